@@ -0,0 +1,5 @@
+#!/bin/sh
+
+# Generates the OpenAPI document for the API commands from their response types
+# Run this whenever an API command or response type changes; the daemon build fails if the document is out of date
+go run ./rocketpool api-schema ./shared/types/api/openapi.json
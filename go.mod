@@ -46,6 +46,7 @@ require (
 	github.com/web3-storage/go-w3s-client v0.0.6
 	golang.org/x/crypto v0.0.0-20211115234514-b4de73f9ece8
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
+	golang.org/x/sys v0.0.0-20211019181941-9d821ace8654
 	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211
 	golang.org/x/tools v0.1.9 // indirect
 	google.golang.org/grpc v1.42.0 // indirect
@@ -140,6 +140,7 @@ func bidOnLot(c *cli.Context) error {
 		if canBid.BidOnLotDisabled {
 			fmt.Println("Bidding on lots is currently disabled.")
 		}
+		cliutils.PrintTransactionRevert(canBid.Revert)
 		return nil
 	}
 
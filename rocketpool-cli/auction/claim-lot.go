@@ -106,6 +106,11 @@ func claimFromLot(c *cli.Context) error {
 		if err != nil {
 			return fmt.Errorf("Error checking if claiming lot %d is possible: %w", lot.Details.Index, err)
 		}
+		if canResponse.Revert != nil {
+			fmt.Printf("Cannot claim from lot %d:\n", lot.Details.Index)
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
+		}
 		gasInfo = canResponse.GasInfo
 		totalGas += canResponse.GasInfo.EstGasLimit
 		totalSafeGas += canResponse.GasInfo.SafeGasLimit
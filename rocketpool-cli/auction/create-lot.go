@@ -38,6 +38,7 @@ func createLot(c *cli.Context) error {
 		if canCreate.CreateLotDisabled {
 			fmt.Println("Lot creation is currently disabled.")
 		}
+		cliutils.PrintTransactionRevert(canCreate.Revert)
 		return nil
 	}
 
@@ -34,6 +34,11 @@ func getLots(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, lots)
+	}
+
 	// Get lots by status
 	openLots := []api.LotDetails{}
 	clearedLots := []api.LotDetails{}
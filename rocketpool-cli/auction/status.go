@@ -32,6 +32,11 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, status)
+	}
+
 	// Print & return
 	fmt.Printf(
 		"A total of %.6f RPL is up for auction, with %.6f RPL currently allotted and %.6f RPL remaining.\n",
@@ -0,0 +1,40 @@
+package dashboard
+
+import (
+	"github.com/urfave/cli"
+
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Register commands
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:      name,
+		Aliases:   aliases,
+		Usage:     "Show a live, full-screen view of the node's status",
+		UsageText: "rocketpool dashboard [options]",
+		Flags: []cli.Flag{
+			cli.UintFlag{
+				Name:  "refresh, r",
+				Usage: "The number of seconds between refreshes",
+				Value: 15,
+			},
+			cli.UintFlag{
+				Name:  "log-lines, l",
+				Usage: "The number of recent node daemon log lines to show",
+				Value: 20,
+			},
+		},
+		Action: func(c *cli.Context) error {
+
+			// Validate args
+			if err := cliutils.ValidateArgCount(c, 0); err != nil {
+				return err
+			}
+
+			// Run
+			return showDashboard(c)
+
+		},
+	})
+}
@@ -0,0 +1,438 @@
+package dashboard
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+// The full-screen dashboard
+type dashboard struct {
+	app          *tview.Application
+	header       *tview.TextView
+	clients      *tview.TextView
+	node         *tview.TextView
+	rewards      *tview.TextView
+	minipools    *tview.TextView
+	duties       *tview.TextView
+	transactions *tview.TextView
+	logs         *tview.TextView
+	lastUpdate   time.Time
+}
+
+func showDashboard(c *cli.Context) error {
+
+	// Get the settings
+	refreshInterval := time.Duration(c.Uint("refresh")) * time.Second
+	if refreshInterval == 0 {
+		return fmt.Errorf("The refresh interval must be at least 1 second.")
+	}
+	logLines := c.Uint("log-lines")
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Create the dashboard
+	app := tview.NewApplication()
+	d := newDashboard(app, logLines)
+
+	// Refresh it in the background until the app stops
+	refresh := make(chan struct{}, 1)
+	done := make(chan struct{})
+	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEscape, event.Rune() == 'q':
+			app.Stop()
+			return nil
+		case event.Rune() == 'r':
+			select {
+			case refresh <- struct{}{}:
+			default:
+			}
+			return nil
+		}
+		return event
+	})
+	go func() {
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			app.QueueUpdateDraw(func() {
+				d.setRefreshing(true)
+			})
+			data := loadDashboardData(rp, logLines)
+			app.QueueUpdateDraw(func() {
+				d.update(data)
+			})
+			select {
+			case <-ticker.C:
+			case <-refresh:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// Run the app
+	err = app.Run()
+	close(done)
+	return err
+
+}
+
+// Create the dashboard's layout
+func newDashboard(app *tview.Application, logLines uint) *dashboard {
+
+	d := &dashboard{
+		app: app,
+		header: tview.NewTextView().
+			SetDynamicColors(true).
+			SetWrap(false),
+		clients:      newPanel("Clients"),
+		node:         newPanel("Node"),
+		rewards:      newPanel("Rewards"),
+		minipools:    newPanel("Minipools"),
+		duties:       newPanel("Duties"),
+		transactions: newPanel("Transactions"),
+		logs:         newPanel("Node Logs"),
+	}
+	d.logs.SetWrap(false)
+	d.header.SetText(" Loading...")
+
+	// Arrange the panels
+	grid := tview.NewGrid().
+		SetRows(1, 10, 0, int(logLines)+2).
+		SetColumns(0, 0, 0)
+	grid.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Rocket Pool Smartnode %s Dashboard ", shared.RocketPoolVersion)).
+		SetBorderColor(tcell.ColorOrange).
+		SetTitleColor(tcell.ColorOrange).
+		SetBackgroundColor(tcell.ColorBlack)
+	grid.AddItem(d.header, 0, 0, 1, 3, 0, 0, false)
+	grid.AddItem(d.clients, 1, 0, 1, 1, 0, 0, false)
+	grid.AddItem(d.node, 1, 1, 1, 1, 0, 0, false)
+	grid.AddItem(d.rewards, 1, 2, 1, 1, 0, 0, false)
+	grid.AddItem(d.minipools, 2, 0, 1, 2, 0, 0, false)
+	grid.AddItem(d.duties, 2, 2, 1, 1, 0, 0, false)
+	grid.AddItem(d.transactions, 3, 0, 1, 1, 0, 0, false)
+	grid.AddItem(d.logs, 3, 1, 1, 2, 0, 0, false)
+	app.SetRoot(grid, true)
+
+	return d
+
+}
+
+// Create a bordered text panel
+func newPanel(title string) *tview.TextView {
+	panel := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true)
+	panel.SetBorder(true).
+		SetTitle(fmt.Sprintf(" %s ", title)).
+		SetTitleAlign(tview.AlignLeft)
+	return panel
+}
+
+// Show whether the dashboard is refreshing in the header
+func (d *dashboard) setRefreshing(refreshing bool) {
+	status := ""
+	if refreshing {
+		status = "  [yellow]Refreshing...[-]"
+	}
+	updated := "never"
+	if !d.lastUpdate.IsZero() {
+		updated = d.lastUpdate.Format("15:04:05")
+	}
+	d.header.SetText(fmt.Sprintf(" Last updated: %s%s    [gray]r: refresh now   q: quit[-]", updated, status))
+}
+
+// Show the latest data
+func (d *dashboard) update(data *dashboardData) {
+	d.lastUpdate = data.Time
+	d.setRefreshing(false)
+	d.clients.SetText(renderClients(data))
+	d.node.SetText(renderNode(data))
+	d.rewards.SetText(renderRewards(data))
+	d.minipools.SetText(renderMinipools(data))
+	d.duties.SetText(renderDuties(data))
+	d.transactions.SetText(renderTransactions(data))
+	d.logs.SetText(renderLogs(data))
+	d.logs.ScrollToEnd()
+}
+
+// Render the sync and failover status of the clients
+func renderClients(data *dashboardData) string {
+	if data.SyncErr != nil {
+		return renderError(data.SyncErr)
+	}
+	var sb strings.Builder
+	sb.WriteString("[::b]Execution client[::-]\n")
+	writeClientManagerStatus(&sb, data.Sync.EcStatus)
+	sb.WriteString("\n[::b]Consensus client[::-]\n")
+	writeClientManagerStatus(&sb, data.Sync.BcStatus)
+	return sb.String()
+}
+
+// Render the status of a primary client and its fallback, marking the one in use
+func writeClientManagerStatus(sb *strings.Builder, status api.ClientManagerStatus) {
+	usingFallback := !status.PrimaryClientStatus.IsSynced && status.FallbackEnabled && status.FallbackClientStatus.IsSynced
+	fmt.Fprintf(sb, "Primary:  %s%s\n", getClientStatusString(status.PrimaryClientStatus), getActiveMarker(!usingFallback))
+	if status.FallbackEnabled {
+		fmt.Fprintf(sb, "Fallback: %s%s\n", getClientStatusString(status.FallbackClientStatus), getActiveMarker(usingFallback))
+	} else {
+		sb.WriteString("Fallback: [gray]not configured[-]\n")
+	}
+}
+
+// Get the description of a client's status
+func getClientStatusString(status api.ClientStatus) string {
+	if status.IsSynced {
+		return "[green]synced[-]"
+	}
+	if status.IsWorking {
+		return fmt.Sprintf("[yellow]syncing (%.2f%%)[-]", status.SyncProgress*100)
+	}
+	if status.Error != "" {
+		return fmt.Sprintf("[red]unavailable (%s)[-]", tview.Escape(status.Error))
+	}
+	return "[red]unavailable[-]"
+}
+
+// Get the marker for the client that's in use
+func getActiveMarker(active bool) string {
+	if active {
+		return " [orange](in use)[-]"
+	}
+	return ""
+}
+
+// Render the node's balances and collateral
+func renderNode(data *dashboardData) string {
+	if data.NodeErr != nil {
+		return renderError(data.NodeErr)
+	}
+	status := data.Node
+	if !status.Registered {
+		return fmt.Sprintf("Account: %s\n\n[yellow]The node is not registered with Rocket Pool.[-]", status.AccountAddress.Hex())
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Account:    %s\n", status.AccountAddress.Hex())
+	fmt.Fprintf(&sb, "Balance:    %.6f ETH, %.6f RPL\n", getEth(status.AccountBalances.ETH), getEth(status.AccountBalances.RPL))
+	fmt.Fprintf(&sb, "RPL staked: %.6f RPL (%.6f effective)\n", getEth(status.RplStake), getEth(status.EffectiveRplStake))
+
+	// Collateral
+	collateral := fmt.Sprintf("%.2f%%", status.CollateralRatio*100)
+	if status.RplStake != nil && status.MinimumRplStake != nil && status.RplStake.Cmp(status.MinimumRplStake) < 0 {
+		collateral = fmt.Sprintf("[red]%s (below the minimum)[-]", collateral)
+	} else if status.RplStake != nil && status.MaximumRplStake != nil && status.RplStake.Cmp(status.MaximumRplStake) > 0 {
+		collateral = fmt.Sprintf("[yellow]%s (above the maximum)[-]", collateral)
+	}
+	fmt.Fprintf(&sb, "Collateral: %s\n", collateral)
+	fmt.Fprintf(&sb, "Minipools:  %d (limit %d)\n", status.MinipoolCounts.Total, status.MinipoolLimit)
+	if status.FeeDistributorBalance != nil {
+		fmt.Fprintf(&sb, "Fee distributor: %.6f ETH\n", getEth(status.FeeDistributorBalance))
+	}
+	if len(status.PenalizedMinipools) > 0 {
+		fmt.Fprintf(&sb, "[red]%d minipool(s) have been penalized.[-]\n", len(status.PenalizedMinipools))
+	}
+	return sb.String()
+}
+
+// Render the next rewards checkpoint and the node's rewards
+func renderRewards(data *dashboardData) string {
+	if data.RewardsErr != nil {
+		return renderError(data.RewardsErr)
+	}
+	rewards := data.Rewards
+	var sb strings.Builder
+	nextCheckpoint := rewards.LastCheckpoint.Add(rewards.RewardsInterval)
+	fmt.Fprintf(&sb, "Next checkpoint: %s\n", nextCheckpoint.Format(time.RFC822))
+	timeLeft := time.Until(nextCheckpoint)
+	if timeLeft > 0 {
+		fmt.Fprintf(&sb, "                 (in %s)\n", timeLeft.Round(time.Minute))
+	} else {
+		sb.WriteString("                 [yellow](waiting for the Oracle DAO)[-]\n")
+	}
+	fmt.Fprintf(&sb, "Estimated RPL:   %.6f RPL\n", rewards.EstimatedRewards)
+	fmt.Fprintf(&sb, "Unclaimed:       %.6f RPL, %.6f ETH\n", rewards.UnclaimedRplRewards, rewards.UnclaimedEthRewards)
+	if rewards.Trusted {
+		fmt.Fprintf(&sb, "Oracle DAO RPL:  %.6f RPL estimated, %.6f RPL unclaimed\n", rewards.EstimatedTrustedRplRewards, rewards.UnclaimedTrustedRplRewards)
+	}
+	return sb.String()
+}
+
+// Render each minipool's status and balance
+func renderMinipools(data *dashboardData) string {
+	if data.MinipoolsErr != nil {
+		return renderError(data.MinipoolsErr)
+	}
+	if len(data.Minipools.Minipools) == 0 {
+		return "The node does not have any minipools yet."
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[::b]%-42s  %-12s  %-9s  %-14s  %s[::-]\n", "Address", "Status", "Validator", "Balance", "Node share")
+	for _, minipool := range data.Minipools.Minipools {
+		statusName := minipool.Status.Status.String()
+		if minipool.Finalised {
+			statusName = "Finalized"
+		}
+		validatorIndex := "-"
+		balance := "-"
+		nodeShare := "-"
+		if minipool.Validator.Exists {
+			validatorIndex = fmt.Sprint(minipool.Validator.Index)
+			balance = fmt.Sprintf("%.6f ETH", getEth(minipool.Validator.Balance))
+			nodeShare = fmt.Sprintf("%.6f ETH", getEth(minipool.Validator.NodeBalance))
+		}
+		fmt.Fprintf(&sb, "%-42s  %s  %-9s  %-14s  %s\n", minipool.Address.Hex(), getMinipoolStatusString(minipool, statusName), validatorIndex, balance, nodeShare)
+	}
+	return sb.String()
+}
+
+// Get the colored status of a minipool
+func getMinipoolStatusString(minipool api.MinipoolDetails, statusName string) string {
+	padded := fmt.Sprintf("%-12s", statusName)
+	switch {
+	case minipool.Finalised:
+		return fmt.Sprintf("[gray]%s[-]", padded)
+	case minipool.Status.Status == types.Staking && minipool.Validator.Exists && !minipool.Validator.Active:
+		return fmt.Sprintf("[yellow]%s[-]", padded)
+	case minipool.Status.Status == types.Staking:
+		return fmt.Sprintf("[green]%s[-]", padded)
+	case minipool.Status.Status == types.Dissolved || minipool.Penalties > 0:
+		return fmt.Sprintf("[red]%s[-]", padded)
+	default:
+		return fmt.Sprintf("[yellow]%s[-]", padded)
+	}
+}
+
+// Render the upcoming proposals and sync committee duties
+func renderDuties(data *dashboardData) string {
+	if data.DutiesErr != nil {
+		return renderError(data.DutiesErr)
+	}
+	duties := data.Duties
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Epoch: %d\n\n", duties.Epoch)
+
+	proposals := []string{}
+	nextProposals := []string{}
+	syncCommittee := []string{}
+	nextSyncCommittee := []string{}
+	for _, validator := range duties.Validators {
+		if validator.Proposals > 0 {
+			proposals = append(proposals, fmt.Sprintf("%d (%d block(s))", validator.Index, validator.Proposals))
+		}
+		if validator.NextEpochProposals > 0 {
+			nextProposals = append(nextProposals, fmt.Sprintf("%d (%d block(s))", validator.Index, validator.NextEpochProposals))
+		}
+		if validator.InSyncCommittee {
+			syncCommittee = append(syncCommittee, fmt.Sprint(validator.Index))
+		}
+		if validator.InNextSyncCommittee {
+			nextSyncCommittee = append(nextSyncCommittee, fmt.Sprint(validator.Index))
+		}
+	}
+
+	sb.WriteString("[::b]Proposals this epoch[::-]\n")
+	writeDutyList(&sb, proposals)
+	sb.WriteString("\n[::b]Proposals next epoch[::-]\n")
+	writeDutyList(&sb, nextProposals)
+	sb.WriteString("\n[::b]Current sync committee[::-]\n")
+	writeDutyList(&sb, syncCommittee)
+	if duties.NextSyncCommitteeEpoch > duties.Epoch {
+		nextPeriod := time.Duration((duties.NextSyncCommitteeEpoch-duties.Epoch)*duties.SecondsPerEpoch) * time.Second
+		fmt.Fprintf(&sb, "\n[::b]Next sync committee[::-] (epoch %d, in %s)\n", duties.NextSyncCommitteeEpoch, nextPeriod.Round(time.Minute))
+		writeDutyList(&sb, nextSyncCommittee)
+	}
+	return sb.String()
+}
+
+// Render the validators with a duty
+func writeDutyList(sb *strings.Builder, validators []string) {
+	if len(validators) == 0 {
+		sb.WriteString("[gray]none[-]\n")
+		return
+	}
+	fmt.Fprintf(sb, "[green]%s[-]\n", strings.Join(validators, ", "))
+}
+
+// Render the pending and queued transactions
+func renderTransactions(data *dashboardData) string {
+	var sb strings.Builder
+
+	// Pending transactions
+	sb.WriteString("[::b]Pending[::-]\n")
+	if data.TransactionsErr != nil {
+		sb.WriteString(renderError(data.TransactionsErr) + "\n")
+	} else {
+		pending := 0
+		for _, tx := range data.Transactions.Transactions {
+			if tx.Status != txmanager.TransactionStatus_Pending {
+				continue
+			}
+			pending++
+			fmt.Fprintf(&sb, "%s (nonce %d, %s ago)\n", tx.Hash.Hex(), tx.Nonce, time.Since(tx.SentTime).Round(time.Second))
+		}
+		if pending == 0 {
+			sb.WriteString("[gray]none[-]\n")
+		}
+	}
+
+	// Queued transactions
+	sb.WriteString("\n[::b]Queued[::-]\n")
+	if data.QueueErr != nil {
+		sb.WriteString(renderError(data.QueueErr) + "\n")
+	} else {
+		waiting := 0
+		for _, tx := range data.Queue.Transactions {
+			if tx.Status != txmanager.QueuedTransactionStatus_Waiting {
+				continue
+			}
+			waiting++
+			fmt.Fprintf(&sb, "%d: %s (below %.2f gwei)\n", tx.ID, tview.Escape(tx.Description), tx.GasThreshold)
+		}
+		if waiting == 0 {
+			sb.WriteString("[gray]none[-]\n")
+		}
+	}
+	return sb.String()
+}
+
+// Render the recent node daemon logs
+func renderLogs(data *dashboardData) string {
+	if data.LogsErr != nil {
+		return renderError(data.LogsErr)
+	}
+	return tview.Escape(strings.Join(data.Logs, "\n"))
+}
+
+// Render an error in a panel
+func renderError(err error) string {
+	return fmt.Sprintf("[red]%s[-]", tview.Escape(err.Error()))
+}
+
+// Convert a wei amount to ETH for display
+func getEth(wei *big.Int) float64 {
+	if wei == nil {
+		return 0
+	}
+	return math.RoundDown(eth.WeiToEth(wei), 6)
+}
@@ -0,0 +1,72 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// A snapshot of everything the dashboard shows.
+// Each section keeps its own error so one failing call doesn't hide the others.
+type dashboardData struct {
+	Time time.Time
+
+	Sync    api.NodeSyncProgressResponse
+	SyncErr error
+
+	Node    api.NodeStatusResponse
+	NodeErr error
+
+	Minipools    api.MinipoolStatusResponse
+	MinipoolsErr error
+
+	Rewards    api.NodeRewardsResponse
+	RewardsErr error
+
+	Duties    api.NodeDutiesResponse
+	DutiesErr error
+
+	Transactions    api.NodeTransactionsResponse
+	TransactionsErr error
+
+	Queue    api.NodeTransactionQueueResponse
+	QueueErr error
+
+	Logs    []string
+	LogsErr error
+}
+
+// Get the latest dashboard data from the daemon
+func loadDashboardData(rp *rocketpool.Client, logLines uint) *dashboardData {
+
+	data := &dashboardData{
+		Time: time.Now(),
+	}
+
+	// Use the fallback clients for the other calls if the primary ones aren't ready
+	data.Sync, data.SyncErr = rp.NodeSync()
+	if data.SyncErr == nil {
+		ecStatus := data.Sync.EcStatus
+		bcStatus := data.Sync.BcStatus
+		if ecStatus.PrimaryClientStatus.IsSynced && bcStatus.PrimaryClientStatus.IsSynced {
+			rp.SetClientStatusFlags(true, false)
+		} else if ecStatus.FallbackEnabled && bcStatus.FallbackEnabled && ecStatus.FallbackClientStatus.IsSynced && bcStatus.FallbackClientStatus.IsSynced {
+			rp.SetClientStatusFlags(true, true)
+		} else {
+			rp.SetClientStatusFlags(false, false)
+		}
+	}
+
+	data.Node, data.NodeErr = rp.NodeStatus()
+	data.Minipools, data.MinipoolsErr = rp.MinipoolStatus()
+	data.Rewards, data.RewardsErr = rp.NodeRewards()
+	data.Duties, data.DutiesErr = rp.NodeDuties()
+	data.Transactions, data.TransactionsErr = rp.NodeTransactions()
+	data.Queue, data.QueueErr = rp.NodeTransactionQueue()
+	data.Logs, data.LogsErr = rp.GetServiceLogs(string(cfgtypes.ContainerID_Node), uint64(logLines))
+
+	return data
+
+}
@@ -43,6 +43,7 @@ func withdrawRpl(c *cli.Context) error {
 		if canWithdraw.InsufficientNodeBalance {
 			fmt.Println("You don't have enough GoETH to pay the faucet withdrawal fee")
 		}
+		cliutils.PrintTransactionRevert(canWithdraw.Revert)
 		return nil
 	}
 
@@ -0,0 +1,112 @@
+package minipool
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func broadcastExit(c *cli.Context, bundlePath string) error {
+
+	// Read the bundle
+	bundleBytes, err := ioutil.ReadFile(bundlePath)
+	if err != nil {
+		return fmt.Errorf("Could not read exit bundle %s: %w", bundlePath, err)
+	}
+	var encrypted validator.EncryptedExitBundle
+	if err := json.Unmarshal(bundleBytes, &encrypted); err != nil {
+		return fmt.Errorf("Could not deserialize exit bundle %s: %w", bundlePath, err)
+	}
+	var bundle validator.ExitBundle
+	if encrypted.Ciphertext != "" {
+		// Decrypt it with the recipient's key
+		if c.String("decryption-key") == "" {
+			return fmt.Errorf("The exit bundle is encrypted to %s; please provide the matching private key with --decryption-key.", encrypted.Recipient)
+		}
+		key, err := crypto.LoadECDSA(c.String("decryption-key"))
+		if err != nil {
+			return fmt.Errorf("Could not load decryption key: %w", err)
+		}
+		bundle, err = validator.DecryptExitBundle(encrypted, key)
+		if err != nil {
+			return err
+		}
+	} else if err := json.Unmarshal(bundleBytes, &bundle); err != nil {
+		return fmt.Errorf("Could not deserialize exit bundle %s: %w", bundlePath, err)
+	}
+	if bundle.Version != validator.ExitBundleVersion {
+		return fmt.Errorf("Unsupported exit bundle version %d.", bundle.Version)
+	}
+
+	// Get the selected exits
+	exits := bundle.Exits
+	if c.String("minipool") != "" {
+		selectedAddress := common.HexToAddress(c.String("minipool"))
+		exits = nil
+		for _, exit := range bundle.Exits {
+			if bytes.Equal(exit.MinipoolAddress.Bytes(), selectedAddress.Bytes()) {
+				exits = append(exits, exit)
+			}
+		}
+		if len(exits) == 0 {
+			return fmt.Errorf("The exit bundle does not contain an exit for minipool %s.", selectedAddress.Hex())
+		}
+	}
+	if len(exits) == 0 {
+		fmt.Println("The exit bundle does not contain any exits.")
+		return nil
+	}
+
+	// Make sure the Beacon Node is on the same network the exits were signed for
+	bc := client.NewStandardHttpClient(c.String("beacon-url"))
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return fmt.Errorf("Could not get the Beacon Node's network config: %w", err)
+	}
+	if hexutil.Encode(eth2Config.GenesisValidatorsRoot) != bundle.GenesisValidatorsRoot {
+		return fmt.Errorf("The exit bundle was signed for a different network (genesis validators root %s) than the Beacon Node is on (%s).", bundle.GenesisValidatorsRoot, hexutil.Encode(eth2Config.GenesisValidatorsRoot))
+	}
+
+	// Show a warning message
+	fmt.Printf("%s***WARNING***\n", colorRed)
+	fmt.Printf("You are about to exit %d validator(s), which will stop all of their activities on the Beacon Chain.\n", len(exits))
+	fmt.Printf("This action cannot be undone!%s\n\n", colorReset)
+	for _, exit := range exits {
+		fmt.Printf("Minipool %s (validator %s, epoch %s)\n", exit.MinipoolAddress.Hex(), exit.SignedVoluntaryExit.Message.ValidatorIndex, exit.SignedVoluntaryExit.Message.Epoch)
+	}
+	fmt.Println()
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to broadcast %d exit(s)?", len(exits)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Broadcast the exits
+	for _, exit := range exits {
+		validatorIndex, epoch, signature, err := exit.Decode()
+		if err != nil {
+			fmt.Printf("Could not decode the exit for minipool %s: %s.\n", exit.MinipoolAddress.Hex(), err)
+			continue
+		}
+		if err := bc.ExitValidator(validatorIndex, epoch, signature); err != nil {
+			fmt.Printf("Could not broadcast the exit for minipool %s: %s.\n", exit.MinipoolAddress.Hex(), err)
+		} else {
+			fmt.Printf("Successfully broadcast the exit for minipool %s.\n", exit.MinipoolAddress.Hex())
+		}
+	}
+
+	// Return
+	return nil
+
+}
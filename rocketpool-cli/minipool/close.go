@@ -18,6 +18,12 @@ import (
 
 func closeMinipools(c *cli.Context) error {
 
+	// Get the conditions for queueing the closures, if requested
+	deferral, err := cliutils.GetTransactionDeferral(c)
+	if err != nil {
+		return err
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -108,10 +114,12 @@ func closeMinipools(c *cli.Context) error {
 	gasInfo.EstGasLimit = totalGas
 	gasInfo.SafeGasLimit = totalSafeGas
 
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(gasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
+	// Assign max fees, unless the closures are being queued
+	if deferral == nil {
+		err = gas.AssignMaxFeeAndLimit(gasInfo, rp, c.Bool("yes"))
+		if err != nil {
+			return err
+		}
 	}
 
 	// Prompt for confirmation
@@ -136,6 +144,18 @@ func closeMinipools(c *cli.Context) error {
 			if !canResponse.InConsensus {
 				fmt.Println("The RPL price and total effective staked RPL of the network are still being voted on by the Oracle DAO.\nPlease try again in a few minutes.")
 			}
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			continue
+		}
+
+		// Queue the closure if requested
+		if deferral != nil {
+			response, err := rp.QueueCloseMinipool(minipool.Address, *deferral)
+			if err != nil {
+				fmt.Printf("Could not queue the closure of minipool %s: %s.\n", minipool.Address.Hex(), err)
+				continue
+			}
+			cliutils.PrintQueuedTransaction(response.ID, *deferral)
 			continue
 		}
 
@@ -1,6 +1,8 @@
 package minipool
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
@@ -32,6 +34,34 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "performance",
+				Aliases:   []string{"p"},
+				Usage:     "Show the recent attestation, proposal and sync committee performance of the node's validators",
+				UsageText: "rocketpool minipool performance [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "epochs, e",
+						Usage: "The number of recent epochs to summarize (225 epochs is approx. 1 day; up to 7 days are kept)",
+						Value: 225,
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					if c.Uint64("epochs") == 0 {
+						return fmt.Errorf("Invalid epochs '0' - must be greater than 0")
+					}
+
+					// Run
+					return getPerformance(c)
+
+				},
+			},
+
 			{
 				Name:      "stake",
 				Aliases:   []string{"t"},
@@ -68,12 +98,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"r"},
 				Usage:     "Refund ETH belonging to the node from minipools",
 				UsageText: "rocketpool minipool refund [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.StringFlag{
 						Name:  "minipool, m",
 						Usage: "The minipool/s to refund from (address or 'all')",
 					},
-				},
+				}, cliutils.TransactionQueueFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -131,7 +161,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"e"},
 				Usage:     "Exit staking minipools from the beacon chain",
 				UsageText: "rocketpool minipool exit [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm exiting minipool/s",
@@ -140,7 +170,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "minipool, m",
 						Usage: "The minipool/s to exit (address or 'all')",
 					},
-				},
+				}, cliutils.OfflineFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -160,6 +190,96 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "export-exits",
+				Usage:     "Pre-sign voluntary exits for minipools and export them to a file, so they can be broadcast later without the validator keys",
+				UsageText: "rocketpool minipool export-exits [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm exporting the exits",
+					},
+					cli.StringFlag{
+						Name:  "minipool, m",
+						Usage: "The minipool/s to export exits for (address or 'all')",
+					},
+					cli.Uint64Flag{
+						Name:  "epoch, e",
+						Usage: "The earliest epoch the exits can be broadcast at (defaults to the current epoch)",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "The file to write the exits to",
+						Value: "exits.json",
+					},
+					cli.StringFlag{
+						Name:  "recipient, r",
+						Usage: "A secp256k1 public key (hex) to encrypt the file to; only the holder of the matching private key will be able to broadcast the exits",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("minipool") != "" && c.String("minipool") != "all" {
+						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return exportExits(c)
+
+				},
+			},
+
+			{
+				Name:      "broadcast-exit",
+				Usage:     "Broadcast pre-signed voluntary exits from a file created with `export-exits`; only requires a Beacon Node",
+				UsageText: "rocketpool minipool broadcast-exit [options] exits-file",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm broadcasting the exits",
+					},
+					cli.StringFlag{
+						Name:  "minipool, m",
+						Usage: "Only broadcast the exit for this minipool address",
+					},
+					cli.StringFlag{
+						Name:  "beacon-url, b",
+						Usage: "The URL of the Beacon Node's REST API",
+						Value: "http://localhost:5052",
+					},
+					cli.StringFlag{
+						Name:  "decryption-key, k",
+						Usage: "The path to a file containing the hex-encoded secp256k1 private key the exits were encrypted to",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("minipool") != "" {
+						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return broadcastExit(c, c.Args().Get(0))
+
+				},
+			},
 			/*
 			   REMOVED UNTIL BEACON WITHDRAWALS
 			   cli.Command{
@@ -167,12 +287,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 			       Aliases:   []string{"c"},
 			       Usage:     "Withdraw balances from dissolved minipools and close them",
 			       UsageText: "rocketpool minipool close [options]",
-			       Flags: []cli.Flag{
+			       Flags: append([]cli.Flag{
 			           cli.StringFlag{
 			               Name:  "minipool, m",
 			               Usage: "The minipool/s to close (address or 'all')",
 			           },
-			       },
+			       }, cliutils.TransactionQueueFlags...),
 			       Action: func(c *cli.Context) error {
 
 			           // Validate args
@@ -194,12 +314,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"u"},
 				Usage:     "Upgrade a minipool's delegate contract to the latest version",
 				UsageText: "rocketpool minipool delegate-upgrade [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.StringFlag{
 						Name:  "minipool, m",
 						Usage: "The minipool/s to upgrade (address or 'all')",
 					},
-				},
+				}, cliutils.TransactionQueueFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
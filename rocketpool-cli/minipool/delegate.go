@@ -14,6 +14,12 @@ import (
 
 func delegateUpgradeMinipools(c *cli.Context) error {
 
+	// Get the conditions for queueing the upgrades, if requested
+	deferral, err := cliutils.GetTransactionDeferral(c)
+	if err != nil {
+		return err
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -77,6 +83,10 @@ func delegateUpgradeMinipools(c *cli.Context) error {
 		if err != nil {
 			fmt.Printf("WARNING: Couldn't get gas price for upgrade transaction (%s)\n", err)
 			break
+		} else if canResponse.Revert != nil {
+			fmt.Printf("Cannot upgrade minipool %s:\n", minipool.Hex())
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
 		} else {
 			fmt.Printf("Minipool %s will upgrade to delegate contract %s.\n", minipool.Hex(), canResponse.LatestDelegateAddress.Hex())
 			gasInfo = canResponse.GasInfo
@@ -87,10 +97,12 @@ func delegateUpgradeMinipools(c *cli.Context) error {
 	gasInfo.EstGasLimit = totalGas
 	gasInfo.SafeGasLimit = totalSafeGas
 
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(gasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
+	// Assign max fees, unless the upgrades are being queued
+	if deferral == nil {
+		err = gas.AssignMaxFeeAndLimit(gasInfo, rp, c.Bool("yes"))
+		if err != nil {
+			return err
+		}
 	}
 
 	// Prompt for confirmation
@@ -101,6 +113,17 @@ func delegateUpgradeMinipools(c *cli.Context) error {
 
 	// Upgrade minipools
 	for _, minipool := range selectedMinipools {
+		// Queue the upgrade if requested
+		if deferral != nil {
+			response, err := rp.QueueDelegateUpgradeMinipool(minipool, *deferral)
+			if err != nil {
+				fmt.Printf("Could not queue the upgrade of minipool %s: %s.\n", minipool.Hex(), err)
+				continue
+			}
+			cliutils.PrintQueuedTransaction(response.ID, *deferral)
+			continue
+		}
+
 		response, err := rp.DelegateUpgradeMinipool(minipool)
 		if err != nil {
 			fmt.Printf("Could not upgrade minipool %s: %s.\n", minipool.Hex(), err)
@@ -186,6 +209,10 @@ func delegateRollbackMinipools(c *cli.Context) error {
 		if err != nil {
 			fmt.Printf("WARNING: Couldn't get gas price for rollback transaction (%s)", err)
 			break
+		} else if canResponse.Revert != nil {
+			fmt.Printf("Cannot roll back minipool %s:\n", minipool.Hex())
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
 		} else {
 			fmt.Printf("Minipool %s will roll back to delegate contract %s.\n", minipool.Hex(), canResponse.RollbackAddress.Hex())
 			gasInfo = canResponse.GasInfo
@@ -295,6 +322,10 @@ func setUseLatestDelegateMinipools(c *cli.Context, setting bool) error {
 		if err != nil {
 			fmt.Printf("WARNING: Couldn't get gas price for auto-upgrade setting transaction (%s)", err)
 			break
+		} else if canResponse.Revert != nil {
+			fmt.Printf("Cannot change the auto-upgrade setting for minipool %s:\n", minipool.Hex())
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
 		} else {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
@@ -100,6 +100,10 @@ func dissolveMinipools(c *cli.Context) error {
 		if err != nil {
 			fmt.Printf("WARNING: Couldn't get gas price for dissolve transaction (%s)", err)
 			break
+		} else if canResponse.Revert != nil {
+			fmt.Printf("Cannot dissolve minipool %s:\n", minipool.Address.Hex())
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
 		} else {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
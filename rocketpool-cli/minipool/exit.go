@@ -108,6 +108,24 @@ func exitMinipools(c *cli.Context) error {
 		return nil
 	}
 
+	// Build the exits for offline signing if requested
+	if cliutils.IsOffline(c) {
+		bundle, err := cliutils.LoadOfflineBundle(c)
+		if err != nil {
+			return err
+		}
+		var response api.BuildOfflineMinipoolExitResponse
+		for _, minipool := range selectedMinipools {
+			response, err = rp.BuildOfflineMinipoolExit(minipool.Address, 0)
+			if err != nil {
+				return err
+			}
+			bundle.Exits = append(bundle.Exits, response.Exit)
+			fmt.Printf("Built the exit of minipool %s (validator %d) for offline signing.\n", minipool.Address.Hex(), response.Exit.ValidatorIndex)
+		}
+		return cliutils.SaveOfflineBundle(c, bundle, response.ChainID, response.NodeAddress)
+	}
+
 	// Exit minipools
 	for _, minipool := range selectedMinipools {
 		if _, err := rp.ExitMinipool(minipool.Address); err != nil {
@@ -0,0 +1,165 @@
+package minipool
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func exportExits(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Parse the recipient key before signing anything
+	var recipientKey *ecdsa.PublicKey
+	if c.String("recipient") != "" {
+		recipientKey, err = validator.ParseExitBundleRecipient(c.String("recipient"))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Get minipool statuses
+	status, err := rp.MinipoolStatus()
+	if err != nil {
+		return err
+	}
+
+	// Get minipools with a validator on the Beacon Chain
+	exitableMinipools := []api.MinipoolDetails{}
+	for _, minipool := range status.Minipools {
+		if minipool.Status.Status == types.Staking && minipool.Validator.Exists {
+			exitableMinipools = append(exitableMinipools, minipool)
+		}
+	}
+	if len(exitableMinipools) == 0 {
+		fmt.Println("No minipools have a validator on the Beacon Chain yet.")
+		return nil
+	}
+
+	// Get selected minipools
+	var selectedMinipools []api.MinipoolDetails
+	if c.String("minipool") == "" {
+
+		// Prompt for minipool selection
+		options := make([]string, len(exitableMinipools)+1)
+		options[0] = "All available minipools"
+		for mi, minipool := range exitableMinipools {
+			options[mi+1] = fmt.Sprintf("%s (validator %d)", minipool.Address.Hex(), minipool.Validator.Index)
+		}
+		selected, _ := cliutils.Select("Please select a minipool to export an exit for:", options)
+
+		// Get minipools
+		if selected == 0 {
+			selectedMinipools = exitableMinipools
+		} else {
+			selectedMinipools = []api.MinipoolDetails{exitableMinipools[selected-1]}
+		}
+
+	} else {
+
+		// Get matching minipools
+		if c.String("minipool") == "all" {
+			selectedMinipools = exitableMinipools
+		} else {
+			selectedAddress := common.HexToAddress(c.String("minipool"))
+			for _, minipool := range exitableMinipools {
+				if bytes.Equal(minipool.Address.Bytes(), selectedAddress.Bytes()) {
+					selectedMinipools = []api.MinipoolDetails{minipool}
+					break
+				}
+			}
+			if selectedMinipools == nil {
+				return fmt.Errorf("The minipool %s does not have a validator on the Beacon Chain.", selectedAddress.Hex())
+			}
+		}
+
+	}
+
+	// Show a warning message
+	fmt.Printf("%s***WARNING***\n", colorRed)
+	fmt.Printf("The exported file can exit your validators without needing their keys. Anyone who obtains it can broadcast the exits at any time after the signed epoch.\n")
+	if recipientKey == nil {
+		fmt.Printf("The file will NOT be encrypted. Please store it as securely as you would your wallet, or use --recipient to encrypt it.\n")
+	}
+	fmt.Printf("%s\n", colorReset)
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to export pre-signed exits for %d minipool(s)?", len(selectedMinipools)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign the exits
+	bundle := validator.ExitBundle{
+		Version: validator.ExitBundleVersion,
+		Exits:   []validator.ExitBundleEntry{},
+	}
+	for _, minipool := range selectedMinipools {
+		response, err := rp.SignMinipoolExit(minipool.Address, c.Uint64("epoch"))
+		if err != nil {
+			return fmt.Errorf("Could not sign exit for minipool %s: %w", minipool.Address.Hex(), err)
+		}
+		bundle.GenesisValidatorsRoot = response.GenesisValidatorsRoot
+		bundle.ForkVersion = response.ForkVersion
+		bundle.Eip7044 = response.Eip7044
+		bundle.Exits = append(bundle.Exits, response.Exit)
+	}
+
+	// Serialize the bundle, encrypting it if requested
+	var bundleBytes []byte
+	if recipientKey != nil {
+		encrypted, err := validator.EncryptExitBundle(bundle, recipientKey)
+		if err != nil {
+			return err
+		}
+		bundleBytes, err = json.MarshalIndent(encrypted, "", "  ")
+		if err != nil {
+			return fmt.Errorf("Could not serialize encrypted exit bundle: %w", err)
+		}
+	} else {
+		bundleBytes, err = json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			return fmt.Errorf("Could not serialize exit bundle: %w", err)
+		}
+	}
+
+	// Write the bundle
+	outputPath := c.String("output")
+	if err := ioutil.WriteFile(outputPath, bundleBytes, 0600); err != nil {
+		return fmt.Errorf("Could not write exit bundle to %s: %w", outputPath, err)
+	}
+
+	// Log & return
+	fmt.Printf("Exported %d pre-signed exit(s) to %s.\n", len(bundle.Exits), outputPath)
+	if bundle.Eip7044 {
+		fmt.Println("These exits are signed for the Capella fork, so they will remain valid permanently (EIP-7044).")
+	} else {
+		fmt.Printf("%sYour Beacon Node doesn't support EIP-7044 yet, so these exits will stop being valid after the next two network upgrades. Please export them again after each upgrade.%s\n", colorYellow, colorReset)
+	}
+	fmt.Println("They can be broadcast with `rocketpool minipool broadcast-exit` from any machine with access to a Beacon Node.")
+	return nil
+
+}
@@ -31,6 +31,11 @@ func finaliseMinipool(c *cli.Context, minipoolAddress common.Address) error {
 	if err != nil {
 		return err
 	}
+	if canResponse.Revert != nil {
+		fmt.Printf("Cannot finalize minipool %s:\n", minipoolAddress.Hex())
+		cliutils.PrintTransactionRevert(canResponse.Revert)
+		return nil
+	}
 
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
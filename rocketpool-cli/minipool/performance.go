@@ -0,0 +1,88 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func getPerformance(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get the performance of the node's validators
+	performance, err := rp.MinipoolPerformance(c.Uint64("epochs"))
+	if err != nil {
+		return err
+	}
+
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, performance)
+	}
+	if performance.LastCheckedEpoch == 0 {
+		fmt.Println("The node daemon has not recorded any validator performance yet. Please check back after it has been running for a few epochs.")
+		return nil
+	}
+	if len(performance.Validators) == 0 {
+		fmt.Println("The node does not have any validators on the Beacon Chain yet.")
+		return nil
+	}
+
+	// Print the performance of each validator
+	fmt.Printf("Performance over the %d epochs up to epoch %d:\n\n", performance.WindowEpochs, performance.LastCheckedEpoch)
+	for _, validator := range performance.Validators {
+		fmt.Printf("--------------------\n\n")
+		fmt.Printf("Minipool:             %s\n", validator.MinipoolAddress.Hex())
+		fmt.Printf("Validator index:      %d\n", validator.ValidatorIndex)
+		if validator.AttestationDuties == 0 {
+			fmt.Printf("Attestations:         no duties\n")
+		} else {
+			missed := validator.AttestationDuties - validator.AttestationsIncluded
+			rate := float64(validator.AttestationsIncluded) / float64(validator.AttestationDuties) * 100
+			fmt.Printf("Attestations:         %d of %d included (%.2f%%)", validator.AttestationsIncluded, validator.AttestationDuties, rate)
+			if missed > 0 {
+				fmt.Printf(" - %s%d missed%s", colorRed, missed, colorReset)
+			}
+			fmt.Println()
+			fmt.Printf("Inclusion distance:   %.2f slots on average\n", validator.AverageInclusionDistance)
+		}
+		if validator.ProposalDuties == 0 {
+			fmt.Printf("Proposals:            no duties\n")
+		} else {
+			fmt.Printf("Proposals:            %d of %d proposed", validator.ProposalDuties-validator.ProposalsMissed, validator.ProposalDuties)
+			if validator.ProposalsMissed > 0 {
+				fmt.Printf(" - %s%d missed%s", colorRed, validator.ProposalsMissed, colorReset)
+			}
+			fmt.Println()
+		}
+		if validator.ProposalsUnknownEpochs > 0 {
+			fmt.Printf("                      %s%d epoch(s) had no proposer duty data, so missed proposals in them aren't counted%s\n", colorYellow, validator.ProposalsUnknownEpochs, colorReset)
+		}
+		if validator.SyncDuties == 0 {
+			fmt.Printf("Sync committee:       no duties\n")
+		} else {
+			rate := float64(validator.SyncParticipations) / float64(validator.SyncDuties) * 100
+			fmt.Printf("Sync committee:       %d of %d signatures included (%.2f%%)\n", validator.SyncParticipations, validator.SyncDuties, rate)
+		}
+		fmt.Println()
+	}
+
+	// Return
+	return nil
+
+}
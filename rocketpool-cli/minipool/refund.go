@@ -18,6 +18,12 @@ import (
 
 func refundMinipools(c *cli.Context) error {
 
+	// Get the conditions for queueing the refunds, if requested
+	deferral, err := cliutils.GetTransactionDeferral(c)
+	if err != nil {
+		return err
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -99,6 +105,10 @@ func refundMinipools(c *cli.Context) error {
 		if err != nil {
 			fmt.Printf("WARNING: Couldn't get gas price for refund transaction (%s)", err)
 			break
+		} else if canResponse.Revert != nil {
+			fmt.Printf("Cannot refund minipool %s:\n", minipool.Address.Hex())
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
 		} else {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
@@ -108,10 +118,12 @@ func refundMinipools(c *cli.Context) error {
 	gasInfo.EstGasLimit = totalGas
 	gasInfo.SafeGasLimit = totalSafeGas
 
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(gasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
+	// Assign max fees, unless the refunds are being queued
+	if deferral == nil {
+		err = gas.AssignMaxFeeAndLimit(gasInfo, rp, c.Bool("yes"))
+		if err != nil {
+			return err
+		}
 	}
 
 	// Prompt for confirmation
@@ -122,6 +134,17 @@ func refundMinipools(c *cli.Context) error {
 
 	// Refund minipools
 	for _, minipool := range selectedMinipools {
+		// Queue the refund if requested
+		if deferral != nil {
+			response, err := rp.QueueRefundMinipool(minipool.Address, *deferral)
+			if err != nil {
+				fmt.Printf("Could not queue the refund of minipool %s: %s.\n", minipool.Address.Hex(), err)
+				continue
+			}
+			cliutils.PrintQueuedTransaction(response.ID, *deferral)
+			continue
+		}
+
 		response, err := rp.RefundMinipool(minipool.Address)
 		if err != nil {
 			fmt.Printf("Could not refund ETH from minipool %s: %s.\n", minipool.Address.Hex(), err)
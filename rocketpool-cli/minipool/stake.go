@@ -97,6 +97,10 @@ func stakeMinipools(c *cli.Context) error {
 		if err != nil {
 			fmt.Printf("WARNING: Couldn't get gas price for stake transaction (%s)", err)
 			break
+		} else if canResponse.Revert != nil {
+			fmt.Printf("Cannot stake minipool %s:\n", minipool.Address.Hex())
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
 		} else {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
@@ -40,6 +40,11 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, status)
+	}
+
 	// Get minipools by status
 	statusMinipools := map[string][]api.MinipoolDetails{}
 	refundableMinipools := []api.MinipoolDetails{}
@@ -37,6 +37,11 @@ func getActiveDAOProposals(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, proposalsResponse)
+	}
+
 	// Voting status
 	fmt.Printf("%s=== DAO Voting ===%s\n", colorGreen, colorReset)
 	blankAddress := common.Address{}
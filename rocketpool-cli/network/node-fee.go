@@ -30,6 +30,11 @@ func getNodeFee(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, response)
+	}
+
 	// Print & return
 	fmt.Printf("The current network node commission rate is %f%%.\n", response.NodeFee*100)
 	fmt.Printf("Minimum node commission rate: %f%%\n", response.MinNodeFee*100)
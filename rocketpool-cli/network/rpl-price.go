@@ -32,6 +32,11 @@ func getRplPrice(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, response)
+	}
+
 	// Print & return
 	fmt.Printf("The current network RPL price is %.6f ETH.\n", math.RoundDown(eth.WeiToEth(response.RplPrice), 6))
 	fmt.Printf("Prices last updated at block: %d\n", response.RplPriceBlock)
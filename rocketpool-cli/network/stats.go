@@ -33,6 +33,11 @@ func getStats(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, response)
+	}
 	activeMinipools := response.InitializedMinipoolCount +
 		response.PrelaunchMinipoolCount +
 		response.StakingMinipoolCount +
@@ -31,6 +31,11 @@ func getTimezones(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, response)
+	}
+
 	// Sort it by the timezone name
 	var maxNameLength int
 	timezoneNames := make([]string, 0, len(response.TimezoneCounts))
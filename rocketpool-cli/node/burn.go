@@ -43,6 +43,7 @@ func nodeBurn(c *cli.Context, amount float64, token string) error {
 		if canBurn.InsufficientCollateral {
 			fmt.Printf("There is insufficient ETH collateral to trade %s for.\n", token)
 		}
+		cliutils.PrintTransactionRevert(canBurn.Revert)
 		return nil
 	}
 
@@ -23,6 +23,12 @@ const (
 
 func nodeClaimRewards(c *cli.Context) error {
 
+	// Get the conditions for queueing the claim, if requested
+	deferral, err := cliutils.GetTransactionDeferral(c)
+	if err != nil {
+		return err
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -208,22 +214,36 @@ func nodeClaimRewards(c *cli.Context) error {
 		if err != nil {
 			return err
 		}
+		if canClaim.Revert != nil {
+			fmt.Println("Cannot claim rewards:")
+			cliutils.PrintTransactionRevert(canClaim.Revert)
+			return nil
+		}
 
-		// Assign max fees
-		err = gas.AssignMaxFeeAndLimit(canClaim.GasInfo, rp, c.Bool("yes"))
-		if err != nil {
-			return err
+		// Assign max fees, unless the claim is being queued
+		if deferral == nil {
+			err = gas.AssignMaxFeeAndLimit(canClaim.GasInfo, rp, c.Bool("yes"))
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		canClaim, err := rp.CanNodeClaimAndStakeRewards(indices, restakeAmountWei)
 		if err != nil {
 			return err
 		}
+		if canClaim.Revert != nil {
+			fmt.Println("Cannot claim and restake rewards:")
+			cliutils.PrintTransactionRevert(canClaim.Revert)
+			return nil
+		}
 
-		// Assign max fees
-		err = gas.AssignMaxFeeAndLimit(canClaim.GasInfo, rp, c.Bool("yes"))
-		if err != nil {
-			return err
+		// Assign max fees, unless the claim is being queued
+		if deferral == nil {
+			err = gas.AssignMaxFeeAndLimit(canClaim.GasInfo, rp, c.Bool("yes"))
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -233,6 +253,31 @@ func nodeClaimRewards(c *cli.Context) error {
 		return nil
 	}
 
+	// Queue the claim if requested
+	if deferral != nil {
+		var response api.QueueNodeTransactionResponse
+		if restakeAmountWei == nil {
+			response, err = rp.QueueNodeClaimRewards(indices, *deferral)
+		} else {
+			response, err = rp.QueueNodeClaimAndStakeRewards(indices, restakeAmountWei, *deferral)
+		}
+		if err != nil {
+			return err
+		}
+		cliutils.PrintQueuedTransaction(response.ID, *deferral)
+		return nil
+	}
+
+	// Build the claim for offline signing if requested
+	if cliutils.IsOffline(c) {
+		return addOfflineTransaction(c, func(nonce uint64) (api.BuildOfflineTransactionResponse, error) {
+			if restakeAmountWei == nil {
+				return rp.BuildOfflineNodeClaimRewards(indices, nonce)
+			}
+			return rp.BuildOfflineNodeClaimAndStakeRewards(indices, restakeAmountWei, nonce)
+		})
+	}
+
 	// Claim rewards
 	var txHash common.Hash
 	if restakeAmountWei == nil {
@@ -104,7 +104,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"w"},
 				Usage:     "Set the node's withdrawal address",
 				UsageText: "rocketpool node set-withdrawal-address [options] address",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm setting withdrawal address",
@@ -113,7 +113,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "force",
 						Usage: "Force update the withdrawal address, bypassing the 'pending' state that requires a confirmation transaction from the new address",
 					},
-				},
+				}, cliutils.OfflineFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -136,12 +136,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"f"},
 				Usage:     "Confirm the node's pending withdrawal address if it has been set back to the node's address itself",
 				UsageText: "rocketpool node confirm-withdrawal-address [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm withdrawal address",
 					},
-				},
+				}, cliutils.OfflineFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -222,7 +222,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"k"},
 				Usage:     "Stake RPL against the node",
 				UsageText: "rocketpool node stake-rpl [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.StringFlag{
 						Name:  "amount, a",
 						Usage: "The amount of RPL to stake (or 'min', 'max', or 'all')",
@@ -235,7 +235,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "swap, s",
 						Usage: "Automatically confirm swapping old RPL before staking",
 					},
-				},
+				}, cliutils.TransactionQueueFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -261,7 +261,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"c"},
 				Usage:     "Claim available RPL and ETH rewards for any checkpoint you haven't claimed yet",
 				UsageText: "rocketpool node claim-rpl [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.StringFlag{
 						Name:  "restake-amount, a",
 						Usage: "The amount of RPL to automatically restake during claiming (or '150%%' to stake up to 150%% collateral, or 'all' for all available RPL)",
@@ -270,7 +270,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm rewards claim",
 					},
-				},
+				}, append(cliutils.TransactionQueueFlags, cliutils.OfflineFlags...)...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -289,7 +289,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"i"},
 				Usage:     "Withdraw RPL staked against the node",
 				UsageText: "rocketpool node withdraw-rpl [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.StringFlag{
 						Name:  "amount, a",
 						Usage: "The amount of RPL to withdraw (or 'max')",
@@ -298,7 +298,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm RPL withdrawal",
 					},
-				},
+				}, cliutils.TransactionQueueFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -324,7 +324,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"d"},
 				Usage:     "Make a deposit and create a minipool",
 				UsageText: "rocketpool node deposit [options]",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					/*cli.StringFlag{
 						Name:  "amount, a",
 						Usage: "The amount of ETH to deposit (0, 16 or 32)",
@@ -341,7 +341,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "salt, l",
 						Usage: "An optional seed to use when generating the new minipool's address. Use this if you want it to have a custom vanity address.",
 					},
-				},
+				}, cliutils.OfflineFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -488,12 +488,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases:   []string{"b"},
 				Usage:     "Distribute the priority fee and MEV rewards from your fee distributor to your withdrawal address and the rETH contract (based on your node's average commission)",
 				UsageText: "rocketpool node distribute-fees",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm distribution",
 					},
-				},
+				}, cliutils.TransactionQueueFlags...),
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -507,6 +507,249 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:    "fee-recipient",
+				Aliases: []string{"fr"},
+				Usage:   "Manage the fee recipients of the node's validators",
+				Subcommands: []cli.Command{
+
+					{
+						Name:      "status",
+						Aliases:   []string{"s"},
+						Usage:     "List the effective fee recipient of each of the node's validators",
+						UsageText: "rocketpool node fee-recipient status",
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getFeeRecipientStatus(c)
+
+						},
+					},
+
+					{
+						Name:      "set-custom",
+						Usage:     "Set the fee recipient of a solo validator; minipool validators always use the node's fee recipient",
+						UsageText: "rocketpool node fee-recipient set-custom [options] pubkey address",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the fee recipient",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 2); err != nil {
+								return err
+							}
+							pubkey, err := cliutils.ValidatePubkey("validator pubkey", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+							feeRecipient, err := cliutils.ValidateAddress("fee recipient", c.Args().Get(1))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return setCustomFeeRecipient(c, pubkey, feeRecipient)
+
+						},
+					},
+
+					{
+						Name:      "clear-custom",
+						Usage:     "Remove the custom fee recipient of a solo validator, so it uses the node's fee recipient",
+						UsageText: "rocketpool node fee-recipient clear-custom pubkey",
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							pubkey, err := cliutils.ValidatePubkey("validator pubkey", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return clearCustomFeeRecipient(c, pubkey)
+
+						},
+					},
+				},
+			},
+
+			{
+				Name:      "broadcast",
+				Usage:     "Broadcast a bundle of transactions and exits that was signed on an offline machine with `rocketpool wallet sign-offline`",
+				UsageText: "rocketpool node broadcast [options] signed-bundle",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm broadcasting the bundle",
+					},
+					cli.StringFlag{
+						Name:  "keystore-password, p",
+						Usage: "The password the validator keys of the bundle's deposits were exported with",
+					},
+					cli.BoolFlag{
+						Name:  "no-restart",
+						Usage: "Don't restart the validator client after importing the validator keys of the bundle's deposits",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcastOfflineBundle(c, c.Args().Get(0))
+
+				},
+			},
+
+			{
+				Name:    "tx",
+				Aliases: []string{"x"},
+				Usage:   "Manage the transactions sent by the node account",
+				Subcommands: []cli.Command{
+
+					{
+						Name:      "list",
+						Aliases:   []string{"l"},
+						Usage:     "List the transactions sent by the node account",
+						UsageText: "rocketpool node tx list [options]",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "pending, p",
+								Usage: "Only list the transactions that are still pending",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getTransactions(c)
+
+						},
+					},
+
+					{
+						Name:      "speed-up",
+						Aliases:   []string{"s"},
+						Usage:     "Replace a pending transaction with a copy that has higher fees",
+						UsageText: "rocketpool node tx speed-up [options] tx-hash",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the replacement",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return speedUpTransaction(c, hash)
+
+						},
+					},
+
+					{
+						Name:      "cancel",
+						Aliases:   []string{"c"},
+						Usage:     "Cancel a pending transaction by replacing it with an empty transfer to the node address",
+						UsageText: "rocketpool node tx cancel [options] tx-hash",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the cancellation",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return cancelTransaction(c, hash)
+
+						},
+					},
+
+					{
+						Name:      "queue",
+						Aliases:   []string{"q"},
+						Usage:     "List the transactions queued for the node daemon to send once gas is low",
+						UsageText: "rocketpool node tx queue",
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getTransactionQueue(c)
+
+						},
+					},
+
+					{
+						Name:      "cancel-queued",
+						Aliases:   []string{"u"},
+						Usage:     "Remove a transaction from the queue before the node daemon sends it",
+						UsageText: "rocketpool node tx cancel-queued [options] id",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the cancellation",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							id, err := cliutils.ValidateUint("id", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return cancelQueuedTransaction(c, id)
+
+						},
+					},
+				},
+			},
+
 			{
 				Name:      "join-smoothing-pool",
 				Aliases:   []string{"js"},
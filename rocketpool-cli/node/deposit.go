@@ -206,6 +206,7 @@ func nodeDeposit(c *cli.Context) error {
 		if !canDeposit.InConsensus {
 			fmt.Println("The RPL price and total effective staked RPL of the network are still being voted on by the Oracle DAO.\nPlease try again in a few minutes.")
 		}
+		cliutils.PrintTransactionRevert(canDeposit.Revert)
 		return nil
 	}
 
@@ -253,6 +254,22 @@ func nodeDeposit(c *cli.Context) error {
 		return nil
 	}
 
+	// Build the deposit for offline signing if requested
+	if cliutils.IsOffline(c) {
+		bundle, err := cliutils.LoadOfflineBundle(c)
+		if err != nil {
+			return err
+		}
+		response, err := rp.BuildOfflineNodeDeposit(amountWei, minNodeFee, salt, bundle.GetNextNonce())
+		if err != nil {
+			return err
+		}
+		bundle.Deposits = append(bundle.Deposits, response.Deposit)
+		fmt.Printf("Built the deposit with nonce %d for offline signing; the new minipool's address will be %s.\n", response.Deposit.Nonce, response.Deposit.MinipoolAddress.Hex())
+		fmt.Println("The validator key will be created on the offline machine when it signs the deposit.")
+		return cliutils.SaveOfflineBundle(c, bundle, response.ChainID, response.NodeAddress)
+	}
+
 	// Make deposit
 	response, err := rp.NodeDeposit(amountWei, minNodeFee, salt)
 	if err != nil {
@@ -72,6 +72,12 @@ func initializeFeeDistributor(c *cli.Context) error {
 
 func distribute(c *cli.Context) error {
 
+	// Get the conditions for queueing the distribution, if requested
+	deferral, err := cliutils.GetTransactionDeferral(c)
+	if err != nil {
+		return err
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -100,6 +106,11 @@ func distribute(c *cli.Context) error {
 		fmt.Printf("Your fee distributor does not have any ETH.")
 		return nil
 	}
+	if canDistributeResponse.Revert != nil {
+		fmt.Println("Cannot distribute the fee distributor's balance:")
+		cliutils.PrintTransactionRevert(canDistributeResponse.Revert)
+		return nil
+	}
 
 	// Print info
 	nodeShare := (1 + canDistributeResponse.AverageNodeFee) * balance / 2
@@ -109,10 +120,12 @@ func distribute(c *cli.Context) error {
 	fmt.Printf("\tYour withdrawal address will receive %.6f ETH.\n", nodeShare)
 	fmt.Printf("\trETH pool stakers will receive %.6f ETH.\n\n", rEthShare)
 
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canDistributeResponse.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
+	// Assign max fees, unless the distribution is being queued
+	if deferral == nil {
+		err = gas.AssignMaxFeeAndLimit(canDistributeResponse.GasInfo, rp, c.Bool("yes"))
+		if err != nil {
+			return err
+		}
 	}
 
 	// Prompt for confirmation
@@ -121,6 +134,16 @@ func distribute(c *cli.Context) error {
 		return nil
 	}
 
+	// Queue the distribution if requested
+	if deferral != nil {
+		response, err := rp.QueueDistribute(*deferral)
+		if err != nil {
+			return err
+		}
+		cliutils.PrintQueuedTransaction(response.ID, *deferral)
+		return nil
+	}
+
 	// Distribute
 	response, err := rp.Distribute()
 	if err != nil {
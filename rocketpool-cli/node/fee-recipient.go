@@ -0,0 +1,158 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getFeeRecipientStatus(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get the fee recipient status
+	status, err := rp.NodeFeeRecipientStatus()
+	if err != nil {
+		return err
+	}
+
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, status)
+	}
+
+	// Print the default fee recipient
+	fmt.Printf("The default fee recipient for your validators is %s%s%s.\n", colorBlue, status.DefaultFeeRecipient.Hex(), colorReset)
+	if !status.DefaultFileUpToDate || !status.ProposerConfigUpToDate {
+		fmt.Printf("%sYour fee recipient files are out of date; the node daemon will update them shortly.%s\n", colorYellow, colorReset)
+	}
+	if status.KeymanagerError != "" {
+		fmt.Printf("%sCould not connect to the Keymanager API: %s%s\n", colorYellow, status.KeymanagerError, colorReset)
+	}
+	fmt.Println()
+
+	// Print each validator
+	if len(status.Validators) == 0 {
+		fmt.Println("The node does not have any validators.")
+		return nil
+	}
+	for _, validator := range status.Validators {
+		fmt.Printf("Validator %s:\n", validator.Pubkey.Hex())
+		if validator.IsMinipool {
+			fmt.Printf("\tMinipool:         %s (%s)\n", validator.MinipoolAddress.Hex(), validator.MinipoolStatus.String())
+		} else {
+			fmt.Println("\tMinipool:         none (solo validator)")
+		}
+		fmt.Printf("\tFee recipient:    %s (%s)\n", validator.FeeRecipient.Hex(), getFeeRecipientSourceDescription(validator.Source))
+		if validator.VcFeeRecipientKnown {
+			if validator.VcFeeRecipient == validator.FeeRecipient {
+				fmt.Printf("\tValidator Client: %susing the correct fee recipient%s\n", colorGreen, colorReset)
+			} else {
+				fmt.Printf("\tValidator Client: %susing %s instead%s\n", colorRed, validator.VcFeeRecipient.Hex(), colorReset)
+			}
+		}
+	}
+	return nil
+
+}
+
+func setCustomFeeRecipient(c *cli.Context, pubkey types.ValidatorPubkey, feeRecipient common.Address) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want validator %s to send its priority fees and MEV to %s?", pubkey.Hex(), feeRecipient.Hex()))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Set the fee recipient
+	if _, err := rp.SetCustomFeeRecipient(pubkey, feeRecipient); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Validator %s will use %s as its fee recipient. The node daemon will update your Validator Client shortly.\n", pubkey.Hex(), feeRecipient.Hex())
+
+	// Teku and Prysm only pick up per-validator fee recipients from the proposer config file if they're started with it
+	cfg, _, err := rp.LoadConfig()
+	if err != nil {
+		return err
+	}
+	cc, _ := cfg.GetSelectedConsensusClient()
+	if cfg.IsNativeMode || cc == cfgtypes.ConsensusClient_Teku || cc == cfgtypes.ConsensusClient_Prysm {
+		fmt.Printf("%sIf your Validator Client is Teku or Prysm, make sure it's started with %s (Teku: `--validators-proposer-config`, Prysm: `--proposer-settings-file`), or it will keep using the node's fee recipient.%s\n", colorYellow, cfg.Smartnode.GetProposerConfigFilePath(), colorReset)
+	}
+	return nil
+
+}
+
+func clearCustomFeeRecipient(c *cli.Context, pubkey types.ValidatorPubkey) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Remove the fee recipient
+	response, err := rp.ClearCustomFeeRecipient(pubkey)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	if !response.Cleared {
+		fmt.Printf("Validator %s does not have a custom fee recipient.\n", pubkey.Hex())
+		return nil
+	}
+	fmt.Printf("Validator %s will use the node's fee recipient. The node daemon will update your Validator Client shortly.\n", pubkey.Hex())
+	return nil
+
+}
+
+// Get a description of why a validator uses its fee recipient
+func getFeeRecipientSourceDescription(source rputils.FeeRecipientSource) string {
+	switch source {
+	case rputils.FeeRecipientSource_SmoothingPool:
+		return "Smoothing Pool"
+	case rputils.FeeRecipientSource_OptOutCooldown:
+		return "Smoothing Pool until the opt-out cooldown ends"
+	case rputils.FeeRecipientSource_Distributor:
+		return "node fee distributor"
+	case rputils.FeeRecipientSource_Custom:
+		return "custom"
+	default:
+		return string(source)
+	}
+}
@@ -0,0 +1,135 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/offline"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Build an unsigned transaction and add it to the offline bundle
+func addOfflineTransaction(c *cli.Context, build func(nonce uint64) (api.BuildOfflineTransactionResponse, error)) error {
+
+	// Load the bundle
+	bundle, err := cliutils.LoadOfflineBundle(c)
+	if err != nil {
+		return err
+	}
+
+	// Build the transaction after the ones already in the bundle
+	response, err := build(bundle.GetNextNonce())
+	if err != nil {
+		return err
+	}
+	bundle.Transactions = append(bundle.Transactions, response.Transaction)
+
+	fmt.Printf("Built transaction '%s' with nonce %d for offline signing.\n", response.Transaction.Description, response.Transaction.Nonce)
+	return cliutils.SaveOfflineBundle(c, bundle, response.ChainID, response.NodeAddress)
+
+}
+
+func broadcastOfflineBundle(c *cli.Context, source string) error {
+
+	// Load the signed bundle
+	bundle, err := offline.LoadSignedBundle(source)
+	if err != nil {
+		return err
+	}
+	bundle.SortTransactions()
+	if len(bundle.Transactions) == 0 && len(bundle.Exits) == 0 {
+		fmt.Println("The signed bundle does not contain any transactions or exits.")
+		return nil
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Print the bundle
+	hasKeystores := false
+	fmt.Printf("The signed bundle for node %s contains:\n", bundle.NodeAddress.Hex())
+	for _, tx := range bundle.Transactions {
+		fmt.Printf("- Transaction %s (nonce %d): %s\n", tx.Hash.Hex(), tx.Nonce, tx.Description)
+		if tx.ValidatorKeystore != nil {
+			hasKeystores = true
+		}
+	}
+	for _, exit := range bundle.Exits {
+		fmt.Printf("- Exit of minipool %s (validator %s, epoch %s)\n", exit.MinipoolAddress.Hex(), exit.SignedVoluntaryExit.Message.ValidatorIndex, exit.SignedVoluntaryExit.Message.Epoch)
+	}
+	fmt.Println()
+	if len(bundle.Exits) > 0 {
+		fmt.Printf("%sExiting a validator stops all of its activities on the Beacon Chain, and cannot be undone!%s\n\n", colorRed, colorReset)
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to broadcast the signed bundle?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Get the password for the validator keys of the deposits
+	keystorePassword := c.String("keystore-password")
+	if hasKeystores && keystorePassword == "" {
+		keystorePassword = cliutils.PromptPassword("Please enter the password the validator keys were exported with:", "^.*$", "")
+	}
+
+	// Broadcast the transactions in nonce order
+	for _, tx := range bundle.Transactions {
+
+		// Import the validator key before the deposit so the validator client has it once the minipool is staking
+		if tx.ValidatorKeystore != nil {
+			response, err := rp.ImportValidatorKey(string(tx.ValidatorKeystore), keystorePassword, !c.Bool("no-restart"))
+			if err != nil {
+				return fmt.Errorf("Could not import the validator key for '%s': %w", tx.Description, err)
+			}
+			fmt.Printf("Imported validator key %s.\n", response.Pubkey.Hex())
+		}
+
+		response, err := rp.BroadcastNodeTransaction(tx.Raw)
+		if err != nil {
+			return fmt.Errorf("Could not broadcast '%s': %w", tx.Description, err)
+		}
+		fmt.Printf("Broadcasting '%s'...\n", tx.Description)
+		cliutils.PrintTransactionHash(rp, response.TxHash)
+		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+			return err
+		}
+		if tx.MinipoolAddress != nil {
+			fmt.Printf("Created minipool %s.\n", tx.MinipoolAddress.Hex())
+		}
+		fmt.Println()
+
+	}
+
+	// Broadcast the exits
+	for _, exit := range bundle.Exits {
+		validatorIndex, epoch, signature, err := exit.Decode()
+		if err != nil {
+			fmt.Printf("Could not decode the exit for minipool %s: %s.\n", exit.MinipoolAddress.Hex(), err)
+			continue
+		}
+		if _, err := rp.BroadcastMinipoolExit(validatorIndex, epoch, signature); err != nil {
+			fmt.Printf("Could not broadcast the exit for minipool %s: %s.\n", exit.MinipoolAddress.Hex(), err)
+		} else {
+			fmt.Printf("Successfully broadcast the exit for minipool %s.\n", exit.MinipoolAddress.Hex())
+		}
+	}
+
+	// Return
+	return nil
+
+}
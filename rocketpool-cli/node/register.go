@@ -46,6 +46,7 @@ func registerNode(c *cli.Context) error {
 		if canRegister.RegistrationDisabled {
 			fmt.Println("Node registrations are currently disabled.")
 		}
+		cliutils.PrintTransactionRevert(canRegister.Revert)
 		return nil
 	}
 
@@ -31,6 +31,11 @@ func getRewards(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, rewards)
+	}
+
 	if !rewards.Registered {
 		fmt.Printf("This node is not currently registered.\n")
 		return nil
@@ -41,6 +41,7 @@ func nodeSend(c *cli.Context, amount float64, token string, toAddress common.Add
 		if canSend.InsufficientBalance {
 			fmt.Printf("The node's %s balance is insufficient.\n", token)
 		}
+		cliutils.PrintTransactionRevert(canSend.Revert)
 		return nil
 	}
 
@@ -38,6 +38,11 @@ func setTimezoneLocation(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if canResponse.Revert != nil {
+		fmt.Println("Cannot set the node's timezone:")
+		cliutils.PrintTransactionRevert(canResponse.Revert)
+		return nil
+	}
 
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
@@ -49,6 +49,11 @@ func joinSmoothingPool(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if canResponse.Revert != nil {
+		fmt.Println("Cannot join the Smoothing Pool:")
+		cliutils.PrintTransactionRevert(canResponse.Revert)
+		return nil
+	}
 
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
@@ -121,6 +126,11 @@ func leaveSmoothingPool(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if canResponse.Revert != nil {
+		fmt.Println("Cannot leave the Smoothing Pool:")
+		cliutils.PrintTransactionRevert(canResponse.Revert)
+		return nil
+	}
 
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
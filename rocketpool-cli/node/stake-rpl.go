@@ -16,6 +16,12 @@ import (
 
 func nodeStakeRpl(c *cli.Context) error {
 
+	// Get the conditions for queueing the stake, if requested
+	deferral, err := cliutils.GetTransactionDeferral(c)
+	if err != nil {
+		return err
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -113,6 +119,7 @@ func nodeStakeRpl(c *cli.Context) error {
 				if canSwap.InsufficientBalance {
 					fmt.Println("The node's old RPL balance is insufficient.")
 				}
+				cliutils.PrintTransactionRevert(canSwap.Revert)
 				return nil
 			}
 			fmt.Println("RPL Swap Gas Info:")
@@ -236,6 +243,11 @@ func nodeStakeRpl(c *cli.Context) error {
 	}
 
 	if allowance.Allowance.Cmp(amountWei) < 0 {
+		// The approval has to be mined before the stake can be sent, so it can't be queued along with it
+		if deferral != nil {
+			return fmt.Errorf("The staking contract doesn't have approval to interact with your RPL yet, so the stake can't be queued.\nPlease run this command without --when-gas-below once to approve it; after that, stakes can be queued.")
+		}
+
 		fmt.Println("Before staking RPL, you must first give the staking contract approval to interact with your RPL.")
 		fmt.Println("This only needs to be done once for your node.")
 
@@ -298,14 +310,17 @@ func nodeStakeRpl(c *cli.Context) error {
 		if !canStake.InConsensus {
 			fmt.Println("The RPL price and total effective staked RPL of the network are still being voted on by the Oracle DAO.\nPlease try again in a few minutes.")
 		}
+		cliutils.PrintTransactionRevert(canStake.Revert)
 		return nil
 	}
 
-	fmt.Println("RPL Stake Gas Info:")
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canStake.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
+	// Assign max fees, unless the stake is being queued
+	if deferral == nil {
+		fmt.Println("RPL Stake Gas Info:")
+		err = gas.AssignMaxFeeAndLimit(canStake.GasInfo, rp, c.Bool("yes"))
+		if err != nil {
+			return err
+		}
 	}
 
 	// Prompt for confirmation
@@ -314,6 +329,16 @@ func nodeStakeRpl(c *cli.Context) error {
 		return nil
 	}
 
+	// Queue the stake if requested
+	if deferral != nil {
+		response, err := rp.QueueNodeStakeRpl(amountWei, *deferral)
+		if err != nil {
+			return err
+		}
+		cliutils.PrintQueuedTransaction(response.ID, *deferral)
+		return nil
+	}
+
 	// Stake RPL
 	stakeResponse, err := rp.NodeStakeRpl(amountWei)
 	if err != nil {
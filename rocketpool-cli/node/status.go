@@ -50,6 +50,11 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, status)
+	}
+
 	// Get the config
 	cfg, _, err := rp.LoadConfig()
 	if err != nil {
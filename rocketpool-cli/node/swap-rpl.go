@@ -141,6 +141,7 @@ func nodeSwapRpl(c *cli.Context) error {
 		if canSwap.InsufficientBalance {
 			fmt.Println("The node's old RPL balance is insufficient.")
 		}
+		cliutils.PrintTransactionRevert(canSwap.Revert)
 		return nil
 	}
 	fmt.Println("RPL Swap Gas Info:")
@@ -40,6 +40,10 @@ func getSyncProgress(c *cli.Context) error {
 		fmt.Printf("To run this safety check, try again later when eth1 has made more sync progress.%s\n\n", colorReset)
 	} else if depositContractInfo.RPNetwork != depositContractInfo.BeaconNetwork ||
 		depositContractInfo.RPDepositContract != depositContractInfo.BeaconDepositContract {
+		if cliutils.IsStructuredOutput() {
+			return fmt.Errorf("Your eth2 client is not using the same deposit contract as Rocket Pool (network %d, contract %s instead of network %d, contract %s)",
+				depositContractInfo.BeaconNetwork, depositContractInfo.BeaconDepositContract.Hex(), depositContractInfo.RPNetwork, depositContractInfo.RPDepositContract.Hex())
+		}
 		cliutils.PrintDepositMismatchError(
 			depositContractInfo.RPNetwork,
 			depositContractInfo.BeaconNetwork,
@@ -56,6 +60,11 @@ func getSyncProgress(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, status)
+	}
+
 	// Print EC status
 	if status.EcStatus.PrimaryClientStatus.Error != "" {
 		fmt.Printf("Your primary execution client is unavailable (%s).\n", status.EcStatus.PrimaryClientStatus.Error)
@@ -0,0 +1,100 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func getTransactionQueue(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the queue
+	response, err := rp.NodeTransactionQueue()
+	if err != nil {
+		return err
+	}
+
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, response)
+	}
+
+	if len(response.Transactions) == 0 {
+		fmt.Println("There are no queued transactions.")
+		return nil
+	}
+
+	// Print the newest transactions first
+	for i := len(response.Transactions) - 1; i >= 0; i-- {
+		tx := response.Transactions[i]
+		fmt.Printf("Queued transaction %d: %s\n", tx.ID, tx.Description)
+		fmt.Printf("\tStatus:       %s\n", getQueuedTransactionStatusDescription(tx))
+		fmt.Printf("\tSend when:    base fee is below %.2f Gwei\n", tx.GasThreshold)
+		if !tx.Deadline.IsZero() {
+			fmt.Printf("\tDeadline:     %s\n", tx.Deadline.Format(time.RFC1123))
+		}
+		fmt.Printf("\tExpires:      %s\n", tx.Expiry.Format(time.RFC1123))
+		fmt.Printf("\tQueued:       %s\n", tx.QueuedTime.Format(time.RFC1123))
+		if tx.TxHash != nil {
+			fmt.Printf("\tSent:         %s at %s\n", tx.TxHash.Hex(), tx.SentTime.Format(time.RFC1123))
+		}
+		if tx.Error != "" {
+			fmt.Printf("\tError:        %s\n", tx.Error)
+		}
+		fmt.Println()
+	}
+	return nil
+
+}
+
+func cancelQueuedTransaction(c *cli.Context, id uint64) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to cancel queued transaction %d?", id))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Cancel the transaction
+	if _, err := rp.CancelQueuedNodeTransaction(id); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Successfully cancelled queued transaction %d.\n", id)
+	return nil
+
+}
+
+// Get a description of a queued transaction's status
+func getQueuedTransactionStatusDescription(tx *txmanager.QueuedTransaction) string {
+	switch tx.Status {
+	case txmanager.QueuedTransactionStatus_Waiting:
+		return fmt.Sprintf("%swaiting%s", colorYellow, colorReset)
+	case txmanager.QueuedTransactionStatus_Sent:
+		return fmt.Sprintf("%ssent%s", colorGreen, colorReset)
+	case txmanager.QueuedTransactionStatus_Failed:
+		return fmt.Sprintf("%sfailed%s", colorRed, colorReset)
+	default:
+		return string(tx.Status)
+	}
+}
@@ -0,0 +1,213 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+func getTransactions(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get the transactions
+	response, err := rp.NodeTransactions()
+	if err != nil {
+		return err
+	}
+	transactions := []*txmanager.Transaction{}
+	for _, tx := range response.Transactions {
+		if c.Bool("pending") && tx.Status != txmanager.TransactionStatus_Pending {
+			continue
+		}
+		transactions = append(transactions, tx)
+	}
+
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		response.Transactions = transactions
+		return cliutils.PrintStructuredOutput(c, response)
+	}
+
+	if len(transactions) == 0 {
+		fmt.Println("The node account has no transactions in its journal.")
+		return nil
+	}
+
+	// Print the newest transactions first
+	for i := len(transactions) - 1; i >= 0; i-- {
+		tx := transactions[i]
+		fmt.Printf("Transaction %s:\n", tx.Hash.Hex())
+		fmt.Printf("\tStatus:       %s\n", getTransactionStatusDescription(tx))
+		fmt.Printf("\tNonce:        %d\n", tx.Nonce)
+		if tx.To != nil {
+			fmt.Printf("\tTo:           %s\n", tx.To.Hex())
+		}
+		if tx.Value != nil && tx.Value.Sign() > 0 {
+			fmt.Printf("\tValue:        %.6f ETH\n", math.RoundDown(eth.WeiToEth(tx.Value), 6))
+		}
+		fmt.Printf("\tMax fee:      %.6f Gwei (priority fee %.6f Gwei)\n", eth.WeiToGwei(tx.MaxFee), eth.WeiToGwei(tx.MaxPriorityFee))
+		fmt.Printf("\tSent by:      %s at %s\n", tx.Source, tx.SentTime.Format(time.RFC1123))
+		if tx.Replaces != nil {
+			fmt.Printf("\tReplaces:     %s\n", tx.Replaces.Hex())
+		}
+		fmt.Println()
+	}
+	return nil
+
+}
+
+func speedUpTransaction(c *cli.Context, hash common.Hash) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Check the transaction can be sped up
+	canSpeedUp, err := rp.CanSpeedUpNodeTransaction(hash)
+	if err != nil {
+		return err
+	}
+	tx := canSpeedUp.Transaction
+	fmt.Printf("Transaction %s (nonce %d) has a max fee of %.6f Gwei and a priority fee of %.6f Gwei.\n", tx.Hash.Hex(), tx.Nonce, eth.WeiToGwei(tx.MaxFee), eth.WeiToGwei(tx.MaxPriorityFee))
+	fmt.Printf("It will be replaced by a copy with a max fee of %s%.6f Gwei%s and a priority fee of %s%.6f Gwei%s, for a total of up to %.6f ETH.\n",
+		colorBlue, eth.WeiToGwei(canSpeedUp.MaxFee), colorReset,
+		colorBlue, eth.WeiToGwei(canSpeedUp.MaxPriorityFee), colorReset,
+		math.RoundDown(eth.WeiToEth(new(big.Int).Mul(canSpeedUp.MaxFee, new(big.Int).SetUint64(tx.GasLimit))), 6))
+	fmt.Println("You can choose different fees with the global --maxFee and --maxPrioFee flags.")
+	fmt.Println()
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to speed up transaction %s?", tx.Hash.Hex()))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Replace the transaction
+	response, err := rp.SpeedUpNodeTransaction(hash)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Speeding up transaction %s...\n", hash.Hex())
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	return waitForReplacement(rp, hash, response.TxHash, "sped up")
+
+}
+
+func cancelTransaction(c *cli.Context, hash common.Hash) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Check the transaction can be cancelled
+	canCancel, err := rp.CanCancelNodeTransaction(hash)
+	if err != nil {
+		return err
+	}
+	tx := canCancel.Transaction
+	fmt.Printf("Transaction %s (nonce %d) will be replaced by an empty transfer to your node address.\n", tx.Hash.Hex(), tx.Nonce)
+	fmt.Printf("The replacement will have a max fee of %s%.6f Gwei%s and a priority fee of %s%.6f Gwei%s, for a total of up to %.6f ETH.\n",
+		colorBlue, eth.WeiToGwei(canCancel.MaxFee), colorReset,
+		colorBlue, eth.WeiToGwei(canCancel.MaxPriorityFee), colorReset,
+		math.RoundDown(eth.WeiToEth(new(big.Int).Mul(canCancel.MaxFee, new(big.Int).SetUint64(canCancel.GasLimit))), 6))
+	fmt.Println("You can choose different fees with the global --maxFee and --maxPrioFee flags.")
+	fmt.Println()
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to cancel transaction %s?", tx.Hash.Hex()))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Replace the transaction
+	response, err := rp.CancelNodeTransaction(hash)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Cancelling transaction %s...\n", hash.Hex())
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	return waitForReplacement(rp, hash, response.TxHash, "cancelled")
+
+}
+
+// Wait for a replacement transaction, and report whether it or the original was mined
+func waitForReplacement(rp *rocketpool.Client, originalHash common.Hash, replacementHash common.Hash, action string) error {
+
+	if _, err := rp.WaitForTransaction(replacementHash); err != nil {
+		return err
+	}
+
+	response, err := rp.NodeTransactions()
+	if err != nil {
+		return err
+	}
+	for _, tx := range response.Transactions {
+		if tx.Hash == replacementHash && tx.Status != txmanager.TransactionStatus_Mined && tx.Status != txmanager.TransactionStatus_Reverted {
+			fmt.Printf("The original transaction %s was mined before it could be %s.\n", originalHash.Hex(), action)
+			return nil
+		}
+	}
+	fmt.Printf("Successfully %s transaction %s.\n", action, originalHash.Hex())
+	return nil
+
+}
+
+// Get a description of a transaction's status
+func getTransactionStatusDescription(tx *txmanager.Transaction) string {
+	switch tx.Status {
+	case txmanager.TransactionStatus_Pending:
+		return fmt.Sprintf("%spending%s", colorYellow, colorReset)
+	case txmanager.TransactionStatus_Mined:
+		return fmt.Sprintf("%smined in block %d%s", colorGreen, tx.BlockNumber, colorReset)
+	case txmanager.TransactionStatus_Reverted:
+		return fmt.Sprintf("%sreverted in block %d%s", colorRed, tx.BlockNumber, colorReset)
+	case txmanager.TransactionStatus_Replaced:
+		if tx.ReplacedBy != nil {
+			return fmt.Sprintf("replaced by %s", tx.ReplacedBy.Hex())
+		}
+		return "replaced"
+	default:
+		return string(tx.Status)
+	}
+}
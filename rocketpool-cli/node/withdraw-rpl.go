@@ -16,6 +16,12 @@ import (
 
 func nodeWithdrawRpl(c *cli.Context) error {
 
+	// Get the conditions for queueing the withdrawal, if requested
+	deferral, err := cliutils.GetTransactionDeferral(c)
+	if err != nil {
+		return err
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -109,13 +115,16 @@ func nodeWithdrawRpl(c *cli.Context) error {
 		if !canWithdraw.InConsensus {
 			fmt.Println("The RPL price and total effective staked RPL of the network are still being voted on by the Oracle DAO.\nPlease try again in a few minutes.")
 		}
+		cliutils.PrintTransactionRevert(canWithdraw.Revert)
 		return nil
 	}
 
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canWithdraw.GasInfo, rp, c.Bool("yes"))
-	if err != nil {
-		return err
+	// Assign max fees, unless the withdrawal is being queued
+	if deferral == nil {
+		err = gas.AssignMaxFeeAndLimit(canWithdraw.GasInfo, rp, c.Bool("yes"))
+		if err != nil {
+			return err
+		}
 	}
 
 	// Prompt for confirmation
@@ -124,6 +133,16 @@ func nodeWithdrawRpl(c *cli.Context) error {
 		return nil
 	}
 
+	// Queue the withdrawal if requested
+	if deferral != nil {
+		response, err := rp.QueueNodeWithdrawRpl(amountWei, *deferral)
+		if err != nil {
+			return err
+		}
+		cliutils.PrintQueuedTransaction(response.ID, *deferral)
+		return nil
+	}
+
 	// Withdraw RPL
 	response, err := rp.NodeWithdrawRpl(amountWei)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -51,8 +52,13 @@ func setWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address) erro
 	if err != nil {
 		return err
 	}
+	if canResponse.Revert != nil {
+		fmt.Println("Cannot set the node's withdrawal address:")
+		cliutils.PrintTransactionRevert(canResponse.Revert)
+		return nil
+	}
 
-	if confirm {
+	if confirm && !cliutils.IsOffline(c) {
 		// Prompt for a test transaction
 		if cliutils.Confirm("Would you like to send a test transaction to make sure you have the correct address?") {
 			inputAmount := cliutils.Prompt(fmt.Sprintf("Please enter an amount of ETH to send to %s:", withdrawalAddress), "^\\d+(\\.\\d+)?$", "Invalid amount")
@@ -104,6 +110,13 @@ func setWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address) erro
 		return nil
 	}
 
+	// Build the transaction for offline signing if requested
+	if cliutils.IsOffline(c) {
+		return addOfflineTransaction(c, func(nonce uint64) (api.BuildOfflineTransactionResponse, error) {
+			return rp.BuildOfflineSetNodeWithdrawalAddress(withdrawalAddress, confirm, nonce)
+		})
+	}
+
 	// Set node's withdrawal address
 	response, err := rp.SetNodeWithdrawalAddress(withdrawalAddress, confirm)
 	if err != nil {
@@ -151,6 +164,11 @@ func confirmWithdrawalAddress(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if canResponse.Revert != nil {
+		fmt.Println("Cannot confirm the node's withdrawal address:")
+		cliutils.PrintTransactionRevert(canResponse.Revert)
+		return nil
+	}
 
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
@@ -164,6 +182,11 @@ func confirmWithdrawalAddress(c *cli.Context) error {
 		return nil
 	}
 
+	// Build the transaction for offline signing if requested
+	if cliutils.IsOffline(c) {
+		return addOfflineTransaction(c, rp.BuildOfflineConfirmNodeWithdrawalAddress)
+	}
+
 	// Confirm node's withdrawal address
 	response, err := rp.ConfirmNodeWithdrawalAddress()
 	if err != nil {
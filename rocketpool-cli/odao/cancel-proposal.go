@@ -95,6 +95,11 @@ func cancelProposal(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if canResponse.Revert != nil {
+		fmt.Printf("Cannot cancel proposal %d:\n", selectedProposal.ID)
+		cliutils.PrintTransactionRevert(canResponse.Revert)
+		return nil
+	}
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
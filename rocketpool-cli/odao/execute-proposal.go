@@ -105,6 +105,10 @@ func executeProposal(c *cli.Context) error {
 		if err != nil {
 			fmt.Printf("WARNING: Couldn't get gas price for execute transaction (%s)", err)
 			break
+		} else if canResponse.Revert != nil {
+			fmt.Printf("Cannot execute proposal %d:\n", proposal.ID)
+			cliutils.PrintTransactionRevert(canResponse.Revert)
+			return nil
 		} else {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
@@ -111,6 +111,7 @@ func join(c *cli.Context) error {
 				if canSwap.InsufficientBalance {
 					fmt.Println("The node's old RPL balance is insufficient.")
 				}
+				cliutils.PrintTransactionRevert(canSwap.Revert)
 				return nil
 			}
 			fmt.Println("RPL Swap Gas Info:")
@@ -165,6 +166,7 @@ func join(c *cli.Context) error {
 		if canJoin.InsufficientRplBalance {
 			fmt.Println("The node does not have enough RPL to pay the RPL bond.")
 		}
+		cliutils.PrintTransactionRevert(canJoin.Revert)
 		return nil
 	}
 
@@ -76,6 +76,7 @@ func leave(c *cli.Context) error {
 		if canLeave.InsufficientMembers {
 			fmt.Println("There are not enough members in the oracle DAO to allow a member to leave.")
 		}
+		cliutils.PrintTransactionRevert(canLeave.Revert)
 		return nil
 	}
 
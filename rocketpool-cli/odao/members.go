@@ -32,6 +32,11 @@ func getMembers(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, members)
+	}
+
 	// Print & return
 	if len(members.Members) > 0 {
 		fmt.Printf("The oracle DAO has %d members:\n", len(members.Members))
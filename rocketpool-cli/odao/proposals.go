@@ -53,6 +53,18 @@ func getProposals(c *cli.Context, stateFilter string) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		filteredProposals := []dao.ProposalDetails{}
+		for _, proposal := range allProposals.Proposals {
+			if !filterProposalState(strings.ToLower(proposal.State.String()), stateFilter) {
+				filteredProposals = append(filteredProposals, proposal)
+			}
+		}
+		allProposals.Proposals = filteredProposals
+		return cliutils.PrintStructuredOutput(c, allProposals)
+	}
+
 	// Get oracle DAO members
 	allMembers, err := rp.TNDAOMembers()
 	if err != nil {
@@ -146,6 +158,19 @@ func getProposal(c *cli.Context, id uint64) error {
 		}
 	}
 
+	if proposal == nil {
+		if cliutils.IsStructuredOutput() {
+			return fmt.Errorf("Proposal with ID %d does not exist.", id)
+		}
+		fmt.Printf("Proposal with ID %d does not exist.\n", id)
+		return nil
+	}
+
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, proposal)
+	}
+
 	// Find the proposer
 	var memberID string
 	for _, member := range allMembers.Members {
@@ -154,11 +179,6 @@ func getProposal(c *cli.Context, id uint64) error {
 		}
 	}
 
-	if proposal == nil {
-		fmt.Printf("Proposal with ID %d does not exist.\n", id)
-		return nil
-	}
-
 	// Main details
 	fmt.Printf("Proposal ID:          %d\n", proposal.ID)
 	fmt.Printf("Message:              %s\n", proposal.Message)
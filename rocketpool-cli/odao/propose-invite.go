@@ -39,6 +39,7 @@ func proposeInvite(c *cli.Context, memberAddress common.Address, memberId, membe
 		if canPropose.MemberAlreadyExists {
 			fmt.Printf("The node %s is already a member of the oracle DAO.\n", memberAddress.Hex())
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
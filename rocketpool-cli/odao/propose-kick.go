@@ -107,6 +107,7 @@ func proposeKick(c *cli.Context) error {
 		if canPropose.InsufficientRplBond {
 			fmt.Printf("The fine amount of %.6f RPL is greater than the member's bond of %.6f RPL.\n", math.RoundDown(eth.WeiToEth(fineAmountWei), 6), math.RoundDown(eth.WeiToEth(selectedMember.RPLBondAmount), 6))
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -38,6 +38,7 @@ func proposeLeave(c *cli.Context) error {
 		if canPropose.InsufficientMembers {
 			fmt.Println("There are not enough members in the oracle DAO to allow a member to leave.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 	// Assign max fees
@@ -37,6 +37,7 @@ func proposeSettingMembersQuorum(c *cli.Context, quorumPercent float64) error {
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -95,6 +96,7 @@ func proposeSettingMembersRplBond(c *cli.Context, bondAmountEth float64) error {
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -153,6 +155,7 @@ func proposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax uint6
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -218,6 +221,7 @@ func proposeSettingProposalCooldown(c *cli.Context, proposalCooldownTimespan str
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -283,6 +287,7 @@ func proposeSettingProposalVoteTimespan(c *cli.Context, proposalVoteTimespan str
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -348,6 +353,7 @@ func proposeSettingProposalVoteDelayTimespan(c *cli.Context, proposalDelayTimesp
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -413,6 +419,7 @@ func proposeSettingProposalExecuteTimespan(c *cli.Context, proposalExecuteTimesp
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -478,6 +485,7 @@ func proposeSettingProposalActionTimespan(c *cli.Context, proposalActionTimespan
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -543,6 +551,7 @@ func proposeSettingScrubPeriod(c *cli.Context, scrubPeriod string) error {
 		if canPropose.ProposalCooldownActive {
 			fmt.Println("The node must wait for the proposal cooldown period to pass before making another proposal.")
 		}
+		cliutils.PrintTransactionRevert(canPropose.Revert)
 		return nil
 	}
 
@@ -140,6 +140,7 @@ func voteOnProposal(c *cli.Context) error {
 		if canVote.JoinedAfterCreated {
 			fmt.Println("You cannot vote on proposals created before you joined the oracle DAO.")
 		}
+		cliutils.PrintTransactionRevert(canVote.Revert)
 		return nil
 	}
 
@@ -41,6 +41,7 @@ func processQueue(c *cli.Context) error {
 		if canProcess.InsufficientDepositBalance {
 			fmt.Println("The deposit pool has an insufficient balance for assignment.")
 		}
+		cliutils.PrintTransactionRevert(canProcess.Revert)
 		return nil
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/rocketpool-cli/auction"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/dashboard"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/faucet"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/minipool"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/network"
@@ -22,6 +23,35 @@ import (
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
+// The commands that can print their results with --output json or yaml
+var structuredOutputCommands = map[string]bool{
+	"auction lots":              true,
+	"auction status":            true,
+	"faucet status":             true,
+	"minipool performance":      true,
+	"minipool status":           true,
+	"network dao-proposals":     true,
+	"network node-fee":          true,
+	"network rpl-price":         true,
+	"network stats":             true,
+	"network timezone-map":      true,
+	"node fee-recipient status": true,
+	"node rewards":              true,
+	"node status":               true,
+	"node sync":                 true,
+	"node tx list":              true,
+	"node tx queue":             true,
+	"odao member-settings":      true,
+	"odao members":              true,
+	"odao minipool-settings":    true,
+	"odao proposal-settings":    true,
+	"odao proposals details":    true,
+	"odao proposals list":       true,
+	"odao status":               true,
+	"queue status":              true,
+	"wallet status":             true,
+}
+
 // Run
 func main() {
 
@@ -98,6 +128,26 @@ ______           _        _    ______           _
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
 		},
+		cli.StringFlag{
+			Name:   "host",
+			Usage:  "Manage a Smartnode on a remote `host` over SSH, as host, host:port or user@host:port. The host key must already be in ~/.ssh/known_hosts",
+			EnvVar: "RP_HOST",
+		},
+		cli.StringFlag{
+			Name:   "ssh-user",
+			Usage:  "The `user` to log in to the remote host as (defaults to the current user)",
+			EnvVar: "RP_SSH_USER",
+		},
+		cli.StringFlag{
+			Name:   "ssh-key",
+			Usage:  "The private key `file` to log in to the remote host with (defaults to the SSH agent)",
+			EnvVar: "RP_SSH_KEY",
+		},
+		cli.StringFlag{
+			Name:  "output",
+			Usage: "The `format` to print command results in: 'table' for text, or 'json' or 'yaml' for scripts. In the structured formats, only the result is printed to stdout, and commands that don't support them fail",
+			Value: string(cliutils.OutputFormat_Table),
+		},
 		cli.BoolFlag{
 			Name: "secure-session, s",
 			Usage: "Some commands may print sensitive information to your terminal. " +
@@ -107,6 +157,7 @@ ______           _        _    ______           _
 
 	// Register commands
 	auction.RegisterCommands(app, "auction", []string{"a"})
+	dashboard.RegisterCommands(app, "dashboard", []string{"d"})
 
 	// Get the config path from the arguments (or use the default)
 	configPath := "~/.rocketpool"
@@ -149,6 +200,7 @@ ______           _        _    ______           _
 	queue.RegisterCommands(app, "queue", []string{"q"})
 	service.RegisterCommands(app, "service", []string{"s"})
 	wallet.RegisterCommands(app, "wallet", []string{"w"})
+	cliutils.RequireStructuredOutputSupport(app.Commands, structuredOutputCommands)
 
 	app.Before = func(c *cli.Context) error {
 		// Check user ID
@@ -158,14 +210,27 @@ ______           _        _    ______           _
 			os.Exit(1)
 		}
 
+		// Set the output format
+		if err := cliutils.SetOutputFormat(c.GlobalString("output")); err != nil {
+			return err
+		}
+		if !cliutils.IsStructuredOutput() {
+			fmt.Println("")
+		}
+
 		return nil
 	}
 
 	// Run application
-	fmt.Println("")
 	if err := app.Run(os.Args); err != nil {
+		if cliutils.IsStructuredOutput() {
+			cliutils.PrintStructuredError(err)
+			os.Exit(1)
+		}
 		cliutils.PrettyPrintError(err)
 	}
-	fmt.Println("")
+	if !cliutils.IsStructuredOutput() {
+		fmt.Println("")
+	}
 
 }
@@ -0,0 +1,463 @@
+package service
+
+import (
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitchellh/go-homedir"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/backup"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Settings
+const (
+	backupDirMode fs.FileMode = 0755
+)
+
+// Create an encrypted backup of the node's wallet, keys, and settings
+func backupNode(c *cli.Context, archivePath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// The backup is read from the node's files directly, so it has to be made on the node machine
+	if rp.IsRemote() {
+		return fmt.Errorf("Backups can't be made with --host; please run this command on the node machine.")
+	}
+
+	// Get the config
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if isNew {
+		return fmt.Errorf("Settings file not found. Please run `rocketpool service config` to set up your Smartnode.")
+	}
+
+	// Make the path absolute and make sure we won't overwrite anything
+	archivePath, err = filepath.Abs(archivePath)
+	if err != nil {
+		return fmt.Errorf("Error converting to absolute path: %w", err)
+	}
+	if _, err := os.Stat(archivePath); err == nil {
+		return fmt.Errorf("%s already exists; please choose a different backup file.", archivePath)
+	}
+
+	// Get the host paths
+	configPath, dataPath, err := getBackupPaths(c, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Read the files to back up
+	files := map[string][]byte{}
+	if err := addBackupFile(files, backup.SettingsFile, filepath.Join(configPath, rocketpool.SettingsFile), true); err != nil {
+		return err
+	}
+	if err := addBackupFile(files, backup.WalletFile, filepath.Join(dataPath, "wallet"), true); err != nil {
+		return err
+	}
+	// Only the file backend keeps the password on disk; the others provide it at runtime
+	passwordFileRequired := (cfg.Smartnode.GetPasswordBackend() == cfgtypes.PasswordBackend_File)
+	if err := addBackupFile(files, backup.PasswordFile, filepath.Join(dataPath, "password"), passwordFileRequired); err != nil {
+		return err
+	}
+	if err := addBackupFile(files, backup.CustomKeyPasswordFile, filepath.Join(dataPath, "custom-key-passwords"), false); err != nil {
+		return err
+	}
+	if err := addBackupFile(files, backup.WatchtowerStateFile, filepath.Join(dataPath, config.WatchtowerFolder, config.WatchtowerStateFile), false); err != nil {
+		return err
+	}
+	if err := addBackupFile(files, backup.CustomFeeRecipientsFile, filepath.Join(dataPath, config.CustomFeeRecipientsFilename), false); err != nil {
+		return err
+	}
+	for _, filename := range []string{config.FeeRecipientFilename, config.NativeFeeRecipientFilename, config.ProposerConfigFilename} {
+		if err := addBackupFile(files, backup.FeeRecipientFolder+"/"+filename, filepath.Join(dataPath, "validators", filename), false); err != nil {
+			return err
+		}
+	}
+
+	// Read the custom and imported keys
+	if err := addBackupFolder(files, backup.CustomKeyFolder, filepath.Join(dataPath, "custom-keys")); err != nil {
+		return fmt.Errorf("Error enumerating custom keystores: %w", err)
+	}
+	if err := addBackupFolder(files, backup.ImportedKeyFolder, filepath.Join(dataPath, wallet.ImportedKeyFolder)); err != nil {
+		return fmt.Errorf("Error enumerating imported validator keys: %w", err)
+	}
+
+	// Read the slashing protection export
+	slashingProtectionPath := c.String("slashing-protection")
+	if slashingProtectionPath != "" {
+		if err := addBackupFile(files, backup.SlashingProtectionFile, slashingProtectionPath, true); err != nil {
+			return err
+		}
+	}
+
+	// Get the node address from the wallet
+	nodeAddress, err := getBackupNodeAddress(cfg, files[backup.WalletFile], files[backup.PasswordFile])
+	if err != nil {
+		return fmt.Errorf("Error loading the node wallet: %w", err)
+	}
+
+	// Print a summary
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Printf("Backing up node %s%s%s with the following files:\n", colorBold, nodeAddress.Hex(), colorReset)
+	for _, name := range names {
+		fmt.Printf("\t%s\n", name)
+	}
+	if slashingProtectionPath == "" {
+		fmt.Printf("%sNo slashing protection export was provided. Use --slashing-protection to include one from your Validator Client.%s\n", colorYellow, colorReset)
+	}
+	fmt.Println()
+	if _, exists := files[backup.PasswordFile]; exists {
+		fmt.Printf("%sThis backup contains your node wallet, its password, and your validator keys. Anyone who has it and its passphrase controls your node.\nStore it somewhere safe.%s\n\n", colorYellow, colorReset)
+	} else {
+		fmt.Printf("%sThis backup contains your node wallet and your validator keys, but not the wallet password (your Smartnode uses the '%s' password backend). You will need that password to restore it.\nStore it somewhere safe.%s\n\n", colorYellow, cfg.Smartnode.GetPasswordBackend(), colorReset)
+	}
+
+	// Get the passphrase
+	passphrase := promptBackupPassphrase(true)
+
+	// Write the archive
+	fmt.Println("Encrypting backup...")
+	manifest := backup.Manifest{
+		SmartnodeVersion: shared.RocketPoolVersion,
+		Network:          string(cfg.Smartnode.Network.Value.(cfgtypes.Network)),
+		NodeAddress:      nodeAddress,
+		CreatedAt:        time.Now().UTC(),
+	}
+	if err := backup.Create(archivePath, passphrase, manifest, files); err != nil {
+		return err
+	}
+
+	fmt.Printf("%sBackup saved to %s.%s\n", colorGreen, archivePath, colorReset)
+	return nil
+
+}
+
+// Restore the node's wallet, keys, and settings from an encrypted backup
+func restoreNode(c *cli.Context, archivePath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// The backup is written to the node's files directly, so it has to be restored on the node machine
+	if rp.IsRemote() {
+		return fmt.Errorf("Backups can't be restored with --host; please run this command on the node machine.")
+	}
+
+	// Get the config
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if isNew {
+		return fmt.Errorf("Settings file not found. Please run `rocketpool service config` and `rocketpool service start` before restoring a backup so it can be checked against the chain.")
+	}
+
+	// Get the host paths
+	_, dataPath, err := getBackupPaths(c, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Decrypt the archive
+	passphrase := promptBackupPassphrase(false)
+	fmt.Println("Decrypting backup...")
+	manifest, files, err := backup.Open(archivePath, passphrase)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Backup of node %s%s%s created on %s with Smartnode v%s.\n\n", colorBold, manifest.NodeAddress.Hex(), colorReset, manifest.CreatedAt.Format(time.RFC1123), manifest.SmartnodeVersion)
+
+	// Check the network
+	network := string(cfg.Smartnode.Network.Value.(cfgtypes.Network))
+	if manifest.Network != network {
+		return fmt.Errorf("This backup is for the %s network, but this node is configured for %s.", manifest.Network, network)
+	}
+
+	// Make sure the wallet in the archive belongs to the node in the manifest
+	nodeAddress, err := getBackupNodeAddress(cfg, files[backup.WalletFile], files[backup.PasswordFile])
+	if err != nil {
+		return fmt.Errorf("Error loading the wallet from the backup: %w", err)
+	}
+	if nodeAddress != manifest.NodeAddress {
+		return fmt.Errorf("The wallet in this backup is for node %s, but the backup claims to be for node %s.", nodeAddress.Hex(), manifest.NodeAddress.Hex())
+	}
+
+	// Make sure the node is registered on chain
+	fmt.Println("Checking the node against the chain...")
+	if err := cliutils.CheckClientStatus(rp); err != nil {
+		return err
+	}
+	nodeCheck, err := rp.CheckBackupNode(nodeAddress)
+	if err != nil {
+		return err
+	}
+	if !nodeCheck.Registered {
+		return fmt.Errorf("Node %s is not registered with Rocket Pool on %s; refusing to restore it.", nodeAddress.Hex(), network)
+	}
+	fmt.Printf("Node %s is registered and has %d minipool(s).\n\n", nodeAddress.Hex(), nodeCheck.MinipoolCount)
+
+	// Check for an existing wallet
+	walletStatus, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if walletStatus.WalletInitialized && walletStatus.AccountAddress != nodeAddress {
+		fmt.Printf("%sWARNING: this machine already has a wallet for a different node (%s). Restoring this backup will replace it.%s\n\n", colorRed, walletStatus.AccountAddress.Hex(), colorReset)
+	}
+
+	// Load the restored settings, keeping this machine's data path
+	var restoredCfg *config.RocketPoolConfig
+	passwordBackend := cfg.Smartnode.GetPasswordBackend()
+	if settingsBytes, exists := files[backup.SettingsFile]; exists {
+		restoredCfg, err = loadBackupConfig(settingsBytes)
+		if err != nil {
+			return err
+		}
+		restoredCfg.RocketPoolDirectory = cfg.RocketPoolDirectory
+		restoredCfg.Smartnode.DataPath.Value = cfg.Smartnode.DataPath.Value
+		passwordBackend = restoredCfg.Smartnode.GetPasswordBackend()
+	}
+
+	// The password file is only used by the File backend, so it isn't restored for the others
+	_, hasPassword := files[backup.PasswordFile]
+	restorePassword := hasPassword && passwordBackend == cfgtypes.PasswordBackend_File
+
+	// Prompt for confirmation
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if name == backup.PasswordFile && !restorePassword {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Println("The following files will be restored, overwriting any existing copies:")
+	for _, name := range names {
+		fmt.Printf("\t%s\n", name)
+	}
+	fmt.Println()
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to restore this backup?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Stage the data files next to their targets, so nothing is replaced unless all of them can be written
+	stagedPaths := map[string]string{}
+	removeStaged := func() {
+		for stagedPath := range stagedPaths {
+			os.Remove(stagedPath)
+		}
+	}
+	for _, name := range names {
+		relativePath, isDataFile := backup.GetDataFolderPath(name)
+		if !isDataFile {
+			continue
+		}
+		mode := fs.FileMode(backup.FileMode)
+		if filepath.Dir(name) == backup.FeeRecipientFolder {
+			mode = rocketpool.FileMode
+		}
+		targetPath := filepath.Join(dataPath, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(targetPath), backupDirMode); err != nil {
+			removeStaged()
+			return fmt.Errorf("Error creating folder for %s: %w", targetPath, err)
+		}
+		stagedPath := targetPath + ".restore"
+		if err := ioutil.WriteFile(stagedPath, files[name], mode); err != nil {
+			removeStaged()
+			return fmt.Errorf("Error restoring %s: %w", targetPath, err)
+		}
+		stagedPaths[stagedPath] = targetPath
+	}
+
+	// Move the staged files into place, then save the settings
+	for stagedPath, targetPath := range stagedPaths {
+		if err := os.Rename(stagedPath, targetPath); err != nil {
+			removeStaged()
+			return fmt.Errorf("Error restoring %s: %w; the backup was only partially restored, please run this command again", targetPath, err)
+		}
+		delete(stagedPaths, stagedPath)
+	}
+	if restoredCfg != nil {
+		if err := rp.SaveConfig(restoredCfg); err != nil {
+			return fmt.Errorf("Error saving restored settings: %w; the data files were restored, please run this command again", err)
+		}
+	}
+
+	// Log & return
+	fmt.Printf("%sThe backup was successfully restored.%s\n", colorGreen, colorReset)
+	if !hasPassword {
+		fmt.Printf("%sThe backup did not contain the wallet password. Provide it through your '%s' password backend, or run `rocketpool wallet unlock`, before using the node.%s\n", colorYellow, passwordBackend, colorReset)
+	} else if !restorePassword {
+		fmt.Printf("%sThe wallet password in the backup was not written to disk because the '%s' password backend is selected. Provide it through that backend, or run `rocketpool wallet unlock`, before using the node.%s\n", colorYellow, passwordBackend, colorReset)
+	}
+	fmt.Println("Please run `rocketpool wallet rebuild` to regenerate your validator keystores, then `rocketpool service start` to apply the restored settings.")
+	if _, exists := files[backup.SlashingProtectionFile]; exists {
+		relativePath, _ := backup.GetDataFolderPath(backup.SlashingProtectionFile)
+		fmt.Printf("%sThe slashing protection export was restored to %s. Import it into your Validator Client before it starts validating.%s\n", colorYellow, filepath.Join(dataPath, relativePath), colorReset)
+	}
+	return nil
+
+}
+
+// Get the expanded host paths of the config folder and the data folder
+func getBackupPaths(c *cli.Context, cfg *config.RocketPoolConfig) (string, string, error) {
+	configPath, err := homedir.Expand(c.GlobalString("config-path"))
+	if err != nil {
+		return "", "", fmt.Errorf("Error expanding config path: %w", err)
+	}
+	dataPath, err := homedir.Expand(cfg.Smartnode.DataPath.Value.(string))
+	if err != nil {
+		return "", "", fmt.Errorf("Error expanding data path: %w", err)
+	}
+	return configPath, dataPath, nil
+}
+
+// Read a file into the backup; missing files are only an error if they're required
+func addBackupFile(files map[string][]byte, name string, path string, required bool) error {
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) && !required {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Error reading %s: %w", path, err)
+	}
+	files[name] = contents
+	return nil
+}
+
+// Read every file in a folder into the backup, if the folder exists
+func addBackupFolder(files map[string][]byte, name string, path string) error {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() {
+		return nil
+	}
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if err := addBackupFile(files, name+"/"+entry.Name(), filepath.Join(path, entry.Name()), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load the node wallet from a backup and get its address
+func getBackupNodeAddress(cfg *config.RocketPoolConfig, walletBytes []byte, passwordBytes []byte) (common.Address, error) {
+
+	if walletBytes == nil {
+		return common.Address{}, fmt.Errorf("the backup does not contain a wallet")
+	}
+
+	// Prompt for the password if it isn't stored in a file
+	if passwordBytes == nil {
+		passwordBytes = []byte(cliutils.PromptPassword("Please enter the node wallet's password:", "^.+$", "Please enter the node wallet's password:"))
+	}
+
+	// Write them to a temporary folder so the wallet can load them
+	tempDir, err := ioutil.TempDir("", "rocketpool-restore")
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error creating temporary folder: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+	walletPath := filepath.Join(tempDir, "wallet")
+	passwordPath := filepath.Join(tempDir, "password")
+	if err := ioutil.WriteFile(walletPath, walletBytes, backup.FileMode); err != nil {
+		return common.Address{}, fmt.Errorf("error writing temporary wallet: %w", err)
+	}
+	if err := ioutil.WriteFile(passwordPath, passwordBytes, backup.FileMode); err != nil {
+		return common.Address{}, fmt.Errorf("error writing temporary password: %w", err)
+	}
+
+	// Load the wallet
+	w, err := wallet.NewWallet(walletPath, cfg.Smartnode.GetChainID(), nil, nil, 0, passwords.NewPasswordManager(passwordPath))
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !w.IsInitialized() {
+		return common.Address{}, fmt.Errorf("the wallet could not be loaded")
+	}
+	account, err := w.GetNodeAccount()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return account.Address, nil
+
+}
+
+// Deserialize the settings stored in a backup
+func loadBackupConfig(settingsBytes []byte) (*config.RocketPoolConfig, error) {
+	tempFile, err := ioutil.TempFile("", "rocketpool-settings")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary settings file: %w", err)
+	}
+	defer os.Remove(tempFile.Name())
+	if _, err := tempFile.Write(settingsBytes); err != nil {
+		tempFile.Close()
+		return nil, fmt.Errorf("error writing temporary settings file: %w", err)
+	}
+	tempFile.Close()
+
+	cfg, err := rputils.LoadConfigFromFile(tempFile.Name())
+	if err != nil {
+		return nil, fmt.Errorf("error loading settings from the backup: %w", err)
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("the backup's settings file is empty")
+	}
+	return cfg, nil
+}
+
+// Prompt for the passphrase used to encrypt a backup
+func promptBackupPassphrase(confirm bool) string {
+	for {
+		passphrase := cliutils.PromptPassword(
+			"Please enter the backup passphrase:",
+			fmt.Sprintf("^.{%d,}$", passwords.MinPasswordLength),
+			fmt.Sprintf("The passphrase must be at least %d characters long. Please try again:", passwords.MinPasswordLength),
+		)
+		if !confirm {
+			return passphrase
+		}
+		confirmation := cliutils.PromptPassword("Please confirm the backup passphrase:", "^.*$", "")
+		if passphrase == confirmation {
+			return passphrase
+		}
+		fmt.Println("Passphrase confirmation does not match.")
+		fmt.Println("")
+	}
+}
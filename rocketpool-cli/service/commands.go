@@ -440,6 +440,54 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "backup",
+				Usage:     "Create an encrypted backup of your node wallet, password, custom validator keys, fee recipient files, watchtower state, and settings",
+				UsageText: "rocketpool service backup [options] backup-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "slashing-protection, s",
+						Usage: "The path to a slashing protection (EIP-3076) file exported from your Validator Client to include in the backup",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					archivePath := c.Args().Get(0)
+
+					// Run command
+					return backupNode(c, archivePath)
+
+				},
+			},
+
+			{
+				Name:      "restore",
+				Usage:     "Restore your node wallet, password, custom validator keys, fee recipient files, watchtower state, and settings from an encrypted backup",
+				UsageText: "rocketpool service restore [options] backup-file",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm restoring the backup",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					archivePath := c.Args().Get(0)
+
+					// Run command
+					return restoreNode(c, archivePath)
+
+				},
+			},
+
 			{
 				Name:      "resync-eth1",
 				Usage:     fmt.Sprintf("%sDeletes the main ETH1 client's chain data and resyncs it from scratch. Only use this as a last resort!%s", colorRed, colorReset),
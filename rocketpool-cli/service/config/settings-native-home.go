@@ -11,17 +11,18 @@ const settingsNativeHomeID string = "settings-native-home"
 
 // This is a container for the primary settings category selection home screen.
 type settingsNativeHome struct {
-	homePage         *page
-	saveButton       *tview.Button
-	wizardButton     *tview.Button
-	smartnodePage    *NativeSmartnodeConfigPage
-	nativePage       *NativePage
-	fallbackPage     *NativeFallbackConfigPage
-	metricsPage      *NativeMetricsConfigPage
-	categoryList     *tview.List
-	settingsSubpages []*page
-	content          tview.Primitive
-	md               *mainDisplay
+	homePage          *page
+	saveButton        *tview.Button
+	wizardButton      *tview.Button
+	smartnodePage     *NativeSmartnodeConfigPage
+	nativePage        *NativePage
+	fallbackPage      *NativeFallbackConfigPage
+	metricsPage       *NativeMetricsConfigPage
+	notificationsPage *NativeNotificationsConfigPage
+	categoryList      *tview.List
+	settingsSubpages  []*page
+	content           tview.Primitive
+	md                *mainDisplay
 }
 
 // Creates a new SettingsNativeHome instance and adds (and its subpages) it to the main display.
@@ -40,11 +41,13 @@ func newSettingsNativeHome(md *mainDisplay) *settingsNativeHome {
 	home.nativePage = NewNativePage(home)
 	home.fallbackPage = NewNativeFallbackConfigPage(home)
 	home.metricsPage = NewNativeMetricsConfigPage(home)
+	home.notificationsPage = NewNativeNotificationsConfigPage(home)
 	settingsSubpages := []*page{
 		home.smartnodePage.page,
 		home.nativePage.page,
 		home.fallbackPage.page,
 		home.metricsPage.page,
+		home.notificationsPage.page,
 	}
 	home.settingsSubpages = settingsSubpages
 
@@ -34,6 +34,30 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "unlock",
+				Aliases:   []string{"u"},
+				Usage:     "Unlock the node wallet when using the interactive unlock password backend",
+				UsageText: "rocketpool wallet unlock [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "password, p",
+						Usage: "The node wallet's password",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return unlockWallet(c)
+
+				},
+			},
+
 			{
 				Name:      "init",
 				Aliases:   []string{"i"},
@@ -48,6 +72,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "confirm-mnemonic, c",
 						Usage: "Automatically confirm the mnemonic phrase",
 					},
+					cli.StringFlag{
+						Name:  "split-mnemonic, s",
+						Usage: "Split the mnemonic into SLIP-39 shares instead of printing it, in the form M-of-N (e.g. 3-of-5 means any 3 of 5 shares can recover the wallet)",
+					},
 					cli.StringFlag{
 						Name:  "derivation-path, d",
 						Usage: "Specify the derivation path for the wallet.\nOmit this flag (or leave it blank) for the default of \"m/44'/60'/0'/0/%d\" (where %d is the index).\nSet this to \"ledgerLive\" to use Ledger Live's path of \"m/44'/60'/%d/0/0\".\nSet this to \"mew\" to use MyEtherWallet's path of \"m/44'/60'/0'/%d\".\nFor custom paths, simply enter them here.",
@@ -67,6 +95,12 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						}
 					}
 
+					if c.String("split-mnemonic") != "" {
+						if _, _, err := parseShareScheme(c.String("split-mnemonic")); err != nil {
+							return err
+						}
+					}
+
 					// Run
 					return initWallet(c)
 
@@ -87,6 +121,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "mnemonic, m",
 						Usage: "The mnemonic phrase to recover the wallet from",
 					},
+					cli.BoolFlag{
+						Name:  "shares",
+						Usage: "Recover the wallet from SLIP-39 mnemonic shares instead of the mnemonic phrase",
+					},
 					cli.BoolFlag{
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
@@ -104,6 +142,16 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "address, a",
 						Usage: "If you are recovering a wallet that was not generated by the Smartnode and don't know the derivation path or index of it, enter the address here. The Smartnode will search through its library of paths and indices to try to find it.",
 					},
+					cli.UintFlag{
+						Name:  "search-start-index",
+						Usage: "When searching for a wallet with --address, the first wallet index to check on each derivation path",
+						Value: 0,
+					},
+					cli.UintFlag{
+						Name:  "search-end-index",
+						Usage: "When searching for a wallet with --address, the wallet index to stop at (exclusive). Omit this to search 100000 indices past the start index.",
+						Value: 0,
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -123,6 +171,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 							return err
 						}
 					}
+					if c.String("mnemonic") != "" && c.Bool("shares") {
+						return fmt.Errorf("Only one of --mnemonic and --shares can be used")
+					}
 
 					// Run
 					return recoverWallet(c)
@@ -158,6 +209,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "mnemonic, m",
 						Usage: "The mnemonic phrase to recover the wallet from",
 					},
+					cli.BoolFlag{
+						Name:  "shares",
+						Usage: "Recover the wallet from SLIP-39 mnemonic shares instead of the mnemonic phrase",
+					},
 					cli.BoolFlag{
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
@@ -175,6 +230,16 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "address, a",
 						Usage: "If you are recovering a wallet that was not generated by the Smartnode and don't know the derivation path or index of it, enter the address here. The Smartnode will search through its library of paths and indices to try to find it.",
 					},
+					cli.UintFlag{
+						Name:  "search-start-index",
+						Usage: "When searching for a wallet with --address, the first wallet index to check on each derivation path",
+						Value: 0,
+					},
+					cli.UintFlag{
+						Name:  "search-end-index",
+						Usage: "When searching for a wallet with --address, the wallet index to stop at (exclusive). Omit this to search 100000 indices past the start index.",
+						Value: 0,
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -189,6 +254,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 							return err
 						}
 					}
+					if c.String("mnemonic") != "" && c.Bool("shares") {
+						return fmt.Errorf("Only one of --mnemonic and --shares can be used")
+					}
 
 					// Run
 					return testRecovery(c)
@@ -196,6 +264,109 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "split-mnemonic",
+				Usage:     "Split a mnemonic phrase into M-of-N SLIP-39 shares",
+				UsageText: "rocketpool wallet split-mnemonic --shares M-of-N [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "shares, s",
+						Usage: "The share scheme in the form M-of-N (e.g. 3-of-5 means any 3 of 5 shares can recover the wallet)",
+					},
+					cli.StringFlag{
+						Name:  "mnemonic, m",
+						Usage: "The mnemonic phrase to split",
+					},
+					cli.BoolFlag{
+						Name:  "confirm-shares, c",
+						Usage: "Automatically confirm the shares",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("shares") == "" {
+						return fmt.Errorf("The --shares flag is required")
+					}
+					if _, _, err := parseShareScheme(c.String("shares")); err != nil {
+						return err
+					}
+					if c.String("mnemonic") != "" {
+						if _, err := cliutils.ValidateWalletMnemonic("mnemonic", c.String("mnemonic")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return splitMnemonicShares(c)
+
+				},
+			},
+
+			{
+				Name:      "verify-shares",
+				Usage:     "Check that a set of SLIP-39 mnemonic shares is valid and show the node account it recovers, without using the daemon",
+				UsageText: "rocketpool wallet verify-shares [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "derivation-path, d",
+						Usage: "Specify the derivation path for the wallet.\nOmit this flag (or leave it blank) for the default of \"m/44'/60'/0'/0/%d\" (where %d is the index).\nSet this to \"ledgerLive\" to use Ledger Live's path of \"m/44'/60'/%d/0/0\".\nSet this to \"mew\" to use MyEtherWallet's path of \"m/44'/60'/0'/%d\".\nFor custom paths, simply enter them here.",
+					},
+					cli.UintFlag{
+						Name:  "wallet-index, i",
+						Usage: "Specify the index to use with the derivation path",
+						Value: 0,
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return verifyShares(c)
+
+				},
+			},
+
+			{
+				Name:      "import-validator-key",
+				Usage:     "Import an externally created EIP-2335 validator keystore for one of your minipools",
+				UsageText: "rocketpool wallet import-validator-key [options] keystore-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "password, p",
+						Usage: "The password the keystore was encrypted with",
+					},
+					cli.BoolFlag{
+						Name:  "no-restart",
+						Usage: "Don't load the key into the Validator Client after importing it",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm that the key is no longer being used for validation anywhere else",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return importValidatorKey(c, c.Args().Get(0))
+
+				},
+			},
+
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
@@ -230,6 +401,68 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "set-offline-address",
+				Usage:     "Make the node wallet watch-only for the given node address, whose key is kept on an offline machine",
+				UsageText: "rocketpool wallet set-offline-address [options] address",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm setting the offline node address",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					address, err := cliutils.ValidateAddress("node address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					return setOfflineAddress(c, address)
+
+				},
+			},
+
+			{
+				Name:      "sign-offline",
+				Usage:     "Sign a bundle of transactions, deposits and exits that was built on an online machine with `--offline`",
+				UsageText: "rocketpool wallet sign-offline [options] bundle-file-or-payload",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "out, o",
+						Usage: "The `file` to save the signed bundle to (defaults to 'signed-' followed by the bundle's filename)",
+					},
+					cli.BoolFlag{
+						Name:  "payload",
+						Usage: "Print the signed bundle as a single line of text (e.g. for a QR code)",
+					},
+					cli.StringFlag{
+						Name:  "keystore-password, p",
+						Usage: "The password to export the validator keys of the bundle's deposits with",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the bundle",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return signOffline(c, c.Args().Get(0))
+
+				},
+			},
 		},
 	})
 }
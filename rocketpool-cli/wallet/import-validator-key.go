@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func importValidatorKey(c *cli.Context, keystorePath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(rp)
+	if err != nil {
+		return err
+	}
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Read the keystore
+	keystoreBytes, err := ioutil.ReadFile(keystorePath)
+	if err != nil {
+		return fmt.Errorf("Error reading keystore %s: %w", keystorePath, err)
+	}
+	keystore := api.ValidatorKeystore{}
+	if err := json.Unmarshal(keystoreBytes, &keystore); err != nil {
+		return fmt.Errorf("Error deserializing keystore %s: %w", keystorePath, err)
+	}
+	compactKeystore := new(bytes.Buffer)
+	if err := json.Compact(compactKeystore, keystoreBytes); err != nil {
+		return fmt.Errorf("Error deserializing keystore %s: %w", keystorePath, err)
+	}
+	fmt.Printf("Importing the keystore for validator %s.\n\n", keystore.Pubkey.Hex())
+
+	// Prompt the user with a warning message
+	fmt.Printf("%sWARNING:\nIf this key was actively used for validation by another machine or a service such as Allnodes, you MUST CONFIRM that it has stopped validating with it and will NEVER validate with it again.\nOtherwise, both will run the same key at the same time which WILL RESULT IN YOUR VALIDATOR BEING SLASHED.%s\n\n", colorRed, colorReset)
+	if !(c.Bool("yes") || cliutils.Confirm("Please confirm that this key is no longer being used for validation anywhere else, and you have manually confirmed on a Blockchain explorer such as https://beaconcha.in that the validator is no longer attesting.")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Get the keystore password
+	password := c.String("password")
+	if password == "" {
+		password = cliutils.PromptPassword(fmt.Sprintf("Please enter the password that the keystore for %s was encrypted with:", keystore.Pubkey.Hex()), "^.*$", "")
+	}
+
+	// Import the key
+	response, err := rp.ImportValidatorKey(compactKeystore.String(), password, !c.Bool("no-restart"))
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Successfully imported the key for validator %s (minipool %s).\n", response.Pubkey.Hex(), response.MinipoolAddress.Hex())
+	fmt.Println("It has been stored for every Validator Client and will be restored by `rocketpool wallet rebuild` and `rocketpool wallet recover`.")
+	if response.RestartedValidator {
+		fmt.Println("It has been loaded into your Validator Client.")
+	} else {
+		fmt.Println("Please restart your Validator Client to load it.")
+	}
+	return nil
+
+}
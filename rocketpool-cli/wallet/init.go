@@ -12,6 +12,17 @@ import (
 
 func initWallet(c *cli.Context) error {
 
+	// Get the share scheme
+	shareScheme := c.String("split-mnemonic")
+	var shareThreshold, shareCount int
+	if shareScheme != "" {
+		var err error
+		shareThreshold, shareCount, err = parseShareScheme(shareScheme)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c)
 	if err != nil {
@@ -60,19 +71,37 @@ func initWallet(c *cli.Context) error {
 		return err
 	}
 
-	// Print mnemonic
-	fmt.Println("Your mnemonic phrase to recover your wallet is printed below. It can be used to recover your node account and validator keys if they are lost.")
-	fmt.Println("Record this phrase somewhere secure and private. Do not share it with anyone as it will give them control of your node account and validators.")
-	fmt.Println("==============================================================================================================================================")
-	fmt.Println("")
-	fmt.Println(response.Mnemonic)
-	fmt.Println("")
-	fmt.Println("==============================================================================================================================================")
-	fmt.Println("")
-
-	// Confirm mnemonic
-	if !c.Bool("confirm-mnemonic") {
-		confirmMnemonic(response.Mnemonic)
+	if shareScheme != "" {
+
+		// Split the mnemonic into shares and print them instead
+		shares, err := splitMnemonic(response.Mnemonic, shareThreshold, shareCount)
+		if err != nil {
+			return err
+		}
+		printShares(shares, shareThreshold)
+
+		// Confirm shares
+		if !c.Bool("confirm-mnemonic") {
+			confirmShares(response.Mnemonic)
+		}
+
+	} else {
+
+		// Print mnemonic
+		fmt.Println("Your mnemonic phrase to recover your wallet is printed below. It can be used to recover your node account and validator keys if they are lost.")
+		fmt.Println("Record this phrase somewhere secure and private. Do not share it with anyone as it will give them control of your node account and validators.")
+		fmt.Println("==============================================================================================================================================")
+		fmt.Println("")
+		fmt.Println(response.Mnemonic)
+		fmt.Println("")
+		fmt.Println("==============================================================================================================================================")
+		fmt.Println("")
+
+		// Confirm mnemonic
+		if !c.Bool("confirm-mnemonic") {
+			confirmMnemonic(response.Mnemonic)
+		}
+
 	}
 
 	// Do a recover to save the wallet
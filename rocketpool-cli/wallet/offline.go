@@ -0,0 +1,202 @@
+package wallet
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/offline"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+// The default filename of a signed bundle when the unsigned one was provided as a payload
+const defaultSignedBundleFilename string = "signed-bundle.json"
+
+func setOfflineAddress(c *cli.Context, address common.Address) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to use %s as the node address, and sign its transactions on an offline machine?", address.Hex()))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Set the address
+	if _, err := rp.SetOfflineNodeAddress(address); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("The node wallet is now watch-only for %s.\n", address.Hex())
+	fmt.Println("Add `--offline <file>` to supported commands to build their transactions, sign them on the offline machine with `rocketpool wallet sign-offline`, and broadcast the result with `rocketpool node broadcast`.")
+	return nil
+
+}
+
+func signOffline(c *cli.Context, source string) error {
+
+	// Load the unsigned bundle
+	bundle, err := offline.LoadUnsignedBundle(source)
+	if err != nil {
+		return err
+	}
+	if len(bundle.Transactions) == 0 && len(bundle.Deposits) == 0 && len(bundle.Exits) == 0 {
+		fmt.Println("The offline bundle does not contain any transactions, deposits or exits.")
+		return nil
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the config to check the bundle against
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if isNew {
+		return fmt.Errorf("Settings file not found. Please run `rocketpool service config` to set up your Smartnode.")
+	}
+	knownContracts := offline.GetKnownContracts(cfg)
+
+	// Print what the bundle will sign
+	fmt.Printf("The offline bundle for node %s on chain %d contains:\n\n", bundle.NodeAddress.Hex(), bundle.ChainID)
+	for _, tx := range bundle.Transactions {
+		fmt.Printf("%sTransaction: %s%s\n", colorGreen, tx.Description, colorReset)
+		if err := printUnsignedTransaction(tx, knownContracts); err != nil {
+			return err
+		}
+	}
+	for _, deposit := range bundle.Deposits {
+		fmt.Printf("%sDeposit: %s%s\n", colorGreen, deposit.Description, colorReset)
+		if err := deposit.Check(cfg.Smartnode.GetGenesisForkVersion()); err != nil {
+			return err
+		}
+		if err := printUnsignedTransaction(&deposit.UnsignedTransaction, knownContracts); err != nil {
+			return err
+		}
+		fmt.Printf("\tMinipool:   %s\n", deposit.MinipoolAddress.Hex())
+		fmt.Printf("\tMin fee:    %f%%\n", deposit.MinNodeFee*100)
+		fmt.Println("\tA new validator key will be created for the deposit.")
+		fmt.Println()
+	}
+	for _, exit := range bundle.Exits {
+		fmt.Printf("%sExit of minipool %s%s\n", colorGreen, exit.MinipoolAddress.Hex(), colorReset)
+		fmt.Printf("\tValidator:  %d (%s)\n", exit.ValidatorIndex, exit.Pubkey.Hex())
+		fmt.Printf("\tEpoch:      %d\n", exit.Epoch)
+		fmt.Println()
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to sign everything in the offline bundle?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Get the password to export the validator keys of the deposits with
+	keystorePassword := c.String("keystore-password")
+	if len(bundle.Deposits) > 0 && keystorePassword == "" {
+		keystorePassword = cliutils.PromptPassword(
+			"Please enter a password to export the new validator keys with (this will be needed to import them on the online machine):",
+			"^.{12,}$",
+			"The password must be at least 12 characters long:",
+		)
+	}
+
+	// Sign the bundle
+	response, err := rp.SignOfflineBundle(bundle, keystorePassword)
+	if err != nil {
+		return err
+	}
+
+	// Save the signed bundle
+	outPath := c.String("out")
+	if outPath == "" && !c.Bool("payload") {
+		if strings.HasPrefix(source, offline.PayloadPrefix) {
+			outPath = defaultSignedBundleFilename
+		} else {
+			outPath = filepath.Join(filepath.Dir(source), "signed-"+filepath.Base(source))
+		}
+	}
+	if outPath != "" {
+		if err := response.Bundle.Save(outPath); err != nil {
+			return err
+		}
+		fmt.Printf("Saved the signed bundle to %s.\n", outPath)
+	}
+
+	// Print the payload if requested
+	if c.Bool("payload") {
+		payload, err := offline.EncodePayload(response.Bundle)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\nSigned bundle payload:\n%s\n\n", payload)
+	}
+
+	// Log & return
+	if len(bundle.Deposits) > 0 {
+		fmt.Printf("%sThe new validator keys were saved to this machine's wallet. Back up its mnemonic if you haven't already!%s\n", colorYellow, colorReset)
+	}
+	fmt.Println("Broadcast the signed bundle from the online machine with `rocketpool node broadcast`.")
+	return nil
+
+}
+
+// Print the details of an unsigned transaction
+func printUnsignedTransaction(tx *offline.UnsignedTransaction, knownContracts map[common.Address]string) error {
+	if tx.To != nil {
+		fmt.Printf("\tTo:         %s\n", tx.To.Hex())
+	}
+	if tx.Value != nil && tx.Value.Sign() > 0 {
+		fmt.Printf("\tValue:      %.6f ETH\n", math.RoundDown(eth.WeiToEth(tx.Value), 6))
+	}
+	fmt.Printf("\tNonce:      %d\n", tx.Nonce)
+	fmt.Printf("\tGas limit:  %d\n", tx.GasLimit)
+	if tx.MaxFee != nil && tx.MaxPriorityFee != nil {
+		fmt.Printf("\tMax fee:    %.6f gwei (%.6f gwei priority)\n", eth.WeiToGwei(tx.MaxFee), eth.WeiToGwei(tx.MaxPriorityFee))
+	}
+
+	// Decode the call so the user can check what they're signing
+	if tx.Call == nil {
+		if len(tx.Data) > 0 {
+			fmt.Printf("\tData:       %s\n", tx.Data.String())
+		}
+		fmt.Println()
+		return nil
+	}
+	fmt.Printf("\tCall:       %s.%s\n", tx.Call.Contract, tx.Call.Method)
+	verified, err := tx.CheckContract(knownContracts)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		fmt.Printf("\t%sThe contract name comes from the online machine and can't be verified offline; make sure %s is the %s contract.%s\n", colorYellow, tx.To.Hex(), tx.Call.Contract, colorReset)
+	}
+	if len(tx.Data) > 0 {
+		args, err := tx.Call.Decode(tx.Data)
+		if err != nil {
+			return fmt.Errorf("Could not decode transaction '%s': %w", tx.Description, err)
+		}
+		for _, arg := range args {
+			fmt.Printf("\t\t%s (%s): %s\n", arg.Name, arg.Type, arg.Value)
+		}
+	}
+	fmt.Println()
+	return nil
+}
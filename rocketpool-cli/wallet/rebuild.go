@@ -2,7 +2,6 @@ package wallet
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/urfave/cli"
 
@@ -48,17 +47,7 @@ func rebuildWallet(c *cli.Context) error {
 	}
 	if customKeyPasswordFile != "" {
 		// Defer deleting the custom keystore password file
-		defer func(customKeyPasswordFile string) {
-			_, err := os.Stat(customKeyPasswordFile)
-			if os.IsNotExist(err) {
-				return
-			}
-
-			err = os.Remove(customKeyPasswordFile)
-			if err != nil {
-				fmt.Printf("*** WARNING ***\nAn error occurred while removing the custom keystore password file: %s\n\nThis file contains the passwords to your custom validator keys.\nYou *must* delete it manually as soon as possible so nobody can read it.\n\nThe file is located here:\n\n\t%s\n\n", err.Error(), customKeyPasswordFile)
-			}
-		}(customKeyPasswordFile)
+		defer deleteCustomKeyPasswordFile(rp, customKeyPasswordFile)
 	}
 
 	// Log
@@ -2,13 +2,13 @@ package wallet
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -54,13 +54,10 @@ func recoverWallet(c *cli.Context) error {
 	}
 
 	// Prompt for mnemonic
-	var mnemonic string
-	if c.String("mnemonic") != "" {
-		mnemonic = c.String("mnemonic")
-	} else {
-		mnemonic = promptMnemonic()
+	mnemonic, err := getRecoveryMnemonic(c)
+	if err != nil {
+		return err
 	}
-	mnemonic = strings.TrimSpace(mnemonic)
 
 	// Handle validator key recovery skipping
 	skipValidatorKeyRecovery := c.Bool("skip-validator-key-recovery")
@@ -73,17 +70,7 @@ func recoverWallet(c *cli.Context) error {
 		}
 		if customKeyPasswordFile != "" {
 			// Defer deleting the custom keystore password file
-			defer func(customKeyPasswordFile string) {
-				_, err := os.Stat(customKeyPasswordFile)
-				if os.IsNotExist(err) {
-					return
-				}
-
-				err = os.Remove(customKeyPasswordFile)
-				if err != nil {
-					fmt.Printf("*** WARNING ***\nAn error occurred while removing the custom keystore password file: %s\n\nThis file contains the passwords to your custom validator keys.\nYou *must* delete it manually as soon as possible so nobody can read it.\n\nThe file is located here:\n\n\t%s\n\n", err.Error(), customKeyPasswordFile)
-				}
-			}(customKeyPasswordFile)
+			defer deleteCustomKeyPasswordFile(rp, customKeyPasswordFile)
 		}
 	}
 
@@ -93,7 +80,18 @@ func recoverWallet(c *cli.Context) error {
 
 		// Get the address to search for
 		address := common.HexToAddress(addressString)
+		startIndex := c.Uint("search-start-index")
+		endIndex := c.Uint("search-end-index")
+		if endIndex != 0 && endIndex <= startIndex {
+			return fmt.Errorf("search end index %d must be greater than search start index %d", endIndex, startIndex)
+		}
 		fmt.Printf("Searching for the derivation path and index for wallet %s...\nNOTE: this may take several minutes depending on how large your wallet's index is.\n", address.Hex())
+		fmt.Printf("Derivation paths searched: %s\n", strings.Join(wallet.KnownNodeKeyPaths, ", "))
+		if endIndex != 0 {
+			fmt.Printf("Wallet indices searched:   %d to %d\n", startIndex, endIndex-1)
+		} else {
+			fmt.Printf("Wallet indices searched:   starting at %d\n", startIndex)
+		}
 
 		// Log
 		if skipValidatorKeyRecovery {
@@ -107,7 +105,7 @@ func recoverWallet(c *cli.Context) error {
 		}
 
 		// Recover wallet
-		response, err := rp.SearchAndRecoverWallet(mnemonic, address, skipValidatorKeyRecovery)
+		response, err := rp.SearchAndRecoverWallet(mnemonic, address, skipValidatorKeyRecovery, startIndex, endIndex)
 		if err != nil {
 			return err
 		}
@@ -119,6 +117,7 @@ func recoverWallet(c *cli.Context) error {
 		fmt.Printf("Node account:    %s\n", response.AccountAddress.Hex())
 		if !skipValidatorKeyRecovery {
 			if len(response.ValidatorKeys) > 0 {
+				fmt.Printf("All %d validator keys derived from this wallet match the node's minipools on chain.\n", len(response.ValidatorKeys))
 				fmt.Println("Validator keys:")
 				for _, key := range response.ValidatorKeys {
 					fmt.Println(key.Hex())
@@ -0,0 +1,161 @@
+package wallet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/wallet/slip39"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Matches an M-of-N share scheme such as "3-of-5"
+var shareSchemeRegex = regexp.MustCompile(`^(\d+)-of-(\d+)$`)
+
+// Parse an M-of-N share scheme into its threshold and share count
+func parseShareScheme(scheme string) (int, int, error) {
+	matches := shareSchemeRegex.FindStringSubmatch(strings.TrimSpace(scheme))
+	if matches == nil {
+		return 0, 0, fmt.Errorf("Invalid share scheme '%s' - it must be in the form M-of-N, such as 3-of-5", scheme)
+	}
+	threshold, _ := strconv.Atoi(matches[1])
+	count, _ := strconv.Atoi(matches[2])
+	if threshold < 2 || count < threshold || count > 16 {
+		return 0, 0, fmt.Errorf("Invalid share scheme '%s' - it requires a threshold of at least 2 and at most 16 shares", scheme)
+	}
+	return threshold, count, nil
+}
+
+// Split a mnemonic's entropy into SLIP-39 shares
+func splitMnemonic(mnemonic string, threshold int, count int) ([]string, error) {
+	entropy, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid mnemonic: %w", err)
+	}
+	shares, err := slip39.GenerateMnemonics(1, []slip39.GroupSpec{{Threshold: threshold, Count: count}}, entropy, nil, slip39.DefaultIterationExponent)
+	if err != nil {
+		return nil, fmt.Errorf("Error splitting mnemonic: %w", err)
+	}
+	return shares[0], nil
+}
+
+// Reassemble a mnemonic from its SLIP-39 shares
+func combineShares(shares []string) (string, error) {
+	entropy, err := slip39.CombineMnemonics(shares, nil)
+	if err != nil {
+		return "", fmt.Errorf("Error combining shares: %w", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", fmt.Errorf("Error recreating mnemonic from shares: %w", err)
+	}
+	return mnemonic, nil
+}
+
+// Print each share with instructions
+func printShares(shares []string, threshold int) {
+	fmt.Printf("Your mnemonic has been split into %d shares, any %d of which can recover your node account and validator keys.\n", len(shares), threshold)
+	fmt.Println("Give each share to a different person or store it in a different secure location. Fewer than the threshold reveal nothing about your wallet.")
+	fmt.Println("==============================================================================================================================================")
+	for i, share := range shares {
+		fmt.Println("")
+		fmt.Printf("%sShare %d of %d:%s\n", bold, i+1, len(shares), unbold)
+		fmt.Println(share)
+	}
+	fmt.Println("")
+	fmt.Println("==============================================================================================================================================")
+	fmt.Println("")
+}
+
+// Prompt for shares one at a time until enough have been entered to recover the mnemonic
+func promptShares() []string {
+	shares := []string{}
+	threshold := 0
+	for threshold == 0 || len(shares) < threshold {
+		var prompt string
+		if threshold == 0 {
+			prompt = "Please enter one of your mnemonic shares:"
+		} else {
+			prompt = fmt.Sprintf("Please enter share %d of the %d required:", len(shares)+1, threshold)
+		}
+		input := cliutils.PromptPassword(prompt, "^[a-zA-Z ]+$", "Please enter the share's words separated by spaces.")
+		share, err := slip39.ParseShare(input)
+		if err != nil {
+			fmt.Printf("That share is not valid: %s\nPlease try again.\n\n", err.Error())
+			continue
+		}
+		if share.GroupThreshold != 1 || share.GroupCount != 1 {
+			fmt.Println("Shares with multiple groups are not supported. Please try again.")
+			fmt.Println("")
+			continue
+		}
+		threshold = int(share.MemberThreshold)
+		shares = append(shares, input)
+	}
+	return shares
+}
+
+// Confirm that the user has recorded enough shares to recover the mnemonic
+func confirmShares(mnemonic string) {
+	for {
+		fmt.Println("Please enter enough of your shares to confirm they recover your mnemonic.")
+		recovered, err := combineShares(promptShares())
+		if err == nil && recovered == mnemonic {
+			return
+		}
+		if err != nil {
+			fmt.Println(err.Error())
+		}
+		fmt.Println("The shares you entered do not recover your mnemonic. Please try again.")
+		fmt.Println("")
+	}
+}
+
+// Get the mnemonic to recover from, either directly or by combining shares
+func getRecoveryMnemonic(c *cli.Context) (string, error) {
+	if c.Bool("shares") {
+		mnemonic, err := combineShares(promptShares())
+		if err != nil {
+			return "", err
+		}
+		fmt.Println("Your shares were successfully combined into your mnemonic.")
+		fmt.Println("")
+		return mnemonic, nil
+	}
+	if c.String("mnemonic") != "" {
+		return strings.TrimSpace(c.String("mnemonic")), nil
+	}
+	return strings.TrimSpace(promptMnemonic()), nil
+}
+
+// Derive the node address for a mnemonic locally, without the daemon
+func getNodeAddressForMnemonic(mnemonic string, derivationPath string, walletIndex uint) (common.Address, error) {
+	switch derivationPath {
+	case "":
+		derivationPath = wallet.DefaultNodeKeyPath
+	case "ledgerLive":
+		derivationPath = wallet.LedgerLiveNodeKeyPath
+	case "mew":
+		derivationPath = wallet.MyEtherWalletNodeKeyPath
+	}
+
+	// The chain ID is irrelevant for deriving the node address
+	w, err := wallet.NewWallet("", 0, nil, nil, 0, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if err := w.TestRecovery(derivationPath, walletIndex, mnemonic); err != nil {
+		return common.Address{}, err
+	}
+	account, err := w.GetNodeAccount()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return account.Address, nil
+}
@@ -0,0 +1,167 @@
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// Shamir secret sharing settings
+const (
+	maxShareCount int  = 16
+	digestLength  int  = 4
+	digestIndex   byte = 254
+	secretIndex   byte = 255
+)
+
+// A single point on the sharing polynomial
+type rawShare struct {
+	x    byte
+	data []byte
+}
+
+// Log and exp tables for GF(256) with the Rijndael polynomial
+var gfExp [255]byte
+var gfLog [256]int
+
+func init() {
+	poly := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(poly)
+		gfLog[poly] = i
+		// Multiply by the generator (x + 1)
+		poly = (poly << 1) ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11b
+		}
+	}
+}
+
+// Evaluate the polynomial that passes through the given shares at x
+func interpolate(shares []rawShare, x byte) ([]byte, error) {
+
+	// Check the shares
+	length := len(shares[0].data)
+	seen := map[byte]bool{}
+	for _, share := range shares {
+		if seen[share.x] {
+			return nil, errors.New("share indices must be unique")
+		}
+		seen[share.x] = true
+		if len(share.data) != length {
+			return nil, errors.New("all share values must have the same length")
+		}
+		if share.x == x {
+			return append([]byte{}, share.data...), nil
+		}
+	}
+
+	// Compute the Lagrange basis polynomials in log form
+	logProd := 0
+	for _, share := range shares {
+		logProd += gfLog[share.x^x]
+	}
+	result := make([]byte, length)
+	for _, share := range shares {
+		logBasis := logProd - gfLog[share.x^x]
+		for _, other := range shares {
+			logBasis -= gfLog[share.x^other.x]
+		}
+		logBasis = ((logBasis % 255) + 255) % 255
+		for i, value := range share.data {
+			if value != 0 {
+				result[i] ^= gfExp[(gfLog[value]+logBasis)%255]
+			}
+		}
+	}
+	return result, nil
+
+}
+
+// Get the digest used to verify a recovered secret
+func createDigest(randomData []byte, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomData)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLength]
+}
+
+// Split a secret into shareCount shares, any threshold of which can recover it
+func splitSecret(threshold int, shareCount int, secret []byte) ([]rawShare, error) {
+
+	// Check the parameters
+	if threshold < 1 {
+		return nil, errors.New("the threshold must be at least 1")
+	}
+	if threshold > shareCount {
+		return nil, fmt.Errorf("the threshold (%d) cannot exceed the share count (%d)", threshold, shareCount)
+	}
+	if shareCount > maxShareCount {
+		return nil, fmt.Errorf("the share count cannot exceed %d", maxShareCount)
+	}
+
+	// Trivial case
+	if threshold == 1 {
+		shares := make([]rawShare, shareCount)
+		for i := range shares {
+			shares[i] = rawShare{x: byte(i), data: append([]byte{}, secret...)}
+		}
+		return shares, nil
+	}
+
+	// Generate the random shares
+	randomShareCount := threshold - 2
+	shares := make([]rawShare, 0, shareCount)
+	for i := 0; i < randomShareCount; i++ {
+		data := make([]byte, len(secret))
+		if _, err := rand.Read(data); err != nil {
+			return nil, fmt.Errorf("error generating random share: %w", err)
+		}
+		shares = append(shares, rawShare{x: byte(i), data: data})
+	}
+
+	// Add the digest and secret shares that define the polynomial
+	randomPart := make([]byte, len(secret)-digestLength)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, fmt.Errorf("error generating random digest data: %w", err)
+	}
+	digest := append(createDigest(randomPart, secret), randomPart...)
+	baseShares := append([]rawShare{}, shares...)
+	baseShares = append(baseShares, rawShare{x: digestIndex, data: digest}, rawShare{x: secretIndex, data: secret})
+
+	// Evaluate the rest of the shares
+	for i := randomShareCount; i < shareCount; i++ {
+		data, err := interpolate(baseShares, byte(i))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, rawShare{x: byte(i), data: data})
+	}
+	return shares, nil
+
+}
+
+// Recover a secret from threshold shares and verify its digest
+func recoverSecret(threshold int, shares []rawShare) ([]byte, error) {
+
+	// Trivial case
+	if threshold == 1 {
+		return append([]byte{}, shares[0].data...), nil
+	}
+
+	// Recover the secret and digest
+	secret, err := interpolate(shares, secretIndex)
+	if err != nil {
+		return nil, err
+	}
+	digestShare, err := interpolate(shares, digestIndex)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(digestShare[:digestLength], createDigest(digestShare[digestLength:], secret)) {
+		return nil, errors.New("invalid digest of the shared secret; the shares do not belong together")
+	}
+	return secret, nil
+
+}
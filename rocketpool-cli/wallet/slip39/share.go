@@ -0,0 +1,231 @@
+package slip39
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Share encoding settings
+const (
+	radixBits              int = 10
+	radixSize              int = 1 << radixBits
+	idLengthBits           int = 15
+	iterationExpBits       int = 4
+	checksumLengthWords    int = 3
+	headerLengthWords      int = 4
+	minStrengthBits        int = 128
+	minMnemonicLengthWords     = headerLengthWords + checksumLengthWords + (minStrengthBits+radixBits-1)/radixBits
+)
+
+// Checksum customization strings
+const (
+	customizationString           string = "shamir"
+	customizationStringExtendable string = "shamir_extendable"
+)
+
+// A single decoded SLIP-39 share
+type Share struct {
+	Identifier        uint16
+	Extendable        bool
+	IterationExponent byte
+	GroupIndex        byte
+	GroupThreshold    byte
+	GroupCount        byte
+	MemberIndex       byte
+	MemberThreshold   byte
+	Value             []byte
+}
+
+// Parameters that must be the same across all shares of a secret
+type commonParameters struct {
+	identifier        uint16
+	extendable        bool
+	iterationExponent byte
+	groupThreshold    byte
+	groupCount        byte
+}
+
+// Get the parameters shared by every share of the same secret
+func (s *Share) commonParameters() commonParameters {
+	return commonParameters{
+		identifier:        s.Identifier,
+		extendable:        s.Extendable,
+		iterationExponent: s.IterationExponent,
+		groupThreshold:    s.GroupThreshold,
+		groupCount:        s.GroupCount,
+	}
+}
+
+// Word indices for each word in the list, keyed by the first four letters
+var wordIndices = map[string]int{}
+
+func init() {
+	for i, word := range wordList {
+		wordIndices[word[:4]] = i
+	}
+}
+
+// Get the customization string used for the checksum and encryption
+func getCustomizationString(extendable bool) string {
+	if extendable {
+		return customizationStringExtendable
+	}
+	return customizationString
+}
+
+// The RS1024 checksum polynomial
+func rs1024Polymod(values []int) int {
+	generator := [10]int{
+		0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+		0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+	}
+	chk := 1
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ v
+		for i := 0; i < 10; i++ {
+			if (b>>i)&1 != 0 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// Get the checksum words for the given data words
+func rs1024CreateChecksum(data []int, extendable bool) []int {
+	values := []int{}
+	for _, c := range getCustomizationString(extendable) {
+		values = append(values, int(c))
+	}
+	values = append(values, data...)
+	values = append(values, make([]int, checksumLengthWords)...)
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, checksumLengthWords)
+	for i := range checksum {
+		checksum[i] = (polymod >> (radixBits * (checksumLengthWords - 1 - i))) & (radixSize - 1)
+	}
+	return checksum
+}
+
+// Check the checksum of the given words
+func rs1024VerifyChecksum(data []int, extendable bool) bool {
+	values := []int{}
+	for _, c := range getCustomizationString(extendable) {
+		values = append(values, int(c))
+	}
+	values = append(values, data...)
+	return rs1024Polymod(values) == 1
+}
+
+// Convert an integer to a slice of 10-bit word indices
+func intToIndices(value *big.Int, length int) []int {
+	indices := make([]int, length)
+	mask := big.NewInt(int64(radixSize - 1))
+	v := new(big.Int).Set(value)
+	for i := length - 1; i >= 0; i-- {
+		indices[i] = int(new(big.Int).And(v, mask).Int64())
+		v.Rsh(v, uint(radixBits))
+	}
+	return indices
+}
+
+// Convert a slice of 10-bit word indices to an integer
+func indicesToInt(indices []int) *big.Int {
+	value := big.NewInt(0)
+	for _, index := range indices {
+		value.Lsh(value, uint(radixBits))
+		value.Or(value, big.NewInt(int64(index)))
+	}
+	return value
+}
+
+// Encode the share as a mnemonic
+func (s *Share) Mnemonic() string {
+
+	// Build the header
+	idExpExt := int(s.Identifier)<<(iterationExpBits+1) | int(s.IterationExponent)
+	if s.Extendable {
+		idExpExt |= 1 << iterationExpBits
+	}
+	header := intToIndices(big.NewInt(int64(idExpExt)), 2)
+	header = append(header,
+		int(s.GroupIndex)<<6|int(s.GroupThreshold-1)<<2|int(s.GroupCount-1)>>2,
+		int((s.GroupCount-1)&3)<<8|int(s.MemberIndex)<<4|int(s.MemberThreshold-1),
+	)
+
+	// Encode the value, left-padded to a multiple of the radix
+	valueWordCount := (len(s.Value)*8 + radixBits - 1) / radixBits
+	data := append(header, intToIndices(new(big.Int).SetBytes(s.Value), valueWordCount)...)
+	data = append(data, rs1024CreateChecksum(data, s.Extendable)...)
+
+	// Convert to words
+	words := make([]string, len(data))
+	for i, index := range data {
+		words[i] = wordList[index]
+	}
+	return strings.Join(words, " ")
+
+}
+
+// Decode a share from its mnemonic
+func ParseShare(mnemonic string) (*Share, error) {
+
+	// Convert the words to indices
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < minMnemonicLengthWords {
+		return nil, fmt.Errorf("invalid share length: each share must be at least %d words", minMnemonicLengthWords)
+	}
+	data := make([]int, len(words))
+	for i, word := range words {
+		if len(word) < 4 {
+			return nil, fmt.Errorf("invalid share word '%s'", word)
+		}
+		index, exists := wordIndices[word[:4]]
+		if !exists || (len(word) > 4 && wordList[index] != word) {
+			return nil, fmt.Errorf("invalid share word '%s'", word)
+		}
+		data[i] = index
+	}
+
+	// Check the padding
+	paddingLength := (radixBits * (len(data) - headerLengthWords - checksumLengthWords)) % 16
+	if paddingLength > 8 {
+		return nil, errors.New("invalid share length")
+	}
+
+	// Decode the header and verify the checksum
+	idExpExt := int(indicesToInt(data[:2]).Int64())
+	share := &Share{
+		Identifier:        uint16(idExpExt >> (iterationExpBits + 1)),
+		Extendable:        (idExpExt>>iterationExpBits)&1 == 1,
+		IterationExponent: byte(idExpExt & ((1 << iterationExpBits) - 1)),
+	}
+	if !rs1024VerifyChecksum(data, share.Extendable) {
+		return nil, errors.New("invalid share checksum; please check the share for typos")
+	}
+	share.GroupIndex = byte(data[2] >> 6)
+	share.GroupThreshold = byte((data[2]>>2)&15) + 1
+	share.GroupCount = byte(((data[2]&3)<<2)|(data[3]>>8)) + 1
+	share.MemberIndex = byte((data[3] >> 4) & 15)
+	share.MemberThreshold = byte(data[3]&15) + 1
+	if share.GroupCount < share.GroupThreshold {
+		return nil, errors.New("invalid share: the group threshold cannot be greater than the group count")
+	}
+
+	// Decode the value
+	valueData := data[headerLengthWords : len(data)-checksumLengthWords]
+	valueByteCount := (radixBits*len(valueData) - paddingLength) / 8
+	value := indicesToInt(valueData)
+	if value.BitLen() > valueByteCount*8 {
+		return nil, errors.New("invalid share: the padding bits must be zero")
+	}
+	valueBytes := value.Bytes()
+	share.Value = make([]byte, valueByteCount)
+	copy(share.Value[valueByteCount-len(valueBytes):], valueBytes)
+
+	return share, nil
+
+}
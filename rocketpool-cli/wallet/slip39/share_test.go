@@ -0,0 +1,32 @@
+package slip39
+
+import (
+	"testing"
+)
+
+func TestShareRoundTrip(t *testing.T) {
+	mnemonic := "shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed"
+	share, err := ParseShare(mnemonic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if share.GroupThreshold != 1 || share.GroupCount != 1 || share.MemberThreshold != 2 {
+		t.Fatalf("unexpected share parameters: %+v", share)
+	}
+	if share.Mnemonic() != mnemonic {
+		t.Fatalf("expected %s, got %s", mnemonic, share.Mnemonic())
+	}
+}
+
+func TestParseShareInvalid(t *testing.T) {
+	for _, mnemonic := range []string{
+		"",
+		"shadow pistol academic",
+		"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding notaword",
+		"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding army",
+	} {
+		if _, err := ParseShare(mnemonic); err == nil {
+			t.Fatalf("expected an error parsing '%s'", mnemonic)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package slip39
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Encryption settings
+const (
+	baseIterationCount       int  = 10000
+	roundCount               int  = 4
+	DefaultIterationExponent byte = 1
+)
+
+// A member group's threshold and share count
+type GroupSpec struct {
+	Threshold int
+	Count     int
+}
+
+// The Feistel round function used to encrypt the master secret
+func roundFunction(round int, passphrase []byte, iterationExponent byte, salt []byte, r []byte) []byte {
+	password := append([]byte{byte(round)}, passphrase...)
+	iterations := (baseIterationCount << iterationExponent) / roundCount
+	return pbkdf2.Key(password, append(append([]byte{}, salt...), r...), iterations, len(r), sha256.New)
+}
+
+// Get the salt used by the round function
+func getSalt(identifier uint16, extendable bool) []byte {
+	if extendable {
+		return []byte{}
+	}
+	salt := []byte(customizationString)
+	idBytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(idBytes, identifier)
+	return append(salt, idBytes...)
+}
+
+// Run the Feistel network over a master secret in the given round order
+func feistel(secret []byte, passphrase []byte, iterationExponent byte, identifier uint16, extendable bool, rounds []int) []byte {
+	half := len(secret) / 2
+	l := append([]byte{}, secret[:half]...)
+	r := append([]byte{}, secret[half:]...)
+	salt := getSalt(identifier, extendable)
+	for _, round := range rounds {
+		f := roundFunction(round, passphrase, iterationExponent, salt, r)
+		next := make([]byte, len(l))
+		for i := range l {
+			next[i] = l[i] ^ f[i]
+		}
+		l, r = r, next
+	}
+	return append(r, l...)
+}
+
+// Encrypt a master secret with the passphrase
+func encrypt(masterSecret []byte, passphrase []byte, iterationExponent byte, identifier uint16, extendable bool) []byte {
+	rounds := make([]int, roundCount)
+	for i := range rounds {
+		rounds[i] = i
+	}
+	return feistel(masterSecret, passphrase, iterationExponent, identifier, extendable, rounds)
+}
+
+// Decrypt an encrypted master secret with the passphrase
+func decrypt(encryptedSecret []byte, passphrase []byte, iterationExponent byte, identifier uint16, extendable bool) []byte {
+	rounds := make([]int, roundCount)
+	for i := range rounds {
+		rounds[i] = roundCount - 1 - i
+	}
+	return feistel(encryptedSecret, passphrase, iterationExponent, identifier, extendable, rounds)
+}
+
+// Split a master secret into SLIP-39 share mnemonics.
+// The result holds one slice of mnemonics per group.
+func GenerateMnemonics(groupThreshold int, groups []GroupSpec, masterSecret []byte, passphrase []byte, iterationExponent byte) ([][]string, error) {
+
+	// Check the parameters
+	if len(masterSecret)*8 < minStrengthBits {
+		return nil, fmt.Errorf("the master secret must be at least %d bits long", minStrengthBits)
+	}
+	if len(masterSecret)%2 != 0 {
+		return nil, errors.New("the master secret must have an even number of bytes")
+	}
+	if groupThreshold > len(groups) {
+		return nil, fmt.Errorf("the group threshold (%d) cannot exceed the number of groups (%d)", groupThreshold, len(groups))
+	}
+	for _, group := range groups {
+		if group.Threshold == 1 && group.Count > 1 {
+			return nil, errors.New("creating multiple member shares with a member threshold of 1 is not allowed; use 1-of-1 member sharing instead")
+		}
+	}
+
+	// Generate a random identifier
+	idBytes := make([]byte, 2)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, fmt.Errorf("error generating share identifier: %w", err)
+	}
+	identifier := binary.BigEndian.Uint16(idBytes) & ((1 << idLengthBits) - 1)
+	extendable := true
+
+	// Encrypt and split the secret
+	encryptedSecret := encrypt(masterSecret, passphrase, iterationExponent, identifier, extendable)
+	groupShares, err := splitSecret(groupThreshold, len(groups), encryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split each group secret into member shares
+	mnemonics := make([][]string, len(groups))
+	for i, groupShare := range groupShares {
+		group := groups[i]
+		memberShares, err := splitSecret(group.Threshold, group.Count, groupShare.data)
+		if err != nil {
+			return nil, err
+		}
+		for _, memberShare := range memberShares {
+			share := Share{
+				Identifier:        identifier,
+				Extendable:        extendable,
+				IterationExponent: iterationExponent,
+				GroupIndex:        groupShare.x,
+				GroupThreshold:    byte(groupThreshold),
+				GroupCount:        byte(len(groups)),
+				MemberIndex:       memberShare.x,
+				MemberThreshold:   byte(group.Threshold),
+				Value:             memberShare.data,
+			}
+			mnemonics[i] = append(mnemonics[i], share.Mnemonic())
+		}
+	}
+	return mnemonics, nil
+
+}
+
+// Recover the master secret from a set of SLIP-39 share mnemonics
+func CombineMnemonics(mnemonics []string, passphrase []byte) ([]byte, error) {
+
+	if len(mnemonics) == 0 {
+		return nil, errors.New("no shares were provided")
+	}
+
+	// Decode the shares and sort them into groups
+	var params *commonParameters
+	groups := map[byte][]*Share{}
+	for i, mnemonic := range mnemonics {
+		share, err := ParseShare(mnemonic)
+		if err != nil {
+			return nil, fmt.Errorf("share %d: %w", i+1, err)
+		}
+		shareParams := share.commonParameters()
+		if params == nil {
+			params = &shareParams
+		} else if *params != shareParams {
+			return nil, fmt.Errorf("share %d does not belong to the same set as the other shares", i+1)
+		}
+		groups[share.GroupIndex] = append(groups[share.GroupIndex], share)
+	}
+
+	// Recover each group's secret
+	groupShares := []rawShare{}
+	incompleteGroups := []string{}
+	for groupIndex, shares := range groups {
+		memberThreshold := int(shares[0].MemberThreshold)
+		memberShares := []rawShare{}
+		seen := map[byte]bool{}
+		for _, share := range shares {
+			if int(share.MemberThreshold) != memberThreshold {
+				return nil, fmt.Errorf("the shares in group %d have different member thresholds", groupIndex+1)
+			}
+			if seen[share.MemberIndex] {
+				continue
+			}
+			seen[share.MemberIndex] = true
+			memberShares = append(memberShares, rawShare{x: share.MemberIndex, data: share.Value})
+		}
+		if len(memberShares) < memberThreshold {
+			// Not enough shares for this group; it can't contribute
+			incompleteGroups = append(incompleteGroups, fmt.Sprintf("group %d has %d of the %d required shares", groupIndex+1, len(memberShares), memberThreshold))
+			continue
+		}
+		groupSecret, err := recoverSecret(memberThreshold, memberShares[:memberThreshold])
+		if err != nil {
+			return nil, fmt.Errorf("error recovering group %d: %w", groupIndex+1, err)
+		}
+		groupShares = append(groupShares, rawShare{x: groupIndex, data: groupSecret})
+	}
+
+	// Recover the encrypted master secret
+	groupThreshold := int(params.groupThreshold)
+	if len(groupShares) < groupThreshold {
+		return nil, fmt.Errorf("not enough shares: %d complete group(s) are required but only %d were provided (%s)", groupThreshold, len(groupShares), strings.Join(incompleteGroups, ", "))
+	}
+	encryptedSecret, err := recoverSecret(groupThreshold, groupShares[:groupThreshold])
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(encryptedSecret, passphrase, params.iterationExponent, params.identifier, params.extendable), nil
+
+}
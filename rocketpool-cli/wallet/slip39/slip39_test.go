@@ -0,0 +1,136 @@
+package slip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors from the SLIP-39 reference implementation (https://github.com/trezor/python-shamir-mnemonic/blob/master/vectors.json).
+// They all use the passphrase "TREZOR"; an empty master secret means the mnemonics are invalid.
+var vectors = []struct {
+	description  string
+	mnemonics    []string
+	masterSecret string
+}{
+	{
+		description:  "Valid mnemonic without sharing (128 bits)",
+		mnemonics:    []string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"},
+		masterSecret: "bb54aac4b89dc868ba37d9cc21b2cece",
+	},
+	{
+		description: "Mnemonic with invalid checksum (128 bits)",
+		mnemonics:   []string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney"},
+	},
+	{
+		description: "Mnemonic with invalid padding (128 bits)",
+		mnemonics:   []string{"duckling enlarge academic academic email result length solution fridge kidney coal piece deal husband erode duke ajar music cargo fitness"},
+	},
+	{
+		description: "Basic sharing 2-of-3 (128 bits)",
+		mnemonics: []string{
+			"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+		},
+		masterSecret: "b43ceb7e57a0ea8766221624d01b0864",
+	},
+	{
+		description: "Basic sharing 2-of-3 with one share (128 bits)",
+		mnemonics:   []string{"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed"},
+	},
+	{
+		description:  "Valid mnemonic without sharing (256 bits)",
+		mnemonics:    []string{"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck"},
+		masterSecret: "989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+	},
+	{
+		description:  "Valid extendable mnemonic without sharing (128 bits)",
+		mnemonics:    []string{"testify swimming academic academic column loyalty smear include exotic bedroom exotic wrist lobe cover grief golden smart junior estimate learn"},
+		masterSecret: "1679b4516e0ee5954351d288a838f45e",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, vector := range vectors {
+		t.Run(vector.description, func(t *testing.T) {
+			masterSecret, err := CombineMnemonics(vector.mnemonics, []byte("TREZOR"))
+			if vector.masterSecret == "" {
+				if err == nil {
+					t.Fatalf("expected an error, got master secret %x", masterSecret)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(masterSecret) != vector.masterSecret {
+				t.Fatalf("expected master secret %s, got %x", vector.masterSecret, masterSecret)
+			}
+		})
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	masterSecret, _ := hex.DecodeString("00112233445566778899aabbccddeeff")
+	passphrase := []byte("TREZOR")
+
+	// Any 2 of the 3 shares should recover the secret
+	mnemonics, err := GenerateMnemonics(1, []GroupSpec{{Threshold: 2, Count: 3}}, masterSecret, passphrase, DefaultIterationExponent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mnemonics) != 1 || len(mnemonics[0]) != 3 {
+		t.Fatalf("expected 1 group of 3 shares, got %v", mnemonics)
+	}
+	for _, pair := range [][2]int{{0, 1}, {0, 2}, {2, 1}} {
+		recovered, err := CombineMnemonics([]string{mnemonics[0][pair[0]], mnemonics[0][pair[1]]}, passphrase)
+		if err != nil {
+			t.Fatalf("shares %v: %s", pair, err.Error())
+		}
+		if !bytes.Equal(recovered, masterSecret) {
+			t.Fatalf("shares %v: expected master secret %x, got %x", pair, masterSecret, recovered)
+		}
+	}
+
+	// One share isn't enough
+	if _, err := CombineMnemonics(mnemonics[0][:1], passphrase); err == nil {
+		t.Fatal("expected an error when combining a single share")
+	}
+
+	// A different passphrase recovers a different secret
+	recovered, err := CombineMnemonics(mnemonics[0][:2], []byte("wrong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(recovered, masterSecret) {
+		t.Fatal("expected a different master secret with the wrong passphrase")
+	}
+}
+
+func TestSplitCombineGroups(t *testing.T) {
+	masterSecret, _ := hex.DecodeString("989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92")
+	groups := []GroupSpec{
+		{Threshold: 1, Count: 1},
+		{Threshold: 2, Count: 3},
+		{Threshold: 3, Count: 5},
+	}
+	mnemonics, err := GenerateMnemonics(2, groups, masterSecret, nil, DefaultIterationExponent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two complete groups should recover the secret
+	shares := []string{mnemonics[0][0], mnemonics[2][4], mnemonics[2][0], mnemonics[2][2]}
+	recovered, err := CombineMnemonics(shares, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(recovered, masterSecret) {
+		t.Fatalf("expected master secret %x, got %x", masterSecret, recovered)
+	}
+
+	// One complete group and one incomplete group aren't enough
+	if _, err := CombineMnemonics([]string{mnemonics[0][0], mnemonics[1][1]}, nil); err == nil {
+		t.Fatal("expected an error when combining an incomplete group")
+	}
+}
@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func splitMnemonicShares(c *cli.Context) error {
+
+	// Get the share scheme
+	threshold, count, err := parseShareScheme(c.String("shares"))
+	if err != nil {
+		return err
+	}
+
+	// Prompt for user confirmation before printing sensitive information
+	if !(c.GlobalBool("secure-session") ||
+		cliutils.ConfirmSecureSession("Splitting your mnemonic will print sensitive information to your screen.")) {
+		return nil
+	}
+
+	// Prompt for mnemonic
+	var mnemonic string
+	if c.String("mnemonic") != "" {
+		mnemonic = c.String("mnemonic")
+	} else {
+		mnemonic = promptMnemonic()
+	}
+
+	// Split the mnemonic
+	shares, err := splitMnemonic(mnemonic, threshold, count)
+	if err != nil {
+		return err
+	}
+	printShares(shares, threshold)
+
+	// Confirm the shares
+	if !c.Bool("confirm-shares") {
+		confirmShares(mnemonic)
+		fmt.Println("Your shares were successfully verified.")
+	}
+
+	fmt.Println("You can check your shares at any time without exposing your mnemonic with `rocketpool wallet verify-shares`.")
+	return nil
+
+}
+
+func verifyShares(c *cli.Context) error {
+
+	fmt.Printf("%sNOTE:\nThis command runs entirely offline; it will combine your shares and show the node account they recover without contacting the Smartnode daemon.%s\n\n", colorYellow, colorReset)
+
+	// Combine the shares
+	mnemonic, err := combineShares(promptShares())
+	if err != nil {
+		return err
+	}
+
+	// Derive the node address
+	address, err := getNodeAddressForMnemonic(mnemonic, c.String("derivation-path"), c.Uint("wallet-index"))
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("%sThe shares are valid and recover a complete mnemonic.%s\n", colorGreen, colorReset)
+	fmt.Printf("Node account: %s\n", address.Hex())
+	fmt.Println("Please make sure this matches your node's address.")
+	return nil
+
+}
@@ -30,10 +30,31 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured output if requested
+	if cliutils.IsStructuredOutput() {
+		return cliutils.PrintStructuredOutput(c, status)
+	}
+
 	// Print status & return
-	if status.WalletInitialized {
+	if status.PasswordLocked {
+		if status.WalletInitialized {
+			fmt.Printf("%sThe node wallet is initialized, but it is locked.%s\n", colorYellow, colorReset)
+			fmt.Println("Run `rocketpool wallet unlock` to unlock it.")
+		} else {
+			fmt.Println("The node wallet has not been initialized.")
+		}
+	} else if status.WalletInitialized {
 		fmt.Println("The node wallet is initialized.")
 		fmt.Printf("Node account: %s\n", status.AccountAddress.Hex())
+		if len(status.ImportedKeys) > 0 {
+			fmt.Printf("Imported validator keys (%d):\n", len(status.ImportedKeys))
+			for _, pubkey := range status.ImportedKeys {
+				fmt.Printf("\t%s\n", pubkey.Hex())
+			}
+		}
+	} else if status.WatchOnly {
+		fmt.Println("The node wallet is watch-only; its transactions are signed on an offline machine.")
+		fmt.Printf("Node account: %s\n", status.AccountAddress.Hex())
 	} else {
 		fmt.Println("The node wallet has not been initialized.")
 	}
@@ -2,13 +2,13 @@ package wallet
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -38,13 +38,10 @@ func testRecovery(c *cli.Context) error {
 	fmt.Printf("%sNOTE:\nThis command will test the recovery of your node wallet's private key and (unless explicitly disabled) the validator keys for your minipools, but will not actually write any files; it's simply a \"dry run\" of recovery.\nUse `rocketpool wallet recover` to actually recover the wallet and validator keys.%s\n\n", colorYellow, colorReset)
 
 	// Prompt for mnemonic
-	var mnemonic string
-	if c.String("mnemonic") != "" {
-		mnemonic = c.String("mnemonic")
-	} else {
-		mnemonic = promptMnemonic()
+	mnemonic, err := getRecoveryMnemonic(c)
+	if err != nil {
+		return err
 	}
-	mnemonic = strings.TrimSpace(mnemonic)
 
 	// Handle validator key recovery skipping
 	skipValidatorKeyRecovery := c.Bool("skip-validator-key-recovery")
@@ -57,17 +54,7 @@ func testRecovery(c *cli.Context) error {
 		}
 		if customKeyPasswordFile != "" {
 			// Defer deleting the custom keystore password file
-			defer func(customKeyPasswordFile string) {
-				_, err := os.Stat(customKeyPasswordFile)
-				if os.IsNotExist(err) {
-					return
-				}
-
-				err = os.Remove(customKeyPasswordFile)
-				if err != nil {
-					fmt.Printf("*** WARNING ***\nAn error occurred while removing the custom keystore password file: %s\n\nThis file contains the passwords to your custom validator keys.\nYou *must* delete it manually as soon as possible so nobody can read it.\n\nThe file is located here:\n\n\t%s\n\n", err.Error(), customKeyPasswordFile)
-				}
-			}(customKeyPasswordFile)
+			defer deleteCustomKeyPasswordFile(rp, customKeyPasswordFile)
 		}
 	}
 
@@ -77,7 +64,18 @@ func testRecovery(c *cli.Context) error {
 
 		// Get the address to search for
 		address := common.HexToAddress(addressString)
+		startIndex := c.Uint("search-start-index")
+		endIndex := c.Uint("search-end-index")
+		if endIndex != 0 && endIndex <= startIndex {
+			return fmt.Errorf("search end index %d must be greater than search start index %d", endIndex, startIndex)
+		}
 		fmt.Printf("Searching for the derivation path and index for wallet %s...\nNOTE: this may take several minutes depending on how large your wallet's index is.\n", address.Hex())
+		fmt.Printf("Derivation paths searched: %s\n", strings.Join(wallet.KnownNodeKeyPaths, ", "))
+		if endIndex != 0 {
+			fmt.Printf("Wallet indices searched:   %d to %d\n", startIndex, endIndex-1)
+		} else {
+			fmt.Printf("Wallet indices searched:   starting at %d\n", startIndex)
+		}
 
 		// Log
 		if skipValidatorKeyRecovery {
@@ -91,7 +89,7 @@ func testRecovery(c *cli.Context) error {
 		}
 
 		// Test recover wallet
-		response, err := rp.TestSearchAndRecoverWallet(mnemonic, address, skipValidatorKeyRecovery)
+		response, err := rp.TestSearchAndRecoverWallet(mnemonic, address, skipValidatorKeyRecovery, startIndex, endIndex)
 		if err != nil {
 			return err
 		}
@@ -103,6 +101,7 @@ func testRecovery(c *cli.Context) error {
 		fmt.Printf("Node account:    %s\n", response.AccountAddress.Hex())
 		if !skipValidatorKeyRecovery {
 			if len(response.ValidatorKeys) > 0 {
+				fmt.Printf("All %d validator keys derived from this wallet match the node's minipools on chain.\n", len(response.ValidatorKeys))
 				fmt.Println("Validator keys:")
 				for _, key := range response.ValidatorKeys {
 					fmt.Println(key.Hex())
@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func unlockWallet(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.PasswordLocked {
+		if status.WalletInitialized {
+			fmt.Println("The node wallet is already unlocked.")
+		} else {
+			fmt.Println("The node wallet has not been initialized.")
+		}
+		return nil
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet has not been initialized. Please run `rocketpool wallet init` instead.")
+		return nil
+	}
+
+	// Get the password
+	var password string
+	if c.String("password") != "" {
+		password = c.String("password")
+	} else {
+		password = cliutils.PromptPassword("Please enter your node wallet's password:", "^.*$", "")
+	}
+
+	// Unlock the wallet
+	response, err := rp.UnlockWallet(password)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Println("The node wallet was successfully unlocked.")
+	fmt.Printf("Node account: %s\n", response.AccountAddress.Hex())
+	fmt.Println("It will remain unlocked until the node daemon restarts.")
+	return nil
+
+}
@@ -3,13 +3,11 @@ package wallet
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"os"
-	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
-	"github.com/mitchellh/go-homedir"
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/wallet/bip39"
 	"github.com/rocket-pool/smartnode/shared/services/config"
@@ -102,21 +100,10 @@ func confirmMnemonic(mnemonic string) {
 // Check for custom keys, prompt for their passwords, and store them in the custom keys file
 func promptForCustomKeyPasswords(rp *rocketpool.Client, cfg *config.RocketPoolConfig, testOnly bool) (string, error) {
 
-	// Check for the custom key directory
-	datapath, err := homedir.Expand(cfg.Smartnode.DataPath.Value.(string))
+	// Get the custom keystore files; they're on the machine running the Smartnode, which may be accessed over SSH
+	files, err := rp.GetCustomKeystores(cfg)
 	if err != nil {
-		return "", fmt.Errorf("error expanding data directory: %w", err)
-	}
-	customKeyDir := filepath.Join(datapath, "custom-keys")
-	info, err := os.Stat(customKeyDir)
-	if os.IsNotExist(err) || !info.IsDir() {
-		return "", nil
-	}
-
-	// Get the custom keystore files
-	files, err := ioutil.ReadDir(customKeyDir)
-	if err != nil {
-		return "", fmt.Errorf("error enumerating custom keystores: %w", err)
+		return "", err
 	}
 	if len(files) == 0 {
 		return "", nil
@@ -134,18 +121,17 @@ func promptForCustomKeyPasswords(rp *rocketpool.Client, cfg *config.RocketPoolCo
 
 	// Get the pubkeys for the custom keystores
 	customPubkeys := []types.ValidatorPubkey{}
-	for _, file := range files {
-		// Read the file
-		bytes, err := ioutil.ReadFile(filepath.Join(customKeyDir, file.Name()))
-		if err != nil {
-			return "", fmt.Errorf("error reading custom keystore %s: %w", file.Name(), err)
-		}
-
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
 		// Deserialize it
 		keystore := api.ValidatorKeystore{}
-		err = json.Unmarshal(bytes, &keystore)
+		err = json.Unmarshal(files[name], &keystore)
 		if err != nil {
-			return "", fmt.Errorf("error deserializing custom keystore %s: %w", file.Name(), err)
+			return "", fmt.Errorf("error deserializing custom keystore %s: %w", name, err)
 		}
 
 		customPubkeys = append(customPubkeys, keystore.Pubkey)
@@ -172,23 +158,14 @@ func promptForCustomKeyPasswords(rp *rocketpool.Client, cfg *config.RocketPoolCo
 	if err != nil {
 		return "", fmt.Errorf("error serializing keystore passwords file: %w", err)
 	}
-	passwordFile := filepath.Join(datapath, "custom-key-passwords")
-	err = ioutil.WriteFile(passwordFile, fileBytes, 0600)
-	if err != nil {
-		return "", fmt.Errorf("error writing keystore passwords file: %w", err)
-	}
-
-	return passwordFile, nil
+	return rp.SaveCustomKeyPasswords(cfg, fileBytes)
 
 }
 
-// Deletes the custom key password file
-func deleteCustomKeyPasswordFile(passwordFile string) error {
-	_, err := os.Stat(passwordFile)
-	if os.IsNotExist(err) {
-		return nil
+// Deletes the custom key password file, warning the user to delete it themselves if that fails
+func deleteCustomKeyPasswordFile(rp *rocketpool.Client, passwordFile string) {
+	err := rp.DeleteCustomKeyPasswords(passwordFile)
+	if err != nil {
+		fmt.Printf("*** WARNING ***\nAn error occurred while removing the custom keystore password file: %s\n\nThis file contains the passwords to your custom validator keys.\nYou *must* delete it manually as soon as possible so nobody can read it.\n\nThe file is located here:\n\n\t%s\n\n", err.Error(), passwordFile)
 	}
-
-	err = os.Remove(passwordFile)
-	return err
 }
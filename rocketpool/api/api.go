@@ -5,7 +5,6 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool/api/debug"
 	"github.com/urfave/cli"
 
-	"github.com/rocket-pool/rocketpool-go/utils"
 	"github.com/rocket-pool/smartnode/rocketpool/api/auction"
 	"github.com/rocket-pool/smartnode/rocketpool/api/faucet"
 	"github.com/rocket-pool/smartnode/rocketpool/api/minipool"
@@ -24,14 +23,16 @@ import (
 // Waits for an auction transaction
 func waitForTransaction(c *cli.Context, hash common.Hash) (*apitypes.APIResponse, error) {
 
-	rp, err := services.GetRocketPool(c)
+	tm, err := services.GetTransactionManager(c)
 	if err != nil {
 		return nil, err
 	}
 
 	// Response
 	response := apitypes.APIResponse{}
-	_, err = utils.WaitForTransaction(rp.Client, hash)
+
+	// Wait for it or one of its replacements to be mined
+	_, err = tm.WaitForTransaction(hash, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +78,7 @@ func canClaimFromLot(c *cli.Context, lotIndex uint64) (*api.CanClaimFromLotRespo
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, err = services.GetTransactionRevert(err)
 		return err
 	})
 
@@ -87,7 +88,7 @@ func canClaimFromLot(c *cli.Context, lotIndex uint64) (*api.CanClaimFromLotRespo
 	}
 
 	// Update & return response
-	response.CanClaim = !(response.DoesNotExist || response.NoBidFromAddress || response.NotCleared)
+	response.CanClaim = !(response.DoesNotExist || response.NoBidFromAddress || response.NotCleared || response.Revert != nil)
 	return &response, nil
 
 }
@@ -83,6 +83,7 @@ func canRecoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.CanRecoverRPLFr
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, err = services.GetTransactionRevert(err)
 		return err
 	})
 
@@ -92,7 +93,7 @@ func canRecoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.CanRecoverRPLFr
 	}
 
 	// Update & return response
-	response.CanRecover = !(response.DoesNotExist || response.BiddingNotEnded || response.NoUnclaimedRPL || response.RPLAlreadyRecovered)
+	response.CanRecover = !(response.DoesNotExist || response.BiddingNotEnded || response.NoUnclaimedRPL || response.RPLAlreadyRecovered || response.Revert != nil)
 	return &response, nil
 
 }
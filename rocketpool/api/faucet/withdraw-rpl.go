@@ -117,10 +117,12 @@ func canWithdrawRpl(c *cli.Context) (*api.CanFaucetWithdrawRplResponse, error) {
 		}
 
 		gasInfo, err := estimateWithdrawGas(c, ec, f, opts, amount)
+		response.Revert, err = services.GetTransactionRevert(err)
 		if err != nil {
 			return nil, err
 		}
 		response.GasInfo = gasInfo
+		response.CanWithdraw = (response.Revert == nil)
 	}
 
 	return &response, nil
@@ -70,9 +70,10 @@ func canCloseMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanC
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, _ = services.GetTransactionRevert(err)
 
 	// Update & return response
-	response.CanClose = !(response.InvalidStatus || !response.InConsensus)
+	response.CanClose = !(response.InvalidStatus || !response.InConsensus || response.Revert != nil)
 	return &response, nil
 
 }
@@ -1,6 +1,9 @@
 package minipool
 
 import (
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -34,6 +37,28 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "performance",
+				Usage:     "Get the recent performance of the node's validators",
+				UsageText: "rocketpool api minipool performance epochs",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					windowEpochs, err := cliutils.ValidatePositiveUint("epochs", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getPerformance(c, windowEpochs))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-stake",
 				Usage:     "Check whether the minipool is ready to be staked, moving from prelaunch to staking status",
@@ -298,6 +323,86 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "sign-exit",
+				Usage:     "Pre-sign a voluntary exit for a minipool without broadcasting it",
+				UsageText: "rocketpool api minipool sign-exit minipool-address epoch",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					epoch, err := cliutils.ValidateUint("epoch", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(signMinipoolExit(c, minipoolAddress, epoch))
+					return nil
+
+				},
+			},
+			{
+				Name:      "build-offline-exit",
+				Usage:     "Build a voluntary exit for a minipool to sign on an offline machine",
+				UsageText: "rocketpool api minipool build-offline-exit minipool-address epoch",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					epoch, err := cliutils.ValidateUint("epoch", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(buildOfflineExit(c, minipoolAddress, epoch))
+					return nil
+
+				},
+			},
+			{
+				Name:      "broadcast-exit",
+				Usage:     "Broadcast a voluntary exit that was signed on an offline machine",
+				UsageText: "rocketpool api minipool broadcast-exit validator-index epoch signature",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					validatorIndex, err := cliutils.ValidateUint("validator index", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					epoch, err := cliutils.ValidateUint("epoch", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					signature, err := types.HexToValidatorSignature(c.Args().Get(2))
+					if err != nil {
+						return fmt.Errorf("Invalid signature '%s': %w", c.Args().Get(2), err)
+					}
+
+					// Run
+					api.PrintResponse(broadcastExit(c, validatorIndex, epoch, signature))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-delegate-upgrade",
 				Usage:     "Check whether the minipool delegate can be upgraded",
@@ -52,6 +52,7 @@ func canDelegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, _ = services.GetTransactionRevert(err)
 
 	// Return response
 	return &response, nil
@@ -145,6 +146,7 @@ func canDelegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.C
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, _ = services.GetTransactionRevert(err)
 
 	// Return response
 	return &response, nil
@@ -232,6 +234,7 @@ func canSetUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, set
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, _ = services.GetTransactionRevert(err)
 
 	// Return response
 	return &response, nil
@@ -62,9 +62,10 @@ func canDissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.C
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, _ = services.GetTransactionRevert(err)
 
 	// Update & return response
-	response.CanDissolve = !response.InvalidStatus
+	response.CanDissolve = !(response.InvalidStatus || response.Revert != nil)
 	return &response, nil
 
 }
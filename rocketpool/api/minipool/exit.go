@@ -2,12 +2,14 @@ package minipool
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
@@ -128,3 +130,103 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 	return &response, nil
 
 }
+
+func signMinipoolExit(c *cli.Context, minipoolAddress common.Address, epoch uint64) (*api.SignMinipoolExitResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SignMinipoolExitResponse{}
+
+	// Validate minipool owner
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+
+	// Get minipool validator pubkey
+	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get validator private key
+	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default to the current epoch
+	if epoch == 0 {
+		head, err := bc.GetBeaconHead()
+		if err != nil {
+			return nil, err
+		}
+		epoch = head.Epoch
+	}
+
+	// Get the voluntary exit signature domain
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	signatureDomain, err := getExitSignatureDomain(bc, eth2Config, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(eth2Config.CapellaForkVersion) > 0 {
+		response.ForkVersion = hexutil.Encode(eth2Config.CapellaForkVersion)
+		response.Eip7044 = true
+	}
+
+	// Get validator index
+	validatorIndex, err := bc.GetValidatorIndex(validatorPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get signed voluntary exit message
+	signature, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, epoch, signatureDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update & return response
+	response.Exit = validator.NewExitBundleEntry(minipoolAddress, validatorPubkey, validatorIndex, epoch, signature)
+	response.GenesisValidatorsRoot = hexutil.Encode(eth2Config.GenesisValidatorsRoot)
+	return &response, nil
+
+}
+
+// Get the voluntary exit signature domain; per EIP-7044, exits signed for Capella stay valid permanently
+func getExitSignatureDomain(bc beacon.Client, eth2Config beacon.Eth2Config, epoch uint64) ([]byte, error) {
+	if len(eth2Config.CapellaForkVersion) > 0 {
+		return eth2types.Domain(eth2types.DomainVoluntaryExit, eth2Config.CapellaForkVersion, eth2Config.GenesisValidatorsRoot), nil
+	}
+	return bc.GetDomainData(eth2types.DomainVoluntaryExit[:], epoch)
+}
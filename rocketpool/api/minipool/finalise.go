@@ -45,6 +45,7 @@ func canFinaliseMinipool(c *cli.Context, minipoolAddress common.Address) (*api.C
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, _ = services.GetTransactionRevert(err)
 
 	// Update & return response
 	return &response, nil
@@ -0,0 +1,130 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/offline"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func buildOfflineExit(c *cli.Context, minipoolAddress common.Address, epoch uint64) (*api.BuildOfflineMinipoolExitResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BuildOfflineMinipoolExitResponse{}
+
+	// Validate minipool owner and status
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+	status, err := mp.GetStatus(nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != types.Staking {
+		return nil, fmt.Errorf("Minipool %s is not staking", minipoolAddress.Hex())
+	}
+
+	// Get minipool validator pubkey and index
+	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	validatorIndex, err := bc.GetValidatorIndex(validatorPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default to the current epoch
+	if epoch == 0 {
+		head, err := bc.GetBeaconHead()
+		if err != nil {
+			return nil, err
+		}
+		epoch = head.Epoch
+	}
+
+	// Get the voluntary exit signature domain
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	signatureDomain, err := getExitSignatureDomain(bc, eth2Config, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update & return response
+	response.ChainID = uint64(cfg.Smartnode.GetChainID())
+	response.NodeAddress = nodeAccount.Address
+	response.Exit = &offline.UnsignedExit{
+		MinipoolAddress: minipoolAddress,
+		Pubkey:          validatorPubkey,
+		ValidatorIndex:  validatorIndex,
+		Epoch:           epoch,
+		SignatureDomain: signatureDomain,
+	}
+	return &response, nil
+
+}
+
+func broadcastExit(c *cli.Context, validatorIndex uint64, epoch uint64, signature types.ValidatorSignature) (*api.BroadcastMinipoolExitResponse, error) {
+
+	// Get services
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastMinipoolExitResponse{}
+
+	// Broadcast voluntary exit message
+	if err := bc.ExitValidator(validatorIndex, epoch, signature); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
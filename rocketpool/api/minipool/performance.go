@@ -0,0 +1,42 @@
+package minipool
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getPerformance(c *cli.Context, windowEpochs uint64) (*api.MinipoolPerformanceResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolPerformanceResponse{
+		WindowEpochs: windowEpochs,
+		Validators:   []rputils.ValidatorPerformance{},
+	}
+
+	// Load the performance state written by the node daemon
+	state, err := rputils.LoadValidatorPerformanceState(cfg.Smartnode.GetValidatorPerformancePath())
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return &response, nil
+	}
+	response.LastCheckedEpoch = state.LastCheckedEpoch
+	response.Validators = state.Summarize(windowEpochs)
+
+	// Return response
+	return &response, nil
+
+}
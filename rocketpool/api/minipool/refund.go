@@ -62,9 +62,10 @@ func canRefundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Can
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, _ = services.GetTransactionRevert(err)
 
 	// Update & return response
-	response.CanRefund = !response.InsufficientRefundBalance
+	response.CanRefund = !(response.InsufficientRefundBalance || response.Revert != nil)
 	return &response, nil
 
 }
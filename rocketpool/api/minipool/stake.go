@@ -123,6 +123,8 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, _ = services.GetTransactionRevert(err)
+		response.CanStake = (response.Revert == nil)
 	}
 
 	// Return response
@@ -76,7 +76,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Name:      "timezone-map",
 				Aliases:   []string{"t"},
 				Usage:     "Get the table of node operators by timezone",
-				UsageText: "rocketpool api network stats",
+				UsageText: "rocketpool api network timezone-map",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -137,6 +137,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "fee-suggestions",
+				Aliases:   []string{"g"},
+				Usage:     "Get gas fee suggestions from the fee history of the Execution client",
+				UsageText: "rocketpool api network fee-suggestions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getFeeSuggestions(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "dao-proposals",
 				Aliases:   []string{"d"},
@@ -87,6 +87,7 @@ func canNodeBurn(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 			if err == nil {
 				response.GasInfo = gasInfo
 			}
+			response.Revert, err = services.GetTransactionRevert(err)
 			return err
 		}
 		return err
@@ -98,7 +99,7 @@ func canNodeBurn(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 	}
 
 	// Update & return response
-	response.CanBurn = !(response.InsufficientBalance || response.InsufficientCollateral)
+	response.CanBurn = !(response.InsufficientBalance || response.InsufficientCollateral || response.Revert != nil)
 	return &response, nil
 
 }
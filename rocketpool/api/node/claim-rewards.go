@@ -138,6 +138,7 @@ func canClaimRewards(c *cli.Context, indicesString string) (*api.CanNodeClaimRew
 		return nil, err
 	}
 	gasInfo, err := rewards.EstimateClaimGas(rp, nodeAccount.Address, indices, amountRPL, amountETH, merkleProofs, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +245,7 @@ func canClaimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *
 		return nil, err
 	}
 	gasInfo, err := rewards.EstimateClaimAndStakeGas(rp, nodeAccount.Address, indices, amountRPL, amountETH, merkleProofs, stakeAmount, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
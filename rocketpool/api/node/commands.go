@@ -1,8 +1,12 @@
 package node
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
@@ -53,6 +57,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "duties",
+				Usage:     "Get the upcoming block proposals and sync committee duties of the node's validators",
+				UsageText: "rocketpool api node duties",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getDuties(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-register",
 				Usage:     "Check whether the node can be registered with Rocket Pool",
@@ -323,7 +345,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 			{
 				Name:      "swap-rpl-allowance",
 				Usage:     "Get the node's legacy RPL allowance for new RPL contract",
-				UsageText: "rocketpool api node swap-allowance-rpl",
+				UsageText: "rocketpool api node swap-rpl-allowance",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -453,7 +475,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 			{
 				Name:      "stake-rpl-allowance",
 				Usage:     "Get the node's RPL allowance for the staking contract",
-				UsageText: "rocketpool api node stake-allowance-rpl",
+				UsageText: "rocketpool api node stake-rpl-allowance",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -1158,6 +1180,69 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "fee-recipient-status",
+				Usage:     "Get the effective fee recipient of each of the node's validators",
+				UsageText: "rocketpool api node fee-recipient-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getFeeRecipientStatus(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "set-custom-fee-recipient",
+				Usage:     "Set the fee recipient of a solo validator",
+				UsageText: "rocketpool api node set-custom-fee-recipient pubkey address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					pubkey, err := cliutils.ValidatePubkey("validator pubkey", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					feeRecipient, err := cliutils.ValidateAddress("fee recipient", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(setCustomFeeRecipient(c, pubkey, feeRecipient))
+					return nil
+
+				},
+			},
+			{
+				Name:      "clear-custom-fee-recipient",
+				Usage:     "Remove the custom fee recipient of a solo validator",
+				UsageText: "rocketpool api node clear-custom-fee-recipient pubkey",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					pubkey, err := cliutils.ValidatePubkey("validator pubkey", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(clearCustomFeeRecipient(c, pubkey))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-set-smoothing-pool-status",
 				Usage:     "Check if the node's Smoothing Pool status can be changed",
@@ -1200,6 +1285,252 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "tx-list",
+				Usage:     "Get the transactions sent by the node account",
+				UsageText: "rocketpool api node tx-list",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getTransactions(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-speed-up-tx",
+				Usage:     "Check whether a pending transaction can be sped up, and get the fees to replace it with",
+				UsageText: "rocketpool api node can-speed-up-tx tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canSpeedUpTransaction(c, hash))
+					return nil
+
+				},
+			},
+			{
+				Name:      "speed-up-tx",
+				Usage:     "Replace a pending transaction with a copy that has higher fees",
+				UsageText: "rocketpool api node speed-up-tx tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(speedUpTransaction(c, hash))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-cancel-tx",
+				Usage:     "Check whether a pending transaction can be cancelled, and get the fees to replace it with",
+				UsageText: "rocketpool api node can-cancel-tx tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canCancelTransaction(c, hash))
+					return nil
+
+				},
+			},
+			{
+				Name:      "cancel-tx",
+				Usage:     "Cancel a pending transaction by replacing it with an empty transfer to the node account",
+				UsageText: "rocketpool api node cancel-tx tx-hash",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(cancelTransaction(c, hash))
+					return nil
+
+				},
+			},
+			{
+				Name:      "queue-tx",
+				Usage:     "Queue a transaction for the node daemon to send once the base fee is below the threshold (in gwei) or the deadline (a Unix timestamp, or 0 for none) passes",
+				UsageText: "rocketpool api node queue-tx gas-threshold deadline expiry description command...",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if len(c.Args()) < 6 {
+						return fmt.Errorf("Incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+					gasThreshold, err := cliutils.ValidatePositiveEthAmount("gas threshold", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					deadline, err := cliutils.ValidateUint("deadline", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					expiry, err := cliutils.ValidatePositiveUint("expiry", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+					deferral := txmanager.Deferral{
+						GasThreshold: gasThreshold,
+						Expiry:       time.Unix(int64(expiry), 0),
+					}
+					if deadline != 0 {
+						deferral.Deadline = time.Unix(int64(deadline), 0)
+					}
+
+					// Run
+					api.PrintResponse(queueTransaction(c, deferral, c.Args().Get(3), c.Args()[4:]))
+					return nil
+
+				},
+			},
+			{
+				Name:      "tx-queue",
+				Usage:     "Get the transactions queued for the node daemon to send",
+				UsageText: "rocketpool api node tx-queue",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getTransactionQueue(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "cancel-queued-tx",
+				Usage:     "Remove a transaction from the queue before it's sent",
+				UsageText: "rocketpool api node cancel-queued-tx id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					id, err := cliutils.ValidatePositiveUint("id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(cancelQueuedTransaction(c, id))
+					return nil
+
+				},
+			},
+			{
+				Name:      "build-offline-tx",
+				Usage:     "Build a transaction for the node account to sign on an offline machine, using at least the provided nonce",
+				UsageText: "rocketpool api node build-offline-tx description nonce command...",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if len(c.Args()) < 4 {
+						return fmt.Errorf("Incorrect argument count; usage: %s", c.Command.UsageText)
+					}
+					nonce, err := cliutils.ValidateUint("nonce", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(buildOfflineTransaction(c, c.Args().Get(0), nonce, c.Args()[2:]))
+					return nil
+
+				},
+			},
+			{
+				Name:      "build-offline-deposit",
+				Usage:     "Build a deposit for the node account to sign on an offline machine, using at least the provided nonce",
+				UsageText: "rocketpool api node build-offline-deposit amount min-fee salt nonce",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 4); err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidateDepositWeiAmount("deposit amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					minNodeFee, err := cliutils.ValidateFraction("minimum node fee", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					salt, err := cliutils.ValidateBigInt("salt", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+					nonce, err := cliutils.ValidateUint("nonce", c.Args().Get(3))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(buildOfflineDeposit(c, amountWei, minNodeFee, salt, nonce))
+					return nil
+
+				},
+			},
+			{
+				Name:      "broadcast-tx",
+				Usage:     "Broadcast a transaction that was signed by the node account on an offline machine. The TX must be serialized as a hex string.",
+				UsageText: "rocketpool api node broadcast-tx tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(broadcastTransaction(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
 		},
 	})
 }
@@ -153,8 +153,16 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 			return err
 		}
 
-		// Get the next validator key
-		validatorKey, err := w.GetNextValidatorKey()
+		// Get the next validator key; watch-only wallets don't have one, so a throwaway key is used for the estimate
+		var validatorKey *eth2types.BLSPrivateKey
+		if w.IsOffline() {
+			if err := eth2types.InitBLS(); err != nil {
+				return fmt.Errorf("Could not initialize BLS: %w", err)
+			}
+			validatorKey, err = eth2types.GenerateBLSPrivateKey()
+		} else {
+			validatorKey, err = w.GetNextValidatorKey()
+		}
 		if err != nil {
 			return err
 		}
@@ -206,6 +214,7 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, err = services.GetTransactionRevert(err)
 		return err
 	})
 
@@ -250,7 +259,7 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 	}
 
 	// Update & return response
-	response.CanDeposit = !(response.InsufficientBalance || response.InsufficientRplStake || response.InvalidAmount || response.UnbondedMinipoolsAtMax || response.DepositDisabled || !response.InConsensus)
+	response.CanDeposit = !(response.InsufficientBalance || response.InsufficientRplStake || response.InvalidAmount || response.UnbondedMinipoolsAtMax || response.DepositDisabled || !response.InConsensus || response.Revert != nil)
 	return &response, nil
 
 }
@@ -0,0 +1,115 @@
+package node
+
+import (
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getDuties(c *cli.Context) (*api.NodeDutiesResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeDutiesResponse{
+		Validators: []api.ValidatorDuties{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the current epoch and the start of the next sync committee period
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	response.Epoch = head.Epoch
+	response.SecondsPerEpoch = eth2Config.SecondsPerEpoch
+	if eth2Config.EpochsPerSyncCommitteePeriod > 0 {
+		response.NextSyncCommitteeEpoch = (head.Epoch/eth2Config.EpochsPerSyncCommitteePeriod + 1) * eth2Config.EpochsPerSyncCommitteePeriod
+	}
+
+	// Get the node's validators
+	pubkeys, err := minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return nil, err
+	}
+	indices := []uint64{}
+	for _, pubkey := range pubkeys {
+		status, exists := statuses[pubkey]
+		if !exists || !status.Exists {
+			continue
+		}
+		indices = append(indices, status.Index)
+		response.Validators = append(response.Validators, api.ValidatorDuties{
+			Pubkey: pubkey,
+			Index:  status.Index,
+		})
+	}
+	if len(indices) == 0 {
+		return &response, nil
+	}
+
+	// Get their duties
+	proposals, err := bc.GetValidatorProposerDuties(indices, head.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	nextProposals, err := bc.GetValidatorProposerDuties(indices, head.Epoch+1)
+	if err != nil {
+		return nil, err
+	}
+	syncDuties, err := bc.GetValidatorSyncDuties(indices, head.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	nextSyncDuties := map[uint64]bool{}
+	if response.NextSyncCommitteeEpoch > 0 {
+		nextSyncDuties, err = bc.GetValidatorSyncDuties(indices, response.NextSyncCommitteeEpoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i := range response.Validators {
+		index := response.Validators[i].Index
+		response.Validators[i].Proposals = proposals[index]
+		response.Validators[i].NextEpochProposals = nextProposals[index]
+		response.Validators[i].InSyncCommittee = syncDuties[index]
+		response.Validators[i].InNextSyncCommittee = nextSyncDuties[index]
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,185 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func getFeeRecipientStatus(c *cli.Context) (*api.NodeFeeRecipientStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeFeeRecipientStatusResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the fee recipients
+	feeRecipientInfo, err := rputils.GetFeeRecipientInfo(rp, bc, nodeAccount.Address)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting fee recipient info: %w", err)
+	}
+	response.FeeRecipientInfo = *feeRecipientInfo
+	response.DefaultFeeRecipient = feeRecipientInfo.GetCorrectFeeRecipient()
+	validatorFeeRecipients, err := rputils.GetValidatorFeeRecipients(rp, cfg, nodeAccount.Address, feeRecipientInfo)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting validator fee recipients: %w", err)
+	}
+	overrides := rputils.GetFeeRecipientOverrides(validatorFeeRecipients, response.DefaultFeeRecipient)
+
+	// Check the fee recipient files
+	_, response.DefaultFileUpToDate, err = rocketpool.CheckFeeRecipientFile(response.DefaultFeeRecipient, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error validating fee recipient file: %w", err)
+	}
+	response.ProposerConfigUpToDate, err = rocketpool.CheckProposerConfigFiles(response.DefaultFeeRecipient, overrides, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error validating proposer config files: %w", err)
+	}
+
+	// Get the fee recipients the VC is actually using, if the Keymanager API is available
+	response.Validators = make([]api.ValidatorFeeRecipientStatus, len(validatorFeeRecipients))
+	km, err := validator.GetKeymanagerClient(cfg)
+	if err != nil {
+		response.KeymanagerError = err.Error()
+	}
+	for i, recipient := range validatorFeeRecipients {
+		response.Validators[i].ValidatorFeeRecipient = recipient
+		if km == nil || response.KeymanagerError != "" {
+			continue
+		}
+		vcFeeRecipient, err := km.GetFeeRecipient(recipient.Pubkey)
+		if err == nil {
+			response.Validators[i].VcFeeRecipient = vcFeeRecipient
+			response.Validators[i].VcFeeRecipientKnown = true
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func setCustomFeeRecipient(c *cli.Context, pubkey types.ValidatorPubkey, feeRecipient common.Address) (*api.SetCustomFeeRecipientResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SetCustomFeeRecipientResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Active minipool validators must use the node's fee recipient, or they'll be penalized
+	feeRecipientInfo, err := rputils.GetFeeRecipientInfo(rp, bc, nodeAccount.Address)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting fee recipient info: %w", err)
+	}
+	validatorFeeRecipients, err := rputils.GetValidatorFeeRecipients(rp, cfg, nodeAccount.Address, feeRecipientInfo)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting validator fee recipients: %w", err)
+	}
+	for _, recipient := range validatorFeeRecipients {
+		if recipient.IsMinipool && recipient.Pubkey == pubkey {
+			return nil, fmt.Errorf("Validator %s belongs to minipool %s, which must use the node's fee recipient.", pubkey.Hex(), recipient.MinipoolAddress.Hex())
+		}
+	}
+
+	// Save the fee recipient
+	customRecipients, err := rputils.LoadCustomFeeRecipients(cfg)
+	if err != nil {
+		return nil, err
+	}
+	customRecipients[pubkey] = feeRecipient
+	if err := rputils.SaveCustomFeeRecipients(cfg, customRecipients); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func clearCustomFeeRecipient(c *cli.Context, pubkey types.ValidatorPubkey) (*api.ClearCustomFeeRecipientResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ClearCustomFeeRecipientResponse{}
+
+	// Remove the fee recipient
+	customRecipients, err := rputils.LoadCustomFeeRecipients(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := customRecipients[pubkey]; !exists {
+		return &response, nil
+	}
+	response.Cleared = true
+	delete(customRecipients, pubkey)
+	if err := rputils.SaveCustomFeeRecipients(cfg, customRecipients); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,332 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/node"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils"
+	"github.com/urfave/cli"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/offline"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// The API commands that can build a transaction for offline signing; each of them sends a single transaction
+var offlineTransactionCommands = map[string]bool{
+	"node set-withdrawal-address":     true,
+	"node confirm-withdrawal-address": true,
+	"node claim-rewards":              true,
+	"node claim-and-stake-rewards":    true,
+}
+
+// The parts of an API response needed to tell why a transaction wasn't built
+type offlineCommandResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+func buildOfflineTransaction(c *cli.Context, description string, nonce uint64, args []string) (*api.BuildOfflineTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the command
+	if len(args) < 2 || !offlineTransactionCommands[args[0]+" "+args[1]] {
+		return nil, fmt.Errorf("The '%s' command can't be built for offline signing", strings.Join(args, " "))
+	}
+
+	// Response
+	response := api.BuildOfflineTransactionResponse{}
+
+	// Get the node account and its nonce
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err = getOfflineNonce(ec, nodeAccount.Address, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run the command as if it was called from the command line, capturing its transaction; it uses the wallet's gas settings
+	commandArgs := []string{c.App.Name, "--settings", os.ExpandEnv(c.GlobalString("settings")), "api"}
+	commandArgs = append(commandArgs, args...)
+	var output []byte
+	transactions, err := w.CaptureTransactions(nonce, func() error {
+		output = apiutils.RunCommand(c.App, commandArgs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) == 0 {
+		var commandResponse offlineCommandResponse
+		if err := json.Unmarshal(output, &commandResponse); err != nil {
+			return nil, fmt.Errorf("Could not decode the API response: %w", err)
+		}
+		if commandResponse.Status == "error" {
+			return nil, errors.New(commandResponse.Error)
+		}
+		return nil, fmt.Errorf("The '%s' command did not build a transaction", strings.Join(args, " "))
+	}
+
+	// Update & return response
+	response.ChainID = uint64(cfg.Smartnode.GetChainID())
+	response.NodeAddress = nodeAccount.Address
+	response.Transaction, err = newUnsignedTransaction(ec, description, transactions[0])
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+
+}
+
+func buildOfflineDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *big.Int, nonce uint64) (*api.BuildOfflineDepositResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get eth2 config
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure ETH2 is on the correct chain
+	depositContractInfo, err := getDepositContractInfo(c)
+	if err != nil {
+		return nil, err
+	}
+	if depositContractInfo.RPNetwork != depositContractInfo.BeaconNetwork ||
+		depositContractInfo.RPDepositContract != depositContractInfo.BeaconDepositContract {
+		return nil, fmt.Errorf("Beacon network mismatch! Expected %s on chain %d, but beacon is using %s on chain %d.",
+			depositContractInfo.RPDepositContract.Hex(),
+			depositContractInfo.RPNetwork,
+			depositContractInfo.BeaconDepositContract.Hex(),
+			depositContractInfo.BeaconNetwork)
+	}
+
+	// Response
+	response := api.BuildOfflineDepositResponse{}
+
+	// Get the node account and its nonce
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err = getOfflineNonce(ec, nodeAccount.Address, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default the salt to the nonce, like online deposits
+	if salt.Cmp(big.NewInt(0)) == 0 {
+		salt.SetUint64(nonce)
+	}
+
+	// Get the next minipool address and withdrawal credentials
+	depositType, err := node.GetDepositType(rp, amountWei, nil)
+	if err != nil {
+		return nil, err
+	}
+	minipoolAddress, err := utils.GenerateAddress(rp, nodeAccount.Address, depositType, salt, nil)
+	if err != nil {
+		return nil, err
+	}
+	withdrawalCredentials, err := minipool.GetMinipoolWithdrawalCredentials(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the deposit with a throwaway validator key; the offline machine replaces it with a new key from the wallet
+	if err := eth2types.InitBLS(); err != nil {
+		return nil, fmt.Errorf("Could not initialize BLS: %w", err)
+	}
+	placeholderKey, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("Could not generate placeholder validator key: %w", err)
+	}
+	depositData, depositDataRoot, err := validator.GetDepositData(placeholderKey, withdrawalCredentials, eth2Config)
+	if err != nil {
+		return nil, err
+	}
+	pubKey := rptypes.BytesToValidatorPubkey(depositData.PublicKey)
+	signature := rptypes.BytesToValidatorSignature(depositData.Signature)
+	transactions, err := w.CaptureTransactions(nonce, func() error {
+		opts, err := w.GetNodeAccountTransactor()
+		if err != nil {
+			return err
+		}
+		opts.Value = amountWei
+		_, err = node.Deposit(rp, minNodeFee, pubKey, signature, depositDataRoot, salt, minipoolAddress, opts)
+		return err
+	})
+	if len(transactions) == 0 {
+		if err == nil {
+			err = errors.New("The deposit transaction was not built")
+		}
+		return nil, err
+	}
+	tx, err := newUnsignedTransaction(ec, "Deposit", transactions[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// Update & return response
+	response.ChainID = uint64(cfg.Smartnode.GetChainID())
+	response.NodeAddress = nodeAccount.Address
+	response.Deposit = &offline.UnsignedDeposit{
+		UnsignedTransaction:   *tx,
+		MinNodeFee:            minNodeFee,
+		Salt:                  salt,
+		MinipoolAddress:       minipoolAddress,
+		WithdrawalCredentials: withdrawalCredentials,
+		GenesisForkVersion:    eth2Config.GenesisForkVersion,
+	}
+	return &response, nil
+
+}
+
+func broadcastTransaction(c *cli.Context, rawTx string) (*api.BroadcastNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastNodeTransactionResponse{}
+
+	// Decode the transaction and check it was signed by the node account
+	txBytes, err := hexutil.Decode(rawTx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode signed transaction: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("Could not decode signed transaction: %w", err)
+	}
+	chainID := new(big.Int).SetUint64(uint64(cfg.Smartnode.GetChainID()))
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get the signer of transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if from != nodeAccount.Address {
+		return nil, fmt.Errorf("Transaction %s was signed by %s, not the node account %s", tx.Hash().Hex(), from.Hex(), nodeAccount.Address.Hex())
+	}
+
+	// Record it in the journal so it can be followed, then send it
+	if err := tm.RecordSigned(tx); err != nil {
+		return nil, err
+	}
+	if err := ec.SendTransaction(context.Background(), tx); err != nil && !strings.Contains(err.Error(), "already known") {
+		return nil, fmt.Errorf("Could not broadcast transaction %s: %w", tx.Hash().Hex(), err)
+	}
+
+	// Update & return response
+	response.TxHash = tx.Hash()
+	return &response, nil
+
+}
+
+// Get the nonce for a transaction built for offline signing; it's at least the provided nonce,
+// so transactions added to the same bundle follow each other
+func getOfflineNonce(ec *services.ExecutionClientManager, nodeAddress common.Address, minNonce uint64) (uint64, error) {
+	nonce, err := ec.PendingNonceAt(context.Background(), nodeAddress)
+	if err != nil {
+		return 0, fmt.Errorf("Could not get the node account's nonce: %w", err)
+	}
+	if minNonce > nonce {
+		nonce = minNonce
+	}
+	return nonce, nil
+}
+
+// Create an unsigned transaction, describing the contract method it calls so it can be checked on the offline machine
+func newUnsignedTransaction(ec *services.ExecutionClientManager, description string, tx *types.Transaction) (*offline.UnsignedTransaction, error) {
+	unsignedTx := offline.NewUnsignedTransaction(description, tx)
+	if tx.To() == nil || len(tx.Data()) == 0 {
+		return unsignedTx, nil
+	}
+	contractName, contractAbi := ec.ResolveContract(*tx.To())
+	if contractAbi == nil {
+		return nil, fmt.Errorf("Could not find the contract at %s to describe the transaction", tx.To().Hex())
+	}
+	call, err := offline.NewContractCall(contractName, contractAbi, tx.Data())
+	if err != nil {
+		return nil, err
+	}
+	unsignedTx.Call = call
+	return unsignedTx, nil
+}
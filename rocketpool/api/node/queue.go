@@ -0,0 +1,107 @@
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The API commands that can be queued to run when gas is low
+var queueableCommands = map[string]bool{
+	"node claim-rewards":           true,
+	"node claim-and-stake-rewards": true,
+	"node distribute":              true,
+	"node stake-rpl":               true,
+	"node withdraw-rpl":            true,
+	"minipool close":               true,
+	"minipool refund":              true,
+	"minipool delegate-upgrade":    true,
+}
+
+func queueTransaction(c *cli.Context, deferral txmanager.Deferral, description string, args []string) (*api.QueueNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.QueueNodeTransactionResponse{}
+
+	// Check the command can be queued
+	if len(args) < 2 || !queueableCommands[strings.Join(args[:2], " ")] {
+		return nil, fmt.Errorf("The API command '%s' can't be queued.", strings.Join(args, " "))
+	}
+
+	// Queue the transaction with the requested fees; the max fee is decided when it's sent if there isn't one
+	queue := txmanager.NewQueue(cfg.Smartnode.GetTransactionQueuePath())
+	response.ID, err = queue.Add(&txmanager.QueuedTransaction{
+		Deferral:       deferral,
+		Description:    description,
+		Args:           args,
+		MaxFee:         c.GlobalFloat64("maxFee"),
+		MaxPriorityFee: c.GlobalFloat64("maxPrioFee"),
+		GasLimit:       c.GlobalUint64("gasLimit"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func getTransactionQueue(c *cli.Context) (*api.NodeTransactionQueueResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeTransactionQueueResponse{}
+
+	// Get the queued transactions
+	queue := txmanager.NewQueue(cfg.Smartnode.GetTransactionQueuePath())
+	response.Transactions, err = queue.GetTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func cancelQueuedTransaction(c *cli.Context, id uint64) (*api.CancelQueuedNodeTransactionResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CancelQueuedNodeTransactionResponse{}
+
+	// Cancel the transaction
+	queue := txmanager.NewQueue(cfg.Smartnode.GetTransactionQueuePath())
+	if err := queue.Cancel(id); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -36,11 +36,12 @@ func canSetTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.CanSe
 		return nil, err
 	}
 	gasInfo, err := node.EstimateSetTimezoneLocationGas(rp, timezoneLocation, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
-	response.CanSet = true
+	response.CanSet = (response.Revert == nil)
 	return &response, nil
 
 }
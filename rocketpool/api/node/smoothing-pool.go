@@ -10,6 +10,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	"github.com/urfave/cli"
 )
@@ -107,6 +108,7 @@ func canSetSmoothingPoolStatus(c *cli.Context, status bool) (*api.CanSetSmoothin
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Revert, err = services.GetTransactionRevert(err)
 
 	return &response, err
 
@@ -174,24 +176,48 @@ func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolR
 			return nil, err
 		}
 
+		// Get the per-validator fee recipients both with and without the Smoothing Pool
+		feeRecipientInfo, err := rputils.GetFeeRecipientInfo(rp, bc, nodeAccount.Address)
+		if err != nil {
+			return nil, err
+		}
+		distributorRecipients, err := rputils.GetValidatorFeeRecipients(rp, cfg, nodeAccount.Address, feeRecipientInfo)
+		if err != nil {
+			return nil, err
+		}
+		smoothingPoolInfo := *feeRecipientInfo
+		smoothingPoolInfo.IsInSmoothingPool = true
+		smoothingPoolRecipients, err := rputils.GetValidatorFeeRecipients(rp, cfg, nodeAccount.Address, &smoothingPoolInfo)
+		if err != nil {
+			return nil, err
+		}
+		distributorOverrides := rputils.GetFeeRecipientOverrides(distributorRecipients, distributor)
+		smoothingPoolOverrides := rputils.GetFeeRecipientOverrides(smoothingPoolRecipients, *smoothingPoolContract.Address)
+
 		err = rocketpool.UpdateFeeRecipientFile(*smoothingPoolContract.Address, cfg)
+		if err == nil {
+			err = rocketpool.UpdateProposerConfigFiles(*smoothingPoolContract.Address, smoothingPoolOverrides, cfg)
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		// Restart the VC
-		err = validator.RestartValidator(cfg, bc, nil, d)
+		// Update the VC
+		err = validator.UpdateFeeRecipient(cfg, bc, nil, d, *smoothingPoolContract.Address, smoothingPoolOverrides)
 		if err != nil {
 			// Set the fee recipient back to the node distributor
 			err2 := rocketpool.UpdateFeeRecipientFile(distributor, cfg)
+			if err2 == nil {
+				err2 = rocketpool.UpdateProposerConfigFiles(distributor, distributorOverrides, cfg)
+			}
 			if err2 != nil {
-				return nil, fmt.Errorf("***WARNING***\nError restarting validator: [%s]\nError setting fee recipient back to your node's distributor: [%w]\nYour node now has the Smoothing Pool as its fee recipient, even though you aren't opted in!\nPlease visit the Rocket Pool Discord server for help with these errors, so it can be set back to your node's distributor.", err.Error(), err2)
+				return nil, fmt.Errorf("***WARNING***\nError updating validator: [%s]\nError setting fee recipient back to your node's distributor: [%w]\nYour node now has the Smoothing Pool as its fee recipient, even though you aren't opted in!\nPlease visit the Rocket Pool Discord server for help with these errors, so it can be set back to your node's distributor.", err.Error(), err2)
 			}
 
-			// Restart the VC but don't pay attention to the errors, since a restart error got us here in the first place
-			validator.RestartValidator(cfg, bc, nil, d)
+			// Update the VC but don't pay attention to the errors, since an update error got us here in the first place
+			validator.UpdateFeeRecipient(cfg, bc, nil, d, distributor, distributorOverrides)
 
-			return nil, fmt.Errorf("Error restarting validator after updating the fee recipient to the Smoothing Pool: [%w]\nYour fee recipient has been set back to your node's distributor contract.\nYou have not been opted into the Smoothing Pool.", err)
+			return nil, fmt.Errorf("Error updating validator after changing the fee recipient to the Smoothing Pool: [%w]\nYour fee recipient has been set back to your node's distributor contract.\nYou have not been opted into the Smoothing Pool.", err)
 		}
 	}
 
@@ -60,13 +60,14 @@ func canNodeStakeRpl(c *cli.Context, amountWei *big.Int) (*api.CanNodeStakeRplRe
 		return nil, err
 	}
 	gasInfo, err := node.EstimateStakeGas(rp, amountWei, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 	response.GasInfo = gasInfo
 
 	// Update & return response
-	response.CanStake = !(response.InsufficientBalance || !response.InConsensus)
+	response.CanStake = !(response.InsufficientBalance || !response.InConsensus || response.Revert != nil)
 	return &response, nil
 
 }
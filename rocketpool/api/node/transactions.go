@@ -0,0 +1,194 @@
+package node
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getTransactions(c *cli.Context) (*api.NodeTransactionsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeTransactionsResponse{}
+
+	// Update the journal and get its transactions
+	if err := tm.Update(); err != nil {
+		return nil, err
+	}
+	transactions, err := tm.GetTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	// The signed transactions aren't needed by the CLI
+	for _, tx := range transactions {
+		tx.Raw = nil
+	}
+	response.Transactions = transactions
+
+	// Return response
+	return &response, nil
+
+}
+
+func canSpeedUpTransaction(c *cli.Context, hash common.Hash) (*api.CanSpeedUpNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanSpeedUpNodeTransactionResponse{}
+
+	// Get the transaction and the fees to replace it with
+	tx, err := tm.GetReplaceableTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	response.MaxFee, response.MaxPriorityFee, err = tm.GetReplacementFees(tx, opts.GasFeeCap, opts.GasTipCap)
+	if err != nil {
+		return nil, err
+	}
+	tx.Raw = nil
+	response.Transaction = tx
+
+	// Return response
+	return &response, nil
+
+}
+
+func speedUpTransaction(c *cli.Context, hash common.Hash) (*api.SpeedUpNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SpeedUpNodeTransactionResponse{}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace the transaction
+	response.TxHash, err = tm.SpeedUp(hash, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func canCancelTransaction(c *cli.Context, hash common.Hash) (*api.CanCancelNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanCancelNodeTransactionResponse{
+		GasLimit: txmanager.CancelGasLimit,
+	}
+
+	// Get the transaction and the fees to replace it with
+	tx, err := tm.GetReplaceableTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	response.MaxFee, response.MaxPriorityFee, err = tm.GetReplacementFees(tx, opts.GasFeeCap, opts.GasTipCap)
+	if err != nil {
+		return nil, err
+	}
+	tx.Raw = nil
+	response.Transaction = tx
+
+	// Return response
+	return &response, nil
+
+}
+
+func cancelTransaction(c *cli.Context, hash common.Hash) (*api.CancelNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CancelNodeTransactionResponse{}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace the transaction
+	response.TxHash, err = tm.Cancel(hash, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -66,6 +66,7 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecuteTNDAO
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, err = services.GetTransactionRevert(err)
 		return err
 	})
 
@@ -75,7 +76,7 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecuteTNDAO
 	}
 
 	// Update & return response
-	response.CanExecute = !(response.DoesNotExist || response.InvalidState)
+	response.CanExecute = !(response.DoesNotExist || response.InvalidState || response.Revert != nil)
 	return &response, nil
 
 }
@@ -93,6 +93,7 @@ func canJoin(c *cli.Context) (*api.CanJoinTNDAOResponse, error) {
 			return err
 		}
 		approveGasInfo, err := tokens.EstimateApproveRPLGas(rp, *rocketDAONodeTrustedActionsAddress, rplBondAmount, opts)
+		response.Revert, err = services.GetTransactionRevert(err)
 		if err != nil {
 			return err
 		}
@@ -113,7 +114,7 @@ func canJoin(c *cli.Context) (*api.CanJoinTNDAOResponse, error) {
 	response.InsufficientRplBalance = (nodeRplBalance.Cmp(rplBondAmount) < 0)
 
 	// Update & return response
-	response.CanJoin = !(response.ProposalExpired || response.AlreadyMember || response.InsufficientRplBalance)
+	response.CanJoin = !(response.ProposalExpired || response.AlreadyMember || response.InsufficientRplBalance || response.Revert != nil)
 	return &response, nil
 
 }
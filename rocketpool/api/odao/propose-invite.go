@@ -67,6 +67,7 @@ func canProposeInvite(c *cli.Context, memberAddress common.Address, memberId, me
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, err = services.GetTransactionRevert(err)
 		return err
 	})
 
@@ -76,7 +77,7 @@ func canProposeInvite(c *cli.Context, memberAddress common.Address, memberId, me
 	}
 
 	// Update & return response
-	response.CanPropose = !(response.ProposalCooldownActive || response.MemberAlreadyExists)
+	response.CanPropose = !(response.ProposalCooldownActive || response.MemberAlreadyExists || response.Revert != nil)
 	return &response, nil
 
 }
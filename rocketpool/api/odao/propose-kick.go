@@ -78,6 +78,7 @@ func canProposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, err = services.GetTransactionRevert(err)
 		return err
 	})
 
@@ -87,7 +88,7 @@ func canProposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei
 	}
 
 	// Update & return response
-	response.CanPropose = !(response.ProposalCooldownActive || response.InsufficientRplBond)
+	response.CanPropose = !(response.ProposalCooldownActive || response.InsufficientRplBond || response.Revert != nil)
 	return &response, nil
 
 }
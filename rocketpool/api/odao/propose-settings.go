@@ -64,11 +64,13 @@ func canProposeSettingMembersQuorum(c *cli.Context, quorum float64) (*api.CanPro
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeQuorumGas(rp, quorum, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -142,11 +144,13 @@ func canProposeSettingMembersRplBond(c *cli.Context, bondAmountWei *big.Int) (*a
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeRPLBondGas(rp, bondAmountWei, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -220,11 +224,13 @@ func canProposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax ui
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeMinipoolUnbondedMaxGas(rp, unbondedMinipoolMax, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -298,11 +304,13 @@ func canProposeSettingProposalCooldown(c *cli.Context, proposalCooldownTimespan
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeProposalCooldownTimeGas(rp, proposalCooldownTimespan, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -376,11 +384,13 @@ func canProposeSettingProposalVoteTimespan(c *cli.Context, proposalVoteTimespan
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeProposalVoteTimeGas(rp, proposalVoteTimespan, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -454,11 +464,13 @@ func canProposeSettingProposalVoteDelayTimespan(c *cli.Context, proposalDelayTim
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeProposalVoteDelayTimeGas(rp, proposalDelayTimespan, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -532,11 +544,13 @@ func canProposeSettingProposalExecuteTimespan(c *cli.Context, proposalExecuteTim
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeProposalExecuteTimeGas(rp, proposalExecuteTimespan, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -610,11 +624,13 @@ func canProposeSettingProposalActionTimespan(c *cli.Context, proposalActionTimes
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeProposalActionTimeGas(rp, proposalActionTimespan, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
@@ -688,11 +704,13 @@ func canProposeSettingScrubPeriod(c *cli.Context, scrubPeriod uint64) (*api.CanP
 		return nil, err
 	}
 	gasInfo, err := trustednode.EstimateProposeScrubPeriodGas(rp, scrubPeriod, opts)
+	response.Revert, err = services.GetTransactionRevert(err)
 	if err != nil {
 		return nil, err
 	}
 
 	response.GasInfo = gasInfo
+	response.CanPropose = (response.CanPropose && response.Revert == nil)
 	return response, nil
 
 }
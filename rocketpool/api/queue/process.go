@@ -74,6 +74,7 @@ func canProcessQueue(c *cli.Context) (*api.CanProcessQueueResponse, error) {
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Revert, err = services.GetTransactionRevert(err)
 		return err
 	})
 
@@ -87,7 +88,7 @@ func canProcessQueue(c *cli.Context) (*api.CanProcessQueueResponse, error) {
 	response.InsufficientDepositBalance = (depositPoolBalance.Cmp(nextMinipoolCapacity) < 0)
 
 	// Update & return response
-	response.CanProcess = !(response.AssignDepositsDisabled || response.NoMinipoolsAvailable || response.InsufficientDepositBalance)
+	response.CanProcess = !(response.AssignDepositsDisabled || response.NoMinipoolsAvailable || response.InsufficientDepositBalance || response.Revert != nil)
 	return &response, nil
 
 }
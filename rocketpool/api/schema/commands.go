@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/urfave/cli"
+)
+
+// Register the API schema command
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:      name,
+		Aliases:   aliases,
+		Usage:     "Generate the OpenAPI document for the API commands, or check that a previously generated one is up to date",
+		UsageText: "rocketpool api-schema [--check] [file]",
+		Hidden:    true,
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "check, c",
+				Usage: "Fail if the document in the file doesn't match the API commands and their response types, instead of writing it",
+			},
+		},
+		Action: func(c *cli.Context) error {
+
+			// Validate args
+			if c.NArg() > 1 {
+				return fmt.Errorf("Incorrect argument count; usage: %s", c.Command.UsageText)
+			}
+
+			// Run
+			return generate(c.Args().Get(0), c.Bool("check"))
+
+		},
+	})
+}
+
+// Generate the OpenAPI document and print it, write it to a file, or compare it with a file
+func generate(path string, check bool) error {
+
+	document, err := GenerateOpenApi()
+	if err != nil {
+		return err
+	}
+
+	if check {
+		if path == "" {
+			return fmt.Errorf("A file to check must be provided")
+		}
+		existing, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("Could not read the OpenAPI document: %w", err)
+		}
+		matches, err := documentsMatch(existing, document)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			return fmt.Errorf("The OpenAPI document at %s is out of date with the API commands and their response types. Regenerate it with `rocketpool api-schema %s`.", path, path)
+		}
+		fmt.Printf("The OpenAPI document at %s is up to date.\n", path)
+		return nil
+	}
+
+	if path == "" {
+		_, err = os.Stdout.Write(document)
+		return err
+	}
+	if err := ioutil.WriteFile(path, document, 0644); err != nil {
+		return fmt.Errorf("Could not write the OpenAPI document: %w", err)
+	}
+	fmt.Printf("Wrote the OpenAPI document to %s.\n", path)
+	return nil
+
+}
+
+// Compare two OpenAPI documents, ignoring the Smartnode version they were generated with
+func documentsMatch(existing []byte, generated []byte) (bool, error) {
+	normalized := [][]byte{}
+	for _, document := range [][]byte{existing, generated} {
+		var contents map[string]interface{}
+		if err := json.Unmarshal(document, &contents); err != nil {
+			return false, fmt.Errorf("Could not parse the OpenAPI document: %w", err)
+		}
+		if info, ok := contents["info"].(map[string]interface{}); ok {
+			delete(info, "version")
+		}
+		serialized, err := json.Marshal(contents)
+		if err != nil {
+			return false, fmt.Errorf("Could not serialize the OpenAPI document: %w", err)
+		}
+		normalized = append(normalized, serialized)
+	}
+	return bytes.Equal(normalized[0], normalized[1]), nil
+}
@@ -0,0 +1,193 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/rocketpool/api"
+	"github.com/rocket-pool/smartnode/shared"
+)
+
+// Settings
+const (
+	OpenApiVersion = "3.0.3"
+	apiCommandName = "api"
+)
+
+// Generate the OpenAPI document describing every API command, its arguments and its response
+func GenerateOpenApi() ([]byte, error) {
+
+	// Build the API command tree
+	app := cli.NewApp()
+	api.RegisterCommands(app, apiCommandName, []string{})
+	commands := map[string]cli.Command{}
+	collectCommands("", app.Commands[0].Subcommands, commands)
+
+	// Check that the registered response types match the commands
+	for path := range responseTypes {
+		if _, exists := commands[path]; !exists {
+			return nil, fmt.Errorf("a response type is registered for the API command '%s', but that command does not exist", path)
+		}
+	}
+	paths := make([]string, 0, len(commands))
+	for path := range commands {
+		if _, exists := responseTypes[path]; !exists {
+			return nil, fmt.Errorf("the API command '%s' has no registered response type; add it to the response types in rocketpool/api/schema", path)
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	// Describe each command
+	builder := newSchemaBuilder()
+	operations := map[string]interface{}{}
+	for _, path := range paths {
+		response := responseTypes[path]
+		if response == nil {
+			continue
+		}
+		responseType := reflect.TypeOf(response)
+		if err := checkResponseType(responseType); err != nil {
+			return nil, fmt.Errorf("invalid response type for the API command '%s': %w", path, err)
+		}
+		responseSchema, err := builder.schemaFor(responseType)
+		if err != nil {
+			return nil, fmt.Errorf("error describing the response of the API command '%s': %w", path, err)
+		}
+		operation, err := describeCommand(path, commands[path], responseSchema)
+		if err != nil {
+			return nil, err
+		}
+		operations["/"+strings.ReplaceAll(path, " ", "/")] = map[string]interface{}{"post": operation}
+	}
+
+	document := map[string]interface{}{
+		"openapi": OpenApiVersion,
+		"info": map[string]interface{}{
+			"title":   "Rocket Pool Smartnode API",
+			"version": shared.RocketPoolVersion,
+			"description": "The commands run by `rocketpool api`. Each path is an API command (e.g. `/node/status` is `rocketpool api node status`), " +
+				"which can also be called through the API server socket with its path and positional arguments in the `args` of a call request.",
+		},
+		"paths": operations,
+		"components": map[string]interface{}{
+			"schemas": builder.components,
+		},
+	}
+
+	// Serialize it with a trailing newline so it can be committed as-is
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, fmt.Errorf("error serializing OpenAPI document: %w", err)
+	}
+	return buffer.Bytes(), nil
+
+}
+
+// Collect the commands that can be run (those without subcommands) by their path
+func collectCommands(prefix string, commands []cli.Command, paths map[string]cli.Command) {
+	for _, command := range commands {
+		path := command.Name
+		if prefix != "" {
+			path = prefix + " " + command.Name
+		}
+		if len(command.Subcommands) > 0 {
+			collectCommands(path, command.Subcommands, paths)
+			continue
+		}
+		paths[path] = command
+	}
+}
+
+// Make sure a response type can be printed by api.PrintResponse
+func checkResponseType(t reflect.Type) error {
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("%s is not a struct", t.String())
+	}
+	for _, name := range []string{"Status", "Error"} {
+		field, exists := t.FieldByName(name)
+		if !exists || field.Type.Kind() != reflect.String {
+			return fmt.Errorf("%s has no %s string field", t.String(), name)
+		}
+	}
+	return nil
+}
+
+// Describe an API command as an OpenAPI operation
+func describeCommand(path string, command cli.Command, responseSchema Schema) (map[string]interface{}, error) {
+
+	// The positional arguments are listed after the command path in its usage text
+	usagePrefix := fmt.Sprintf("rocketpool %s %s", apiCommandName, path)
+	if !strings.HasPrefix(command.UsageText, usagePrefix) {
+		return nil, fmt.Errorf("the usage text of the API command '%s' doesn't start with '%s'", path, usagePrefix)
+	}
+	args := strings.Fields(strings.TrimPrefix(command.UsageText, usagePrefix))
+	argsSchema := Schema{
+		"type":     "array",
+		"items":    Schema{"type": "string"},
+		"minItems": len(args),
+		"maxItems": len(args),
+	}
+	if len(args) > 0 {
+		argsSchema["description"] = "The positional arguments, in order: " + strings.Join(args, ", ")
+	}
+
+	operation := map[string]interface{}{
+		"operationId": strings.ReplaceAll(path, " ", "-"),
+		"summary":     command.Usage,
+		"tags":        []string{strings.Fields(path)[0]},
+		"requestBody": map[string]interface{}{
+			"required": len(args) > 0,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": Schema{
+						"type":       "object",
+						"properties": map[string]interface{}{"args": argsSchema},
+					},
+				},
+			},
+		},
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "The command's response. Its status is 'error' and its error is set if the command failed.",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": responseSchema,
+					},
+				},
+			},
+		},
+	}
+	if len(args) > 0 {
+		operation["x-args"] = args
+	}
+
+	// Flags
+	if len(command.Flags) > 0 {
+		flags := []map[string]interface{}{}
+		for _, flag := range command.Flags {
+			names := strings.Split(flag.GetName(), ",")
+			description := ""
+			if docFlag, ok := flag.(cli.DocGenerationFlag); ok {
+				description = docFlag.GetUsage()
+			}
+			flags = append(flags, map[string]interface{}{
+				"name":        "--" + strings.TrimSpace(names[0]),
+				"description": description,
+			})
+		}
+		operation["x-flags"] = flags
+	}
+
+	return operation, nil
+
+}
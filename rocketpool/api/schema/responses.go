@@ -0,0 +1,213 @@
+package schema
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The response type of each API command, by its path under `rocketpool api`.
+// Every API command must have an entry here; commands that don't print a JSON response are mapped to nil.
+var responseTypes = map[string]interface{}{
+
+	// Auction
+	"auction status":          api.AuctionStatusResponse{},
+	"auction lots":            api.AuctionLotsResponse{},
+	"auction can-create-lot":  api.CanCreateLotResponse{},
+	"auction create-lot":      api.CreateLotResponse{},
+	"auction can-bid-lot":     api.CanBidOnLotResponse{},
+	"auction bid-lot":         api.BidOnLotResponse{},
+	"auction can-claim-lot":   api.CanClaimFromLotResponse{},
+	"auction claim-lot":       api.ClaimFromLotResponse{},
+	"auction can-recover-lot": api.CanRecoverRPLFromLotResponse{},
+	"auction recover-lot":     api.RecoverRPLFromLotResponse{},
+
+	// Faucet
+	"faucet status":           api.FaucetStatusResponse{},
+	"faucet can-withdraw-rpl": api.CanFaucetWithdrawRplResponse{},
+	"faucet withdraw-rpl":     api.FaucetWithdrawRplResponse{},
+
+	// Minipool
+	"minipool status":                      api.MinipoolStatusResponse{},
+	"minipool performance":                 api.MinipoolPerformanceResponse{},
+	"minipool can-stake":                   api.CanStakeMinipoolResponse{},
+	"minipool stake":                       api.StakeMinipoolResponse{},
+	"minipool can-refund":                  api.CanRefundMinipoolResponse{},
+	"minipool refund":                      api.RefundMinipoolResponse{},
+	"minipool can-dissolve":                api.CanDissolveMinipoolResponse{},
+	"minipool dissolve":                    api.DissolveMinipoolResponse{},
+	"minipool can-exit":                    api.CanExitMinipoolResponse{},
+	"minipool exit":                        api.ExitMinipoolResponse{},
+	"minipool can-close":                   api.CanCloseMinipoolResponse{},
+	"minipool close":                       api.CloseMinipoolResponse{},
+	"minipool can-finalize":                api.CanFinaliseMinipoolResponse{},
+	"minipool finalize":                    api.FinaliseMinipoolResponse{},
+	"minipool sign-exit":                   api.SignMinipoolExitResponse{},
+	"minipool build-offline-exit":          api.BuildOfflineMinipoolExitResponse{},
+	"minipool broadcast-exit":              api.BroadcastMinipoolExitResponse{},
+	"minipool can-delegate-upgrade":        api.CanDelegateUpgradeResponse{},
+	"minipool delegate-upgrade":            api.DelegateUpgradeResponse{},
+	"minipool can-delegate-rollback":       api.CanDelegateRollbackResponse{},
+	"minipool delegate-rollback":           api.DelegateRollbackResponse{},
+	"minipool can-set-use-latest-delegate": api.CanSetUseLatestDelegateResponse{},
+	"minipool set-use-latest-delegate":     api.SetUseLatestDelegateResponse{},
+	"minipool get-use-latest-delegate":     api.GetUseLatestDelegateResponse{},
+	"minipool get-delegate":                api.GetDelegateResponse{},
+	"minipool get-previous-delegate":       api.GetPreviousDelegateResponse{},
+	"minipool get-effective-delegate":      api.GetEffectiveDelegateResponse{},
+	"minipool get-vanity-artifacts":        api.GetVanityArtifactsResponse{},
+
+	// Network
+	"network node-fee":                  api.NodeFeeResponse{},
+	"network rpl-price":                 api.RplPriceResponse{},
+	"network stats":                     api.NetworkStatsResponse{},
+	"network timezone-map":              api.NetworkTimezonesResponse{},
+	"network can-generate-rewards-tree": api.CanNetworkGenerateRewardsTreeResponse{},
+	"network generate-rewards-tree":     api.NetworkGenerateRewardsTreeResponse{},
+	"network dao-proposals":             api.NetworkDAOProposalsResponse{},
+	"network fee-suggestions":           api.NetworkFeeSuggestionsResponse{},
+
+	// Node
+	"node status":                                 api.NodeStatusResponse{},
+	"node sync":                                   api.NodeSyncProgressResponse{},
+	"node duties":                                 api.NodeDutiesResponse{},
+	"node can-register":                           api.CanRegisterNodeResponse{},
+	"node register":                               api.RegisterNodeResponse{},
+	"node can-set-withdrawal-address":             api.CanSetNodeWithdrawalAddressResponse{},
+	"node set-withdrawal-address":                 api.SetNodeWithdrawalAddressResponse{},
+	"node can-confirm-withdrawal-address":         api.CanConfirmNodeWithdrawalAddressResponse{},
+	"node confirm-withdrawal-address":             api.ConfirmNodeWithdrawalAddressResponse{},
+	"node can-set-timezone":                       api.CanSetNodeTimezoneResponse{},
+	"node set-timezone":                           api.SetNodeTimezoneResponse{},
+	"node can-swap-rpl":                           api.CanNodeSwapRplResponse{},
+	"node swap-rpl-approve-rpl":                   api.NodeSwapRplApproveResponse{},
+	"node wait-and-swap-rpl":                      api.NodeSwapRplSwapResponse{},
+	"node get-swap-rpl-approval-gas":              api.NodeSwapRplApproveGasResponse{},
+	"node swap-rpl-allowance":                     api.NodeSwapRplAllowanceResponse{},
+	"node swap-rpl":                               api.NodeSwapRplSwapResponse{},
+	"node can-stake-rpl":                          api.CanNodeStakeRplResponse{},
+	"node stake-rpl-approve-rpl":                  api.NodeStakeRplApproveResponse{},
+	"node wait-and-stake-rpl":                     api.NodeStakeRplStakeResponse{},
+	"node get-stake-rpl-approval-gas":             api.NodeStakeRplApproveGasResponse{},
+	"node stake-rpl-allowance":                    api.NodeStakeRplAllowanceResponse{},
+	"node stake-rpl":                              api.NodeStakeRplStakeResponse{},
+	"node can-withdraw-rpl":                       api.CanNodeWithdrawRplResponse{},
+	"node withdraw-rpl":                           api.NodeWithdrawRplResponse{},
+	"node can-deposit":                            api.CanNodeDepositResponse{},
+	"node deposit":                                api.NodeDepositResponse{},
+	"node can-send":                               api.CanNodeSendResponse{},
+	"node send":                                   api.NodeSendResponse{},
+	"node can-burn":                               api.CanNodeBurnResponse{},
+	"node burn":                                   api.NodeBurnResponse{},
+	"node can-claim-rpl-rewards":                  api.CanNodeClaimRplResponse{},
+	"node claim-rpl-rewards":                      api.NodeClaimRplResponse{},
+	"node rewards":                                api.NodeRewardsResponse{},
+	"node deposit-contract-info":                  api.DepositContractInfoResponse{},
+	"node sign":                                   api.NodeSignResponse{},
+	"node sign-message":                           api.NodeSignResponse{},
+	"node estimate-set-snapshot-delegate-gas":     api.EstimateSetSnapshotDelegateGasResponse{},
+	"node set-snapshot-delegate":                  api.SetSnapshotDelegateResponse{},
+	"node estimate-clear-snapshot-delegate-gas":   api.EstimateClearSnapshotDelegateGasResponse{},
+	"node clear-snapshot-delegate":                api.ClearSnapshotDelegateResponse{},
+	"node is-fee-distributor-initialized":         api.NodeIsFeeDistributorInitializedResponse{},
+	"node get-initialize-fee-distributor-gas":     api.NodeInitializeFeeDistributorGasResponse{},
+	"node initialize-fee-distributor":             api.NodeInitializeFeeDistributorResponse{},
+	"node can-distribute":                         api.NodeCanDistributeResponse{},
+	"node distribute":                             api.NodeDistributeResponse{},
+	"node get-rewards-info":                       api.NodeGetRewardsInfoResponse{},
+	"node can-claim-rewards":                      api.CanNodeClaimRewardsResponse{},
+	"node claim-rewards":                          api.NodeClaimRewardsResponse{},
+	"node can-claim-and-stake-rewards":            api.CanNodeClaimAndStakeRewardsResponse{},
+	"node claim-and-stake-rewards":                api.NodeClaimAndStakeRewardsResponse{},
+	"node get-smoothing-pool-registration-status": api.GetSmoothingPoolRegistrationStatusResponse{},
+	"node fee-recipient-status":                   api.NodeFeeRecipientStatusResponse{},
+	"node set-custom-fee-recipient":               api.SetCustomFeeRecipientResponse{},
+	"node clear-custom-fee-recipient":             api.ClearCustomFeeRecipientResponse{},
+	"node can-set-smoothing-pool-status":          api.CanSetSmoothingPoolRegistrationStatusResponse{},
+	"node set-smoothing-pool-status":              api.SetSmoothingPoolRegistrationStatusResponse{},
+	"node tx-list":                                api.NodeTransactionsResponse{},
+	"node can-speed-up-tx":                        api.CanSpeedUpNodeTransactionResponse{},
+	"node speed-up-tx":                            api.SpeedUpNodeTransactionResponse{},
+	"node can-cancel-tx":                          api.CanCancelNodeTransactionResponse{},
+	"node cancel-tx":                              api.CancelNodeTransactionResponse{},
+	"node queue-tx":                               api.QueueNodeTransactionResponse{},
+	"node tx-queue":                               api.NodeTransactionQueueResponse{},
+	"node cancel-queued-tx":                       api.CancelQueuedNodeTransactionResponse{},
+	"node build-offline-tx":                       api.BuildOfflineTransactionResponse{},
+	"node build-offline-deposit":                  api.BuildOfflineDepositResponse{},
+	"node broadcast-tx":                           api.BroadcastNodeTransactionResponse{},
+
+	// Oracle DAO
+	"odao status":                                    api.TNDAOStatusResponse{},
+	"odao members":                                   api.TNDAOMembersResponse{},
+	"odao proposals":                                 api.TNDAOProposalsResponse{},
+	"odao proposal-details":                          api.TNDAOProposalResponse{},
+	"odao can-propose-invite":                        api.CanProposeTNDAOInviteResponse{},
+	"odao propose-invite":                            api.ProposeTNDAOInviteResponse{},
+	"odao can-propose-leave":                         api.CanProposeTNDAOLeaveResponse{},
+	"odao propose-leave":                             api.ProposeTNDAOLeaveResponse{},
+	"odao can-propose-kick":                          api.CanProposeTNDAOKickResponse{},
+	"odao propose-kick":                              api.ProposeTNDAOKickResponse{},
+	"odao can-cancel-proposal":                       api.CanCancelTNDAOProposalResponse{},
+	"odao cancel-proposal":                           api.CancelTNDAOProposalResponse{},
+	"odao can-vote-proposal":                         api.CanVoteOnTNDAOProposalResponse{},
+	"odao vote-proposal":                             api.VoteOnTNDAOProposalResponse{},
+	"odao can-execute-proposal":                      api.CanExecuteTNDAOProposalResponse{},
+	"odao execute-proposal":                          api.ExecuteTNDAOProposalResponse{},
+	"odao can-join":                                  api.CanJoinTNDAOResponse{},
+	"odao join-approve-rpl":                          api.JoinTNDAOApproveResponse{},
+	"odao join":                                      api.JoinTNDAOJoinResponse{},
+	"odao can-leave":                                 api.CanLeaveTNDAOResponse{},
+	"odao leave":                                     api.LeaveTNDAOResponse{},
+	"odao can-propose-members-quorum":                api.CanProposeTNDAOSettingResponse{},
+	"odao propose-members-quorum":                    api.ProposeTNDAOSettingMembersQuorumResponse{},
+	"odao can-propose-members-rplbond":               api.CanProposeTNDAOSettingResponse{},
+	"odao propose-members-rplbond":                   api.ProposeTNDAOSettingMembersRplBondResponse{},
+	"odao can-propose-members-minipool-unbonded-max": api.CanProposeTNDAOSettingResponse{},
+	"odao propose-members-minipool-unbonded-max":     api.ProposeTNDAOSettingMinipoolUnbondedMaxResponse{},
+	"odao can-propose-proposal-cooldown":             api.CanProposeTNDAOSettingResponse{},
+	"odao propose-proposal-cooldown":                 api.ProposeTNDAOSettingProposalCooldownResponse{},
+	"odao can-propose-proposal-vote-timespan":        api.CanProposeTNDAOSettingResponse{},
+	"odao propose-proposal-vote-timespan":            api.ProposeTNDAOSettingProposalVoteTimespanResponse{},
+	"odao can-propose-proposal-vote-delay-timespan":  api.CanProposeTNDAOSettingResponse{},
+	"odao propose-proposal-vote-delay-timespan":      api.ProposeTNDAOSettingProposalVoteDelayTimespanResponse{},
+	"odao can-propose-proposal-execute-timespan":     api.CanProposeTNDAOSettingResponse{},
+	"odao propose-proposal-execute-timespan":         api.ProposeTNDAOSettingProposalExecuteTimespanResponse{},
+	"odao can-propose-proposal-action-timespan":      api.CanProposeTNDAOSettingResponse{},
+	"odao propose-proposal-action-timespan":          api.ProposeTNDAOSettingProposalActionTimespanResponse{},
+	"odao can-propose-scrub-period":                  api.CanProposeTNDAOSettingResponse{},
+	"odao propose-scrub-period":                      api.ProposeTNDAOSettingScrubPeriodResponse{},
+	"odao get-member-settings":                       api.GetTNDAOMemberSettingsResponse{},
+	"odao get-proposal-settings":                     api.GetTNDAOProposalSettingsResponse{},
+	"odao get-minipool-settings":                     api.GetTNDAOMinipoolSettingsResponse{},
+
+	// Queue
+	"queue status":      api.QueueStatusResponse{},
+	"queue can-process": api.CanProcessQueueResponse{},
+	"queue process":     api.ProcessQueueResponse{},
+
+	// Wallet
+	"wallet status":                  api.WalletStatusResponse{},
+	"wallet set-password":            api.SetPasswordResponse{},
+	"wallet unlock":                  api.UnlockWalletResponse{},
+	"wallet init":                    api.InitWalletResponse{},
+	"wallet recover":                 api.RecoverWalletResponse{},
+	"wallet search-and-recover":      api.SearchAndRecoverWalletResponse{},
+	"wallet rebuild":                 api.RebuildWalletResponse{},
+	"wallet test-recovery":           api.RecoverWalletResponse{},
+	"wallet test-search-and-recover": api.SearchAndRecoverWalletResponse{},
+	"wallet import-validator-key":    api.ImportValidatorKeyResponse{},
+	"wallet export":                  api.ExportWalletResponse{},
+	"wallet purge":                   api.PurgeResponse{},
+	"wallet set-offline-address":     api.SetOfflineNodeAddressResponse{},
+	"wallet sign-offline":            api.SignOfflineBundleResponse{},
+
+	// Service
+	"service terminate-data-folder": api.TerminateDataFolderResponse{},
+	"service get-client-status":     api.ClientStatusResponse{},
+	"service check-backup-node":     api.CheckBackupNodeResponse{},
+
+	// Debug
+	"debug export-validators": nil, // Writes a TSV file instead of a JSON response
+
+	// General
+	"wait": api.APIResponse{},
+}
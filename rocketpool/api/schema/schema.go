@@ -0,0 +1,259 @@
+package schema
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+)
+
+// A JSON Schema, in the dialect used by OpenAPI 3.0
+type Schema map[string]interface{}
+
+// Reflection types
+var (
+	bigIntType        = reflect.TypeOf(big.Int{})
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Schemas for types with their own JSON encoding
+var customSchemas = map[reflect.Type]func() Schema{
+	reflect.TypeOf(rptypes.ValidatorPubkey{}):    func() Schema { return Schema{"type": "string", "pattern": "^[0-9a-f]{96}$"} },
+	reflect.TypeOf(rptypes.ValidatorSignature{}): func() Schema { return Schema{"type": "string", "pattern": "^[0-9a-f]{192}$"} },
+	reflect.TypeOf(rptypes.MinipoolStatus(0)):    func() Schema { return enumSchema(rptypes.MinipoolStatuses) },
+	reflect.TypeOf(rptypes.MinipoolDeposit(0)):   func() Schema { return enumSchema(rptypes.MinipoolDepositTypes) },
+	reflect.TypeOf(rptypes.ProposalState(0)):     func() Schema { return enumSchema(rptypes.ProposalStates) },
+	reflect.TypeOf(json.RawMessage{}):            func() Schema { return Schema{} },
+}
+
+// Builds JSON Schemas from Go types, following the rules of encoding/json.
+// Named struct types are added to the components and referenced so each is only described once.
+type schemaBuilder struct {
+	components map[string]Schema
+	typeNames  map[reflect.Type]string
+}
+
+// Create a new schema builder
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{
+		components: map[string]Schema{},
+		typeNames:  map[reflect.Type]string{},
+	}
+}
+
+// Get the schema for a type
+func (b *schemaBuilder) schemaFor(t reflect.Type) (Schema, error) {
+
+	// Pointers serialize as their element, or null
+	if t.Kind() == reflect.Ptr {
+		schema, err := b.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullable(schema), nil
+	}
+
+	// Types with their own encoding
+	if schema, exists := customSchemas[t]; exists {
+		return schema(), nil
+	}
+	switch {
+	case t == bigIntType:
+		return Schema{"type": "integer"}, nil
+	case t == timeType:
+		return Schema{"type": "string", "format": "date-time"}, nil
+	case implements(t, jsonMarshalerType):
+		if implements(t, textMarshalerType) && t.Kind() != reflect.Struct {
+			return Schema{"type": "string"}, nil
+		}
+		if t.Kind() == reflect.Struct && t.NumField() == 1 && t.Field(0).Anonymous && t.Field(0).Type == bigIntType {
+			// Quoted big integers
+			return Schema{"type": "string", "pattern": "^-?[0-9]+$"}, nil
+		}
+		return nil, fmt.Errorf("type %s has a custom JSON encoding that can't be described", t.String())
+	case implements(t, textMarshalerType):
+		return Schema{"type": "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return Schema{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Schema{"type": "integer"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Schema{"type": "integer", "minimum": 0}, nil
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}, nil
+	case reflect.String:
+		return Schema{"type": "string"}, nil
+	case reflect.Interface:
+		return Schema{}, nil
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && !implements(t.Elem(), jsonMarshalerType) && !implements(t.Elem(), textMarshalerType) {
+			return nullable(Schema{"type": "string", "format": "byte"}), nil
+		}
+		items, err := b.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullable(Schema{"type": "array", "items": items}), nil
+
+	case reflect.Array:
+		items, err := b.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return Schema{"type": "array", "items": items, "minItems": t.Len(), "maxItems": t.Len()}, nil
+
+	case reflect.Map:
+		values, err := b.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullable(Schema{"type": "object", "additionalProperties": values}), nil
+
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+		name, err := b.addComponent(t)
+		if err != nil {
+			return nil, err
+		}
+		return Schema{"$ref": "#/components/schemas/" + name}, nil
+	}
+
+	return nil, fmt.Errorf("type %s can't be serialized to JSON", t.String())
+
+}
+
+// Add a named struct type to the components, returning its name
+func (b *schemaBuilder) addComponent(t reflect.Type) (string, error) {
+	if name, exists := b.typeNames[t]; exists {
+		return name, nil
+	}
+
+	// Types from the API package use their own names, others are prefixed with their package
+	name := t.Name()
+	if !strings.HasSuffix(t.PkgPath(), "/shared/types/api") {
+		pkgPath := strings.Split(t.PkgPath(), "/")
+		name = pkgPath[len(pkgPath)-1] + "." + name
+	}
+	for otherType, otherName := range b.typeNames {
+		if otherName == name {
+			return "", fmt.Errorf("types %s and %s have the same schema name", otherType.PkgPath()+"."+otherType.Name(), t.PkgPath()+"."+t.Name())
+		}
+	}
+
+	// Register the name first so recursive types can refer to it
+	b.typeNames[t] = name
+	schema, err := b.structSchema(t)
+	if err != nil {
+		return "", err
+	}
+	b.components[name] = schema
+	return name, nil
+}
+
+// Get the schema for the fields of a struct
+func (b *schemaBuilder) structSchema(t reflect.Type) (Schema, error) {
+	properties := map[string]interface{}{}
+	required := []string{}
+	if err := b.addFields(t, properties, &required); err != nil {
+		return nil, err
+	}
+	schema := Schema{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema, nil
+}
+
+// Add the serialized fields of a struct, including those promoted from embedded structs
+func (b *schemaBuilder) addFields(t reflect.Type, properties map[string]interface{}, required *[]string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagParts := strings.Split(tag, ",")
+		name := tagParts[0]
+
+		// Embedded structs without a name have their fields promoted
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct && !implements(fieldType, jsonMarshalerType) && !implements(fieldType, textMarshalerType) {
+				if err := b.addFields(fieldType, properties, required); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" && !field.Anonymous {
+			// Unexported
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema, err := b.schemaFor(field.Type)
+		if err != nil {
+			return fmt.Errorf("error describing field %s of %s: %w", field.Name, t.String(), err)
+		}
+		omitEmpty := false
+		for _, option := range tagParts[1:] {
+			switch option {
+			case "omitempty":
+				omitEmpty = true
+			case "string":
+				schema = Schema{"type": "string"}
+			}
+		}
+		properties[name] = schema
+		if !omitEmpty {
+			*required = append(*required, name)
+		}
+	}
+	return nil
+}
+
+// Check if a type or a pointer to it implements an interface
+func implements(t reflect.Type, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
+
+// Get the schema for a string enum
+func enumSchema(values []string) Schema {
+	enum := make([]interface{}, len(values))
+	for i, value := range values {
+		enum[i] = value
+	}
+	return Schema{"type": "string", "enum": enum}
+}
+
+// Allow a schema to be null
+func nullable(schema Schema) Schema {
+	if _, isRef := schema["$ref"]; isRef {
+		// OpenAPI 3.0 ignores siblings of a reference, so it has to be wrapped
+		return Schema{"allOf": []interface{}{schema}, "nullable": true}
+	}
+	schema["nullable"] = true
+	return schema
+}
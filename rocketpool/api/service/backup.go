@@ -0,0 +1,49 @@
+package service
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Checks that the node address recorded in a backup is registered on chain
+func checkBackupNode(c *cli.Context, nodeAddress common.Address) (*api.CheckBackupNodeResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CheckBackupNodeResponse{}
+
+	// Check the node registration
+	response.Registered, err = node.GetNodeExists(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Registered {
+		return &response, nil
+	}
+
+	// Get the minipool count
+	response.MinipoolCount, err = minipool.GetNodeMinipoolCount(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
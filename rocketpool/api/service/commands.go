@@ -51,6 +51,28 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "check-backup-node",
+				Usage:     "Checks that the node address recorded in a backup archive is registered on chain",
+				UsageText: "rocketpool api service check-backup-node address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					nodeAddress, err := cliutils.ValidateAddress("node address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(checkBackupNode(c, nodeAddress))
+					return nil
+
+				},
+			},
 		},
 	})
 }
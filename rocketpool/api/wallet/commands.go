@@ -57,6 +57,29 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "unlock",
+				Aliases:   []string{"u"},
+				Usage:     "Unlock the node wallet when using interactive unlock",
+				UsageText: "rocketpool api wallet unlock password",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					password, err := cliutils.ValidateNodePassword("wallet password", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(unlockWallet(c, password))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "init",
 				Aliases:   []string{"i"},
@@ -130,6 +153,16 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
 					},
+					cli.UintFlag{
+						Name:  "start-index, s",
+						Usage: "The first wallet index to check on each derivation path",
+						Value: 0,
+					},
+					cli.UintFlag{
+						Name:  "end-index, e",
+						Usage: "The wallet index to stop searching at (exclusive); omit this to search 100000 indices past the start index",
+						Value: 0,
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -220,6 +253,16 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						Name:  "skip-validator-key-recovery, k",
 						Usage: "Recover the node wallet, but do not regenerate its validator keys",
 					},
+					cli.UintFlag{
+						Name:  "start-index, s",
+						Usage: "The first wallet index to check on each derivation path",
+						Value: 0,
+					},
+					cli.UintFlag{
+						Name:  "end-index, e",
+						Usage: "The wallet index to stop searching at (exclusive); omit this to search 100000 indices past the start index",
+						Value: 0,
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -243,6 +286,30 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "import-validator-key",
+				Usage:     "Import an externally created validator keystore for one of the node's minipools",
+				UsageText: "rocketpool api wallet import-validator-key keystore password",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "no-restart",
+						Usage: "Don't restart the validator client after importing the key",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(importValidatorKey(c, c.Args().Get(0), c.Args().Get(1), !c.Bool("no-restart")))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
@@ -279,6 +346,46 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "set-offline-address",
+				Usage:     "Make this machine's node wallet watch-only, for a node account whose wallet is kept on an offline machine",
+				UsageText: "rocketpool api wallet set-offline-address address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					address, err := cliutils.ValidateAddress("node address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(setOfflineNodeAddress(c, address))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "sign-offline",
+				Usage:     "Sign a bundle of transactions and exits built for offline signing; the validator keys of its deposits are exported with the keystore password",
+				UsageText: "rocketpool api wallet sign-offline bundle-json keystore-password",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(signOfflineBundle(c, c.Args().Get(0), c.Args().Get(1)))
+					return nil
+
+				},
+			},
 		},
 	})
 }
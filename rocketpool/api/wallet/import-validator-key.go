@@ -0,0 +1,114 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/urfave/cli"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
+)
+
+func importValidatorKey(c *cli.Context, keystoreJson string, password string, restartValidator bool) (*api.ImportValidatorKeyResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ImportValidatorKeyResponse{}
+
+	// Decode and decrypt the keystore
+	keystore := api.ValidatorKeystore{}
+	if err := json.Unmarshal([]byte(keystoreJson), &keystore); err != nil {
+		return nil, fmt.Errorf("Error deserializing keystore: %w", err)
+	}
+	privateKey, err := walletutils.DecryptValidatorKeystore(keystore, password)
+	if err != nil {
+		return nil, err
+	}
+	response.Pubkey = keystore.Pubkey
+
+	// Check that the key belongs to one of the node's minipools
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	minipoolAddress, err := minipool.GetMinipoolByPubkey(rp, keystore.Pubkey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting minipool for validator %s: %w", keystore.Pubkey.Hex(), err)
+	}
+	if minipoolAddress == (common.Address{}) {
+		return nil, fmt.Errorf("Validator %s does not belong to any Rocket Pool minipool", keystore.Pubkey.Hex())
+	}
+	mp, err := minipool.NewMinipool(rp, minipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+	minipoolOwner, err := mp.GetNodeAddress(nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting owner of minipool %s: %w", minipoolAddress.Hex(), err)
+	}
+	if minipoolOwner != nodeAccount.Address {
+		return nil, fmt.Errorf("Validator %s belongs to minipool %s, which is owned by node %s, not this node", keystore.Pubkey.Hex(), minipoolAddress.Hex(), minipoolOwner.Hex())
+	}
+	response.MinipoolAddress = minipoolAddress
+
+	// Import the key into the wallet and save it; watch-only wallets only store it for the VC, since the offline wallet already has it
+	if w.IsOffline() {
+		if err := w.StoreValidatorKey(privateKey, keystore.Path); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := w.ImportValidatorKey(privateKey, keystore.Path); err != nil {
+			return nil, err
+		}
+		if err := w.Save(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Load the new key into the VC
+	if restartValidator {
+		bc, err := services.GetBeaconClient(c)
+		if err != nil {
+			return nil, err
+		}
+		d, err := services.GetDocker(c)
+		if err != nil {
+			return nil, err
+		}
+		var feeRecipient common.Address
+		if feeRecipientInfo, err := rputils.GetFeeRecipientInfo(rp, bc, nodeAccount.Address); err == nil {
+			feeRecipient = feeRecipientInfo.GetCorrectFeeRecipient()
+		}
+		if err := validator.LoadValidatorKeys(cfg, bc, nil, d, []*eth2types.BLSPrivateKey{privateKey}, feeRecipient); err != nil {
+			return nil, fmt.Errorf("The key was imported, but the validator client could not load it: %w", err)
+		}
+		response.RestartedValidator = true
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,232 @@
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/offline"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
+)
+
+func setOfflineNodeAddress(c *cli.Context, address common.Address) (*api.SetOfflineNodeAddressResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SetOfflineNodeAddressResponse{}
+
+	// Check that there's no wallet on this machine
+	if _, err := os.Stat(os.ExpandEnv(cfg.Smartnode.GetWalletPath())); err == nil {
+		return nil, errors.New("This machine already has a node wallet. The node address can only be set for offline signing on a machine without one.")
+	}
+
+	// Save the address
+	if err := w.SaveOfflineAddress(os.ExpandEnv(cfg.Smartnode.GetOfflineNodeAddressPath()), address); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func signOfflineBundle(c *cli.Context, bundleJson string, keystorePassword string) (*api.SignOfflineBundleResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	if !w.IsInitialized() {
+		return nil, errors.New("The node wallet is watch-only, so it can't sign transactions. Please sign them on the machine that has the wallet.")
+	}
+
+	// Response
+	response := api.SignOfflineBundleResponse{}
+
+	// Decode the bundle
+	var bundle offline.UnsignedBundle
+	if err := json.Unmarshal([]byte(bundleJson), &bundle); err != nil {
+		return nil, fmt.Errorf("Could not deserialize offline bundle: %w", err)
+	}
+	if bundle.Version != offline.BundleVersion {
+		return nil, fmt.Errorf("Unsupported offline bundle version %d.", bundle.Version)
+	}
+
+	// Check that it's for this node
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := bundle.Check(uint64(cfg.Smartnode.GetChainID()), nodeAccount.Address); err != nil {
+		return nil, err
+	}
+	if len(bundle.Deposits) > 0 && keystorePassword == "" {
+		return nil, errors.New("A password is required to export the validator keys of the deposits.")
+	}
+
+	// Check the contracts and deposits against what this machine knows about the network
+	knownContracts := offline.GetKnownContracts(cfg)
+	for _, unsignedTx := range bundle.Transactions {
+		if _, err := unsignedTx.CheckContract(knownContracts); err != nil {
+			return nil, err
+		}
+	}
+	for _, deposit := range bundle.Deposits {
+		if err := deposit.Check(cfg.Smartnode.GetGenesisForkVersion()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sign the transactions
+	signedBundle := &offline.SignedBundle{
+		Version:      offline.BundleVersion,
+		ChainID:      bundle.ChainID,
+		NodeAddress:  bundle.NodeAddress,
+		Transactions: []*offline.SignedTransaction{},
+		Exits:        []validator.ExitBundleEntry{},
+	}
+	for _, unsignedTx := range bundle.Transactions {
+		if unsignedTx.Call != nil {
+			if _, err := unsignedTx.Call.Decode(unsignedTx.Data); err != nil {
+				return nil, fmt.Errorf("Could not check transaction '%s': %w", unsignedTx.Description, err)
+			}
+		}
+		signedTx, err := signOfflineTransaction(w, bundle.ChainID, unsignedTx)
+		if err != nil {
+			return nil, err
+		}
+		signedBundle.Transactions = append(signedBundle.Transactions, signedTx)
+	}
+
+	// Create the validator keys for the deposits, then sign them
+	for _, deposit := range bundle.Deposits {
+		signedTx, err := signOfflineDeposit(w, bundle.ChainID, deposit, keystorePassword)
+		if err != nil {
+			return nil, err
+		}
+		signedBundle.Transactions = append(signedBundle.Transactions, signedTx)
+	}
+	if len(bundle.Deposits) > 0 {
+		if err := w.Save(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sign the exits
+	for _, exit := range bundle.Exits {
+		validatorKey, err := w.GetValidatorKeyByPubkey(exit.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := validator.GetSignedExitMessage(validatorKey, exit.ValidatorIndex, exit.Epoch, exit.SignatureDomain)
+		if err != nil {
+			return nil, err
+		}
+		signedBundle.Exits = append(signedBundle.Exits, validator.NewExitBundleEntry(exit.MinipoolAddress, exit.Pubkey, exit.ValidatorIndex, exit.Epoch, signature))
+	}
+
+	// Update & return response
+	signedBundle.SortTransactions()
+	response.Bundle = signedBundle
+	return &response, nil
+
+}
+
+// Sign a transaction from an offline bundle
+func signOfflineTransaction(w *wallet.Wallet, chainID uint64, unsignedTx *offline.UnsignedTransaction) (*offline.SignedTransaction, error) {
+	signedTx, err := w.SignTransaction(unsignedTx.GetTransaction(chainID))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("Could not serialize signed transaction: %w", err)
+	}
+	return &offline.SignedTransaction{
+		Description: unsignedTx.Description,
+		Nonce:       signedTx.Nonce(),
+		Hash:        signedTx.Hash(),
+		Raw:         raw,
+	}, nil
+}
+
+// Create a new validator key for a deposit from an offline bundle, then sign it
+func signOfflineDeposit(w *wallet.Wallet, chainID uint64, deposit *offline.UnsignedDeposit, keystorePassword string) (*offline.SignedTransaction, error) {
+
+	if deposit.Call == nil {
+		return nil, errors.New("The deposit does not include the method it calls")
+	}
+
+	// Create a new validator key
+	index, err := w.GetValidatorKeyCount()
+	if err != nil {
+		return nil, err
+	}
+	validatorKey, err := w.CreateValidatorKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the deposit data, and build the deposit with it
+	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, deposit.WithdrawalCredentials, beacon.Eth2Config{
+		GenesisForkVersion: deposit.GenesisForkVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	pubkey := rptypes.BytesToValidatorPubkey(depositData.PublicKey)
+	signature := rptypes.BytesToValidatorSignature(depositData.Signature)
+	unsignedTx := deposit.UnsignedTransaction
+	unsignedTx.Data, err = deposit.Call.Pack(eth.EthToWei(deposit.MinNodeFee), pubkey[:], signature[:], depositDataRoot, deposit.Salt, deposit.MinipoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign it
+	signedTx, err := signOfflineTransaction(w, chainID, &unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Export the validator key so the online machine can validate with it
+	keystore, err := walletutils.EncryptValidatorKeystore(validatorKey, fmt.Sprintf(wallet.ValidatorKeyPath, index), keystorePassword)
+	if err != nil {
+		return nil, err
+	}
+	signedTx.ValidatorKeystore, err = json.Marshal(keystore)
+	if err != nil {
+		return nil, fmt.Errorf("Could not serialize validator keystore: %w", err)
+	}
+	minipoolAddress := deposit.MinipoolAddress
+	signedTx.MinipoolAddress = &minipoolAddress
+	return signedTx, nil
+
+}
@@ -50,6 +50,12 @@ func purge(c *cli.Context) (*api.PurgeResponse, error) {
 		return nil, fmt.Errorf("error deleting validator storage: %w", err)
 	}
 
+	// Delete the imported validator keys, since they're encrypted with the password
+	err = w.DeleteImportedValidatorKeys()
+	if err != nil {
+		return nil, fmt.Errorf("error deleting imported validator keys: %w", err)
+	}
+
 	// Delete the wallet and password
 	err = w.Delete()
 	if err != nil {
@@ -14,10 +14,6 @@ import (
 	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
 )
 
-const (
-	findIterations uint = 100000
-)
-
 func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse, error) {
 
 	// Get services
@@ -119,44 +115,19 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 		return nil, errors.New("the wallet is already initialized")
 	}
 
-	// Try each derivation path across all of the iterations
-	paths := []string{
-		wallet.DefaultNodeKeyPath,
-		wallet.LedgerLiveNodeKeyPath,
-		wallet.MyEtherWalletNodeKeyPath,
-	}
-	for i := uint(0); i < findIterations; i++ {
-		for j := 0; j < len(paths); j++ {
-			derivationPath := paths[j]
-			recoveredWallet, err := wallet.NewWallet("", uint(w.GetChainID().Uint64()), nil, nil, 0, nil)
-			if err != nil {
-				return nil, fmt.Errorf("error generating new wallet: %w", err)
-			}
-			err = recoveredWallet.TestRecovery(derivationPath, i, mnemonic)
-			if err != nil {
-				return nil, fmt.Errorf("error recovering wallet with path [%s], index [%d]: %w", derivationPath, i, err)
-			}
-
-			// Get recovered account
-			recoveredAccount, err := recoveredWallet.GetNodeAccount()
-			if err != nil {
-				return nil, fmt.Errorf("error getting recovered account: %w", err)
-			}
-			if recoveredAccount.Address == address {
-				// We found the correct derivation path and index
-				response.FoundWallet = true
-				response.DerivationPath = derivationPath
-				response.Index = i
-				break
-			}
-		}
-		if response.FoundWallet {
-			break
-		}
+	// Get the range of indices to search
+	startIndex, endIndex, err := getSearchRange(c)
+	if err != nil {
+		return nil, err
 	}
 
+	// Try each derivation path across all of the iterations
+	response.DerivationPath, response.Index, response.FoundWallet, err = findNodeKeyPath(uint(w.GetChainID().Uint64()), mnemonic, address, startIndex, endIndex)
+	if err != nil {
+		return nil, err
+	}
 	if !response.FoundWallet {
-		return nil, fmt.Errorf("exhausted all derivation paths and indices from 0 to %d, wallet not found", findIterations)
+		return nil, fmt.Errorf("exhausted all derivation paths and indices from %d to %d, wallet not found", startIndex, endIndex-1)
 	}
 
 	// Recover wallet
@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"os"
+
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -10,21 +12,47 @@ import (
 func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
 
 	// Get services
-	pm, err := services.GetPasswordManager(c)
+	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
+	pm, err := services.GetPasswordManager(c)
 	if err != nil {
 		return nil, err
 	}
 
 	// Response
 	response := api.WalletStatusResponse{}
+	response.PasswordBackend = string(cfg.Smartnode.GetPasswordBackend())
 
-	// Get wallet status
+	// Get password status
 	response.PasswordSet = pm.IsPasswordSet()
+	if !response.PasswordSet && pm.IsLocked() {
+
+		// The wallet can't be decrypted while locked, so just check that it exists
+		response.PasswordLocked = true
+		_, err := os.Stat(os.ExpandEnv(cfg.Smartnode.GetWalletPath()))
+		response.WalletInitialized = (err == nil)
+		return &response, nil
+
+	}
+
+	// Get wallet status
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
 	response.WalletInitialized = w.IsInitialized()
+	response.WatchOnly = w.IsOffline()
+
+	// Get the offline node account if watch-only
+	if response.WatchOnly {
+		nodeAccount, err := w.GetNodeAccount()
+		if err != nil {
+			return nil, err
+		}
+		response.AccountAddress = nodeAccount.Address
+	}
 
 	// Get accounts if initialized
 	if response.WalletInitialized {
@@ -36,6 +64,15 @@ func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
 		}
 		response.AccountAddress = nodeAccount.Address
 
+		// Get imported validator keys
+		importedKeys, err := w.GetImportedValidatorKeys()
+		if err != nil {
+			return nil, err
+		}
+		for _, importedKey := range importedKeys {
+			response.ImportedKeys = append(response.ImportedKeys, importedKey.Pubkey)
+		}
+
 	}
 
 	// Return response
@@ -0,0 +1,61 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func unlockWallet(c *cli.Context, password string) (*api.UnlockWalletResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	pm, err := services.GetPasswordManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.UnlockWalletResponse{}
+
+	// Check the password backend
+	if !pm.CanUnlock() {
+		return nil, fmt.Errorf("The node wallet cannot be unlocked with the '%s' password backend; unlocking is only used by the interactive backend", cfg.Smartnode.GetPasswordBackend())
+	}
+	if !pm.IsLocked() {
+		return nil, errors.New("The node wallet is already unlocked")
+	}
+
+	// Make sure there's a wallet to unlock
+	if _, err := os.Stat(os.ExpandEnv(cfg.Smartnode.GetWalletPath())); os.IsNotExist(err) {
+		return nil, errors.New("The node wallet has not been initialized. Please run 'rocketpool wallet init' instead.")
+	}
+
+	// Unlock the password store
+	if err := pm.Unlock(password); err != nil {
+		return nil, err
+	}
+
+	// Check that the password decrypts the wallet, and lock it again if not
+	w, err := services.GetWallet(c)
+	if err == nil && w.IsInitialized() {
+		nodeAccount, err := w.GetNodeAccount()
+		if err == nil {
+			response.AccountAddress = nodeAccount.Address
+			return &response, nil
+		}
+	}
+	if err := pm.Lock(); err != nil {
+		return nil, fmt.Errorf("The password could not decrypt the node wallet, and the wallet could not be locked again: %w", err)
+	}
+	return nil, errors.New("The password could not decrypt the node wallet; the wallet is still locked")
+
+}
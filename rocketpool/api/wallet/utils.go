@@ -0,0 +1,61 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// Settings
+const (
+	findIterations uint = 100000
+)
+
+// Get the range of wallet indices to search, as specified by the command flags
+func getSearchRange(c *cli.Context) (uint, uint, error) {
+
+	startIndex := c.Uint("start-index")
+	endIndex := c.Uint("end-index")
+	if endIndex == 0 {
+		endIndex = startIndex + findIterations
+	}
+	if endIndex <= startIndex {
+		return 0, 0, fmt.Errorf("end index %d must be greater than start index %d", endIndex, startIndex)
+	}
+	return startIndex, endIndex, nil
+
+}
+
+// Search each of the known derivation paths for the wallet index that produces the given node address.
+// Indices in the range [startIndex, endIndex) are checked across every path before moving on to the next index.
+func findNodeKeyPath(chainId uint, mnemonic string, address common.Address, startIndex uint, endIndex uint) (string, uint, bool, error) {
+
+	for i := startIndex; i < endIndex; i++ {
+		for _, derivationPath := range wallet.KnownNodeKeyPaths {
+			recoveredWallet, err := wallet.NewWallet("", chainId, nil, nil, 0, nil)
+			if err != nil {
+				return "", 0, false, fmt.Errorf("error generating new wallet: %w", err)
+			}
+			err = recoveredWallet.TestRecovery(derivationPath, i, mnemonic)
+			if err != nil {
+				return "", 0, false, fmt.Errorf("error recovering wallet with path [%s], index [%d]: %w", derivationPath, i, err)
+			}
+
+			// Get recovered account
+			recoveredAccount, err := recoveredWallet.GetNodeAccount()
+			if err != nil {
+				return "", 0, false, fmt.Errorf("error getting recovered account: %w", err)
+			}
+			if recoveredAccount.Address == address {
+				// We found the correct derivation path and index
+				return derivationPath, i, true, nil
+			}
+		}
+	}
+
+	return "", 0, false, nil
+
+}
@@ -0,0 +1,270 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+// The socket is only accessible to the owner of the data folder
+const SocketMode = 0600
+
+// Colors
+var (
+	ApiServerColor = color.FgHiCyan
+	ErrorColor     = color.FgRed
+	WarningColor   = color.FgYellow
+)
+
+// Runs API commands sent over a Unix socket, reusing the same services for each call
+type server struct {
+	c            *cli.Context
+	log          log.ColorLogger
+	settingsPath string
+	settingsTime time.Time
+	restart      context.CancelFunc
+
+	// API commands share the same services and output, so they're run one at a time.
+	// Waiting for transactions doesn't run a command, so it doesn't take the lock.
+	lock sync.Mutex
+}
+
+// Register API server command
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Run the Rocket Pool API server, which serves API commands over a Unix socket; `rocketpool service start` runs it in the API container",
+		Action: func(c *cli.Context) error {
+			return run(c)
+		},
+	})
+}
+
+// Run daemon
+func run(c *cli.Context) error {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	logger := log.NewColorLogger(ApiServerColor)
+	errorLog := log.NewColorLogger(ErrorColor)
+
+	// Get the shutdown context
+	ctx, cancel := tasks.NewShutdownContext(log.NewColorLogger(WarningColor))
+	defer cancel()
+
+	// Listen on the socket
+	socketPath := os.ExpandEnv(cfg.Smartnode.GetApiSocketPath())
+	listener, err := listen(socketPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(socketPath)
+
+	settingsPath := os.ExpandEnv(c.GlobalString("settings"))
+	s := &server{
+		c:            c,
+		log:          logger,
+		settingsPath: settingsPath,
+		settingsTime: getModTime(settingsPath),
+		restart:      cancel,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(api.ServerCallPath, s.handleCall)
+	httpServer := &http.Server{Handler: mux}
+
+	// Stop the server when the daemon shuts down; calls in progress are allowed to finish
+	go func() {
+		<-ctx.Done()
+		httpServer.Shutdown(context.Background())
+	}()
+
+	logger.Printlnf("Serving API commands on %s.", socketPath)
+	err = httpServer.Serve(listener)
+	if err != nil && err != http.ErrServerClosed {
+		errorLog.Printlnf("Error running API server: %s", err.Error())
+		return err
+	}
+	logger.Println("API server stopped.")
+	return nil
+
+}
+
+// Listen on the socket, giving it to the owner of the data folder
+func listen(socketPath string) (net.Listener, error) {
+
+	// Don't take over the socket of a server that's still running
+	if conn, err := net.DialTimeout("unix", socketPath, time.Second); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("The API server is already running on %s", socketPath)
+	}
+
+	// Remove a stale socket from a previous run
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("Could not remove old API socket: %w", err)
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("Could not start API server: %w", err)
+	}
+	if err := os.Chmod(socketPath, SocketMode); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("Could not set API socket permissions: %w", err)
+	}
+
+	// The data folder is mounted from the host, so its owner is the user that runs the CLI
+	info, err := os.Stat(filepath.Dir(socketPath))
+	if err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("Could not get the owner of the data folder: %w", err)
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		if err := os.Lchown(socketPath, int(stat.Uid), int(stat.Gid)); err != nil {
+			listener.Close()
+			return nil, fmt.Errorf("Could not set API socket owner: %w", err)
+		}
+	}
+
+	return listener, nil
+
+}
+
+// Run an API command and return its response
+func (s *server) handleCall(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "API calls must use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	var request api.ServerCallRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid API call: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+	if len(request.Args) == 0 {
+		http.Error(w, "No API command was provided", http.StatusBadRequest)
+		return
+	}
+
+	// Waiting for a transaction can take a long time, so don't block other calls with it
+	if isWaitCall(request.Args) {
+		s.handleWait(w, r, request.Args)
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// The loaded config is stale if the settings have changed, so restart with the new settings
+	if !getModTime(s.settingsPath).Equal(s.settingsTime) {
+		s.log.Println("The settings file has changed, restarting...")
+		http.Error(w, "The API server is restarting to load new settings", http.StatusServiceUnavailable)
+		s.restart()
+		return
+	}
+
+	// Run the command as if it was called from the command line with the call's settings, capturing the response
+	var output []byte
+	if err := services.RunWithCallSettings(s.c, services.CallSettings{
+		IgnoreSyncCheck: request.IgnoreSyncCheck,
+		ForceFallbacks:  request.ForceFallbacks,
+		MaxFee:          request.MaxFee,
+		MaxPriorityFee:  request.MaxPrioFee,
+		GasLimit:        request.GasLimit,
+	}, func() {
+		output = apiutils.RunCommand(s.c.App, s.getCommandArgs(request))
+	}); err != nil && output == nil {
+		http.Error(w, fmt.Sprintf("Could not prepare the API services: %s", err.Error()), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(output)
+
+}
+
+// Wait for a transaction to be mined, like `rocketpool api wait`, until it is or the caller disconnects
+func (s *server) handleWait(w http.ResponseWriter, r *http.Request, args []string) {
+
+	err := func() error {
+		if len(args) != 2 {
+			return fmt.Errorf("Incorrect argument count; usage: rocketpool api wait tx-hash")
+		}
+		hash, err := cliutils.ValidateTxHash("tx-hash", args[1])
+		if err != nil {
+			return err
+		}
+		tm, err := services.GetTransactionManager(s.c)
+		if err != nil {
+			return err
+		}
+		_, err = tm.WaitForTransactionContext(r.Context(), hash, nil)
+		return err
+	}()
+
+	w.Header().Set("Content-Type", "application/json")
+	apiutils.WriteResponse(w, &api.APIResponse{}, err)
+
+}
+
+// Check if an API call is the wait command
+func isWaitCall(args []string) bool {
+	return len(args) > 0 && (args[0] == "wait" || args[0] == "t")
+}
+
+// Get the command line for an API call
+func (s *server) getCommandArgs(request api.ServerCallRequest) []string {
+	args := []string{s.c.App.Name, "--settings", s.settingsPath}
+	if request.IgnoreSyncCheck {
+		args = append(args, "--ignore-sync-check")
+	}
+	if request.ForceFallbacks {
+		args = append(args, "--force-fallbacks")
+	}
+	if request.MaxFee != 0 {
+		args = append(args, "--maxFee", strconv.FormatFloat(request.MaxFee, 'f', -1, 64))
+	}
+	if request.MaxPrioFee != 0 {
+		args = append(args, "--maxPrioFee", strconv.FormatFloat(request.MaxPrioFee, 'f', -1, 64))
+	}
+	if request.GasLimit != 0 {
+		args = append(args, "--gasLimit", strconv.FormatUint(request.GasLimit, 10))
+	}
+	if request.Nonce != "" {
+		args = append(args, "--nonce", request.Nonce)
+	}
+	args = append(args, "api")
+	return append(args, request.Args...)
+}
+
+// Get the modification time of a file, or the zero time if it doesn't exist
+func getModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
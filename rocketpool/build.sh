@@ -7,4 +7,7 @@ cd /smartnode/rocketpool
 CGO_CFLAGS="-O -D__BLST_PORTABLE__" GOARCH=amd64 GOOS=linux go build -o rocketpool-daemon-linux-amd64 rocketpool.go
 
 # Build the arm64 version
-CC=aarch64-linux-gnu-gcc CXX=aarch64-linux-gnu-cpp CGO_CFLAGS="-O -D__BLST_PORTABLE__" GOARCH=arm64 GOOS=linux go build -o rocketpool-daemon-linux-arm64 rocketpool.go
\ No newline at end of file
+CC=aarch64-linux-gnu-gcc CXX=aarch64-linux-gnu-cpp CGO_CFLAGS="-O -D__BLST_PORTABLE__" GOARCH=arm64 GOOS=linux go build -o rocketpool-daemon-linux-arm64 rocketpool.go
+
+# Make sure the OpenAPI document matches the API commands
+./rocketpool-daemon-linux-amd64 api-schema --check ../shared/types/api/openapi.json || exit 1
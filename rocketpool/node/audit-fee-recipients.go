@@ -0,0 +1,294 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Settings
+const (
+	// Number of epochs to go back and audit if the audit hasn't run before (225 epochs is approx. 1 day)
+	FeeRecipientAuditLookbackEpochs uint64 = 225
+
+	// Maximum number of epochs to audit in a single run
+	FeeRecipientAuditMaxEpochsPerRun uint64 = 225
+)
+
+// Audit fee recipients task
+type auditFeeRecipients struct {
+	c      *cli.Context
+	log    log.ColorLogger
+	errLog log.ColorLogger
+	cfg    *config.RocketPoolConfig
+	w      *wallet.Wallet
+	rp     *rocketpool.RocketPool
+	bc     beacon.Client
+	n      *notifications.Notifier
+}
+
+// Create audit fee recipients task
+func newAuditFeeRecipients(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, notifier *notifications.Notifier) (*auditFeeRecipients, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &auditFeeRecipients{
+		c:      c,
+		log:    logger,
+		errLog: errorLogger,
+		cfg:    cfg,
+		w:      w,
+		rp:     rp,
+		bc:     bc,
+		n:      notifier,
+	}, nil
+
+}
+
+// Audit the fee recipients of blocks proposed by the node's validators
+func (t *auditFeeRecipients) run(ctx context.Context) error {
+
+	// Wait for eth clients to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+	if err := services.WaitBeaconClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the epochs to audit
+	head, err := t.bc.GetBeaconHead()
+	if err != nil {
+		return fmt.Errorf("error getting beacon head: %w", err)
+	}
+	statePath := t.cfg.Smartnode.GetFeeRecipientAuditStatePath()
+	state, err := rputils.LoadFeeRecipientAuditState(statePath)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = &rputils.FeeRecipientAuditState{}
+		if head.FinalizedEpoch > FeeRecipientAuditLookbackEpochs {
+			state.LastCheckedEpoch = head.FinalizedEpoch - FeeRecipientAuditLookbackEpochs
+		}
+	}
+	startEpoch := state.LastCheckedEpoch + 1
+	endEpoch := head.FinalizedEpoch
+	if endEpoch < startEpoch {
+		return nil
+	}
+	if endEpoch-startEpoch+1 > FeeRecipientAuditMaxEpochsPerRun {
+		endEpoch = startEpoch + FeeRecipientAuditMaxEpochsPerRun - 1
+	}
+
+	// Get the node's validators
+	validators, err := getNodeValidators(t.rp, t.bc, nodeAccount.Address)
+	if err != nil {
+		return err
+	}
+	if len(validators) == 0 {
+		state.LastCheckedEpoch = endEpoch
+		return state.Save(statePath)
+	}
+	indices := make([]uint64, 0, len(validators))
+	for index := range validators {
+		indices = append(indices, index)
+	}
+
+	// Log
+	t.log.Printlnf("Auditing the fee recipients of blocks proposed in epochs %d to %d...", startEpoch, endEpoch)
+
+	// Get the addresses that are always valid
+	smoothingPoolContract, err := t.rp.GetContract("rocketSmoothingPool")
+	if err != nil {
+		return fmt.Errorf("error getting smoothing pool contract: %w", err)
+	}
+	smoothingPoolAddress := *smoothingPoolContract.Address
+	distributorAddress, err := node.GetDistributorAddress(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return fmt.Errorf("error getting fee distributor address: %w", err)
+	}
+	eth2Config, err := t.bc.GetEth2Config()
+	if err != nil {
+		return fmt.Errorf("error getting Beacon config: %w", err)
+	}
+
+	// Get the current Smoothing Pool status, for blocks whose historical state the EC can't provide
+	headOptedIn, err := node.GetSmoothingPoolRegistrationState(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return fmt.Errorf("error checking Smoothing Pool status: %w", err)
+	}
+
+	// Audit each epoch
+	logPath := t.cfg.Smartnode.GetFeeRecipientAuditLogPath()
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+
+		// Stop if the daemon is shutting down; progress is saved after every epoch
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// Check if any of the node's validators proposed in this epoch; some clients don't serve duties for old epochs, so scan the whole epoch if they're unavailable
+		hasDuties := false
+		duties, err := t.bc.GetValidatorProposerDuties(indices, epoch)
+		if err != nil {
+			hasDuties = true
+		}
+		for _, count := range duties {
+			if count > 0 {
+				hasDuties = true
+				break
+			}
+		}
+
+		// Check the blocks proposed by them
+		if hasDuties {
+			entries, err := t.auditEpoch(ctx, epoch, eth2Config.SlotsPerEpoch, validators, nodeAccount.Address, smoothingPoolAddress, distributorAddress, headOptedIn)
+			if err != nil && ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				// Skip the epoch so one that can't be audited doesn't stall the audit
+				t.errLog.Printlnf("Could not audit epoch %d, skipping it: %s", epoch, err.Error())
+				state.UnverifiableEpochs++
+				state.LastUnverifiableEpoch = epoch
+				entries = nil
+			}
+			if err := rputils.AppendFeeRecipientAuditLog(logPath, entries); err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				state.CheckedBlocks++
+				if !entry.Correct {
+					state.MismatchedBlocks++
+					state.LastMismatchSlot = entry.Slot
+					t.alertMismatch(entry)
+				}
+			}
+		}
+
+		// Save progress
+		state.LastCheckedEpoch = epoch
+		if err := state.Save(statePath); err != nil {
+			return err
+		}
+
+	}
+
+	// Log & return
+	t.log.Printlnf("Finished auditing fee recipients up to epoch %d (%d proposals checked, %d with the wrong fee recipient, %d epochs that couldn't be audited).", endEpoch, state.CheckedBlocks, state.MismatchedBlocks, state.UnverifiableEpochs)
+	return nil
+
+}
+
+// Audit the blocks proposed by the node's validators in an epoch
+func (t *auditFeeRecipients) auditEpoch(ctx context.Context, epoch uint64, slotsPerEpoch uint64, validators map[uint64]nodeValidator, nodeAddress common.Address, smoothingPoolAddress common.Address, distributorAddress common.Address, headOptedIn bool) ([]rputils.FeeRecipientAuditEntry, error) {
+
+	entries := []rputils.FeeRecipientAuditEntry{}
+	for slot := epoch * slotsPerEpoch; slot < (epoch+1)*slotsPerEpoch; slot++ {
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Get the block, skipping missed slots and blocks proposed by other validators
+		block, exists, err := t.bc.GetBeaconBlock(strconv.FormatUint(slot, 10))
+		if err != nil {
+			return nil, fmt.Errorf("error getting beacon block %d: %w", slot, err)
+		}
+		if !exists || !block.HasExecutionPayload {
+			continue
+		}
+		validator, isNodeValidator := validators[block.ProposerIndex]
+		if !isNodeValidator {
+			continue
+		}
+
+		// Get the expected fee recipient based on the node's Smoothing Pool status at that block.
+		// Non-archive ECs can't provide old state, so use the current status instead; either of the node's valid addresses is accepted then, since the status may have changed.
+		opts := &bind.CallOpts{
+			BlockNumber: big.NewInt(int64(block.ExecutionBlockNumber)),
+		}
+		expectedFromHead := false
+		isOptedIn, err := node.GetSmoothingPoolRegistrationState(t.rp, nodeAddress, opts)
+		if err != nil {
+			isOptedIn = headOptedIn
+			expectedFromHead = true
+		}
+		expectedFeeRecipient := distributorAddress
+		if isOptedIn {
+			expectedFeeRecipient = smoothingPoolAddress
+		}
+
+		// The Smoothing Pool and rETH addresses are always acceptable
+		correct := block.FeeRecipient == expectedFeeRecipient ||
+			block.FeeRecipient == smoothingPoolAddress ||
+			block.FeeRecipient == t.cfg.Smartnode.GetRethAddress() ||
+			(expectedFromHead && block.FeeRecipient == distributorAddress)
+
+		entries = append(entries, rputils.FeeRecipientAuditEntry{
+			Slot:                 block.Slot,
+			ExecutionBlockNumber: block.ExecutionBlockNumber,
+			ValidatorIndex:       block.ProposerIndex,
+			Pubkey:               validator.pubkey,
+			MinipoolAddress:      validator.minipoolAddress,
+			FeeRecipient:         block.FeeRecipient,
+			ExpectedFeeRecipient: expectedFeeRecipient,
+			Correct:              correct,
+			ExpectedFromHead:     expectedFromHead,
+			CheckedAt:            time.Now().UTC(),
+		})
+
+	}
+	return entries, nil
+
+}
+
+// Alert the node operator that a block was proposed with the wrong fee recipient
+func (t *auditFeeRecipients) alertMismatch(entry rputils.FeeRecipientAuditEntry) {
+	t.errLog.Println("*** WARNING: A block was proposed with the wrong fee recipient! ***")
+	t.errLog.Printlnf("Validator %s (minipool %s) proposed slot %d with fee recipient %s, but it should have been %s.", entry.Pubkey.Hex(), entry.MinipoolAddress.Hex(), entry.Slot, entry.FeeRecipient.Hex(), entry.ExpectedFeeRecipient.Hex())
+	t.errLog.Println("The Oracle DAO will penalize your minipool for this. Please check your Validator Client's fee recipient configuration immediately with `rocketpool node fee-recipient status`.")
+	t.n.Notify(cfgtypes.NotificationSeverity_Critical, fmt.Sprintf("fee-recipient-audit-%d", entry.Slot), "Block proposed with the wrong fee recipient",
+		fmt.Sprintf("Validator %s (minipool %s) proposed slot %d with fee recipient %s, but it should have been %s. The Oracle DAO will penalize your minipool for this; check your Validator Client with `rocketpool node fee-recipient status`.", entry.Pubkey.Hex(), entry.MinipoolAddress.Hex(), entry.Slot, entry.FeeRecipient.Hex(), entry.ExpectedFeeRecipient.Hex()))
+}
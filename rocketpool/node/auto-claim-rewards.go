@@ -0,0 +1,365 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Auto claim rewards task
+type autoClaimRewards struct {
+	c                *cli.Context
+	log              log.ColorLogger
+	cfg              *config.RocketPoolConfig
+	w                *wallet.Wallet
+	rp               *rocketpool.RocketPool
+	ec               *services.ExecutionClientManager
+	tm               *txmanager.Manager
+	enabled          bool
+	minRpl           *big.Int
+	minEth           *big.Int
+	gasThreshold     float64
+	restakeMode      cfgtypes.RestakeMode
+	collateralTarget float64
+	restakeFraction  float64
+	maxFee           *big.Int
+	maxPriorityFee   *big.Int
+	gasLimit         uint64
+}
+
+// The unclaimed rewards that are ready to be claimed
+type claimableRewards struct {
+	indices      []*big.Int
+	amountRPL    []*big.Int
+	amountETH    []*big.Int
+	merkleProofs [][]common.Hash
+	totalRPL     *big.Int
+	totalETH     *big.Int
+}
+
+// Create auto claim rewards task
+func newAutoClaimRewards(c *cli.Context, logger log.ColorLogger) (*autoClaimRewards, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the claim policy
+	enabled := cfg.Smartnode.AutoClaimEnabled.Value.(bool)
+	minRpl := eth.EthToWei(cfg.Smartnode.AutoClaimMinRpl.Value.(float64))
+	minEth := eth.EthToWei(cfg.Smartnode.AutoClaimMinEth.Value.(float64))
+	gasThreshold := cfg.Smartnode.AutoClaimGasThreshold.Value.(float64)
+	restakeMode := cfg.Smartnode.AutoRestakeMode.Value.(cfgtypes.RestakeMode)
+	collateralTarget := cfg.Smartnode.AutoRestakeCollateralTarget.Value.(float64)
+	restakeFraction := cfg.Smartnode.AutoRestakeFraction.Value.(float64)
+	if restakeFraction < 0 || restakeFraction > 1 {
+		return nil, fmt.Errorf("Invalid automatic restake fraction %.4f: it must be between 0 and 1", restakeFraction)
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested max fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &autoClaimRewards{
+		c:                c,
+		log:              logger,
+		cfg:              cfg,
+		w:                w,
+		rp:               rp,
+		ec:               ec,
+		tm:               tm,
+		enabled:          enabled,
+		minRpl:           minRpl,
+		minEth:           minEth,
+		gasThreshold:     gasThreshold,
+		restakeMode:      restakeMode,
+		collateralTarget: collateralTarget,
+		restakeFraction:  restakeFraction,
+		maxFee:           maxFee,
+		maxPriorityFee:   priorityFee,
+		gasLimit:         0,
+	}, nil
+
+}
+
+// Claim (and optionally restake) rewards if the configured conditions are met
+func (t *autoClaimRewards) run(ctx context.Context) error {
+
+	// Check if automatic claiming is enabled
+	if !t.enabled {
+		return nil
+	}
+
+	// Reload the wallet (in case a call to `node deposit` changed it)
+	if err := t.w.Reload(); err != nil {
+		return err
+	}
+
+	// Wait for eth client to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Println("Checking for rewards to claim...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the claimable rewards
+	claimable, err := t.getClaimableRewards(ctx, nodeAccount.Address)
+	if err != nil {
+		return err
+	}
+	if len(claimable.indices) == 0 {
+		return nil
+	}
+
+	// Get the restake amount; nothing is restaked once the collateral target has been reached
+	stakeAmount, err := t.getRestakeAmount(nodeAccount.Address, claimable.totalRPL)
+	if err != nil {
+		return err
+	}
+
+	// Check the claim conditions
+	meetsRplMin := claimable.totalRPL.Cmp(t.minRpl) >= 0
+	meetsEthMin := claimable.totalETH.Sign() > 0 && claimable.totalETH.Cmp(t.minEth) >= 0
+	if !meetsRplMin && !meetsEthMin {
+		t.log.Printlnf("%.6f RPL and %.6f ETH are claimable, which does not meet the automatic claim conditions yet.", eth.WeiToEth(claimable.totalRPL), eth.WeiToEth(claimable.totalETH))
+		return nil
+	}
+
+	// Claim the rewards
+	return t.claimRewards(ctx, nodeAccount.Address, claimable, stakeAmount)
+
+}
+
+// Get the rewards for every unclaimed interval that has a valid tree file
+func (t *autoClaimRewards) getClaimableRewards(ctx context.Context, nodeAddress common.Address) (*claimableRewards, error) {
+
+	// Get the unclaimed intervals
+	unclaimed, _, err := rprewards.GetClaimStatus(t.rp, nodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get the rewards claim status: %w", err)
+	}
+
+	claimable := &claimableRewards{
+		indices:      []*big.Int{},
+		amountRPL:    []*big.Int{},
+		amountETH:    []*big.Int{},
+		merkleProofs: [][]common.Hash{},
+		totalRPL:     big.NewInt(0),
+		totalETH:     big.NewInt(0),
+	}
+	for _, index := range unclaimed {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		intervalInfo, err := rprewards.GetIntervalInfo(t.rp, t.cfg, nodeAddress, index)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get the info for interval %d: %w", index, err)
+		}
+		if !intervalInfo.TreeFileExists || !intervalInfo.MerkleRootValid {
+			t.log.Printlnf("Skipping interval %d because its rewards tree file is missing or invalid.", index)
+			continue
+		}
+		if !intervalInfo.NodeExists {
+			continue
+		}
+
+		rplForInterval := big.NewInt(0)
+		rplForInterval.Add(rplForInterval, &intervalInfo.CollateralRplAmount.Int)
+		rplForInterval.Add(rplForInterval, &intervalInfo.ODaoRplAmount.Int)
+		ethForInterval := big.NewInt(0).Set(&intervalInfo.SmoothingPoolEthAmount.Int)
+
+		claimable.indices = append(claimable.indices, big.NewInt(0).SetUint64(index))
+		claimable.amountRPL = append(claimable.amountRPL, rplForInterval)
+		claimable.amountETH = append(claimable.amountETH, ethForInterval)
+		claimable.merkleProofs = append(claimable.merkleProofs, intervalInfo.MerkleProof)
+		claimable.totalRPL.Add(claimable.totalRPL, rplForInterval)
+		claimable.totalETH.Add(claimable.totalETH, ethForInterval)
+	}
+
+	return claimable, nil
+
+}
+
+// Get the amount of the claimed RPL to restake according to the restake policy
+func (t *autoClaimRewards) getRestakeAmount(nodeAddress common.Address, claimRpl *big.Int) (*big.Int, error) {
+
+	switch t.restakeMode {
+	case cfgtypes.RestakeMode_CollateralTarget:
+		// Get the stake required for the target; the maximum stake corresponds to the maximum per-minipool collateral
+		rplStake, err := node.GetNodeRPLStake(t.rp, nodeAddress, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get the node's RPL stake: %w", err)
+		}
+		maxRplStake, err := node.GetNodeMaximumRPLStake(t.rp, nodeAddress, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get the node's maximum RPL stake: %w", err)
+		}
+		maxPerMinipoolStake, err := protocol.GetMaximumPerMinipoolStake(t.rp, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get the maximum per-minipool stake: %w", err)
+		}
+		if maxPerMinipoolStake == 0 || maxRplStake.Sign() == 0 {
+			t.log.Println("The node does not have any active minipools, so no RPL will be restaked.")
+			return nil, nil
+		}
+
+		targetStake := eth.EthToWei(eth.WeiToEth(maxRplStake) * (t.collateralTarget / 100) / maxPerMinipoolStake)
+		if rplStake.Cmp(targetStake) >= 0 {
+			return nil, nil
+		}
+		stakeAmount := big.NewInt(0).Sub(targetStake, rplStake)
+		if stakeAmount.Cmp(claimRpl) > 0 {
+			stakeAmount.Set(claimRpl)
+		}
+		return stakeAmount, nil
+
+	case cfgtypes.RestakeMode_Fraction:
+		stakeAmount := eth.EthToWei(eth.WeiToEth(claimRpl) * t.restakeFraction)
+		if stakeAmount.Cmp(claimRpl) > 0 {
+			stakeAmount.Set(claimRpl)
+		}
+		return stakeAmount, nil
+
+	default:
+		return nil, nil
+	}
+
+}
+
+// Claim the rewards, restaking the given amount of RPL
+func (t *autoClaimRewards) claimRewards(ctx context.Context, nodeAddress common.Address, claimable *claimableRewards, stakeAmount *big.Int) error {
+
+	restake := stakeAmount != nil && stakeAmount.Sign() > 0
+	if restake {
+		t.log.Printlnf("Claiming %.6f RPL and %.6f ETH from %d interval(s) and restaking %.6f RPL...", eth.WeiToEth(claimable.totalRPL), eth.WeiToEth(claimable.totalETH), len(claimable.indices), eth.WeiToEth(stakeAmount))
+	} else {
+		t.log.Printlnf("Claiming %.6f RPL and %.6f ETH from %d interval(s)...", eth.WeiToEth(claimable.totalRPL), eth.WeiToEth(claimable.totalETH), len(claimable.indices))
+	}
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Get the gas limit
+	var gasInfo rocketpool.GasInfo
+	if restake {
+		gasInfo, err = rewards.EstimateClaimAndStakeGas(t.rp, nodeAddress, claimable.indices, claimable.amountRPL, claimable.amountETH, claimable.merkleProofs, stakeAmount, opts)
+	} else {
+		gasInfo, err = rewards.EstimateClaimGas(t.rp, nodeAddress, claimable.indices, claimable.amountRPL, claimable.amountETH, claimable.merkleProofs, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("Could not estimate the gas required to claim rewards: %w", err)
+	}
+	var gas *big.Int
+	if t.gasLimit != 0 {
+		gas = new(big.Int).SetUint64(t.gasLimit)
+	} else {
+		gas = new(big.Int).SetUint64(gasInfo.SafeGasLimit)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.ec)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Print the gas info
+	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
+		return nil
+	}
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = t.maxPriorityFee
+	opts.GasLimit = gas.Uint64()
+
+	// Don't send a transaction if the daemon is shutting down
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Claim rewards
+	var hash common.Hash
+	if restake {
+		hash, err = rewards.ClaimAndStake(t.rp, nodeAddress, claimable.indices, claimable.amountRPL, claimable.amountETH, claimable.merkleProofs, stakeAmount, opts)
+	} else {
+		hash, err = rewards.Claim(t.rp, nodeAddress, claimable.indices, claimable.amountRPL, claimable.amountETH, claimable.merkleProofs, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("Could not claim rewards: %w", err)
+	}
+
+	// Print TX info and wait for it to be mined
+	err = api.PrintAndWaitForTransactionContext(ctx, t.cfg, hash, t.tm, t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Println("Successfully claimed rewards.")
+	return nil
+
+}
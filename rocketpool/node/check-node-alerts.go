@@ -0,0 +1,253 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Check node alerts task
+type checkNodeAlerts struct {
+	c                   *cli.Context
+	log                 log.ColorLogger
+	cfg                 *config.RocketPoolConfig
+	w                   *wallet.Wallet
+	rp                  *rocketpool.RocketPool
+	n                   *notifications.Notifier
+	lowEthBalance       *big.Int
+	dissolveWarningTime time.Duration
+
+	// The latest rewards interval that a notification was sent for
+	notifiedRewardsInterval uint64
+	hasNotifiedRewards      bool
+}
+
+// Create check node alerts task
+func newCheckNodeAlerts(c *cli.Context, logger log.ColorLogger, notifier *notifications.Notifier) (*checkNodeAlerts, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &checkNodeAlerts{
+		c:                   c,
+		log:                 logger,
+		cfg:                 cfg,
+		w:                   w,
+		rp:                  rp,
+		n:                   notifier,
+		lowEthBalance:       eth.EthToWei(cfg.Notifications.LowEthBalance.Value.(float64)),
+		dissolveWarningTime: time.Duration(cfg.Notifications.DissolveWarningTime.Value.(uint64)) * time.Hour,
+	}, nil
+
+}
+
+// Check the node for problems that the operator should be notified about
+func (t *checkNodeAlerts) run(ctx context.Context) error {
+
+	// Check if notifications are enabled
+	if !t.n.IsEnabled() {
+		return nil
+	}
+
+	// Wait for eth client to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Println("Checking for node alerts...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Run the checks, stopping early if the daemon is shutting down
+	checks := []func(common.Address) error{
+		t.checkEthBalance,
+		t.checkRplCollateral,
+		func(nodeAddress common.Address) error {
+			return t.checkDissolvingMinipools(ctx, nodeAddress)
+		},
+		t.checkNewRewards,
+	}
+	for _, check := range checks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := check(nodeAccount.Address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// Check if the node has enough ETH to pay for gas
+func (t *checkNodeAlerts) checkEthBalance(nodeAddress common.Address) error {
+	balance, err := t.rp.Client.BalanceAt(context.Background(), nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get the node's ETH balance: %w", err)
+	}
+	if balance.Cmp(t.lowEthBalance) < 0 {
+		t.n.Notify(cfgtypes.NotificationSeverity_Warning, "low-eth-balance", "Low node ETH balance",
+			fmt.Sprintf("Your node wallet %s only has %.6f ETH, which is below your threshold of %.6f ETH. It may not be able to pay for the gas of automatic transactions such as staking minipools.", nodeAddress.Hex(), eth.WeiToEth(balance), eth.WeiToEth(t.lowEthBalance)))
+	} else {
+		t.n.Clear("low-eth-balance")
+	}
+	return nil
+}
+
+// Check if the node's RPL stake is below the minimum for its minipools
+func (t *checkNodeAlerts) checkRplCollateral(nodeAddress common.Address) error {
+	var wg errgroup.Group
+	var rplStake *big.Int
+	var minimumRplStake *big.Int
+	wg.Go(func() error {
+		var err error
+		rplStake, err = node.GetNodeRPLStake(t.rp, nodeAddress, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		minimumRplStake, err = node.GetNodeMinimumRPLStake(t.rp, nodeAddress, nil)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return fmt.Errorf("Could not get the node's RPL stake: %w", err)
+	}
+
+	if minimumRplStake.Sign() > 0 && rplStake.Cmp(minimumRplStake) < 0 {
+		t.n.Notify(cfgtypes.NotificationSeverity_Critical, "rpl-collateral-low", "RPL collateral below minimum",
+			fmt.Sprintf("Your node has %.6f RPL staked, which is below the minimum of %.6f RPL for its minipools. It will not earn RPL rewards until you stake more.", eth.WeiToEth(rplStake), eth.WeiToEth(minimumRplStake)))
+	} else {
+		t.n.Clear("rpl-collateral-low")
+	}
+	return nil
+}
+
+// Check if any prelaunch minipools will be dissolved soon
+func (t *checkNodeAlerts) checkDissolvingMinipools(ctx context.Context, nodeAddress common.Address) error {
+
+	// Get the node's minipools
+	addresses, err := minipool.GetNodeMinipoolAddresses(t.rp, nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get the node's minipools: %w", err)
+	}
+	statuses := make([]minipool.StatusDetails, len(addresses))
+	var wg errgroup.Group
+	for mi, address := range addresses {
+		mi, address := mi, address
+		wg.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			mp, err := minipool.NewMinipool(t.rp, address)
+			if err != nil {
+				return err
+			}
+			statuses[mi], err = mp.GetStatusDetails(nil)
+			return err
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return fmt.Errorf("Could not get the minipool statuses: %w", err)
+	}
+
+	// Get the dissolve timeout and the time of the latest block
+	timeout, err := protocol.GetMinipoolLaunchTimeout(t.rp, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get the minipool launch timeout: %w", err)
+	}
+	latestEth1Block, err := t.rp.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Can't get the latest block time: %w", err)
+	}
+	latestBlockTime := time.Unix(int64(latestEth1Block.Time), 0)
+
+	// Check the prelaunch minipools
+	for mi, address := range addresses {
+		key := fmt.Sprintf("minipool-dissolve-%s", address.Hex())
+		if statuses[mi].Status != rptypes.Prelaunch {
+			t.n.Clear(key)
+			continue
+		}
+		timeUntilDissolve := statuses[mi].StatusTime.Add(timeout).Sub(latestBlockTime)
+		if timeUntilDissolve < t.dissolveWarningTime {
+			t.n.Notify(cfgtypes.NotificationSeverity_Critical, key, "Minipool nearing dissolve",
+				fmt.Sprintf("Minipool %s is still in prelaunch and will be dissolved in %s unless it is staked. Check that your node daemon is running and has enough ETH for gas.", address.Hex(), timeUntilDissolve.Round(time.Minute)))
+		}
+	}
+	return nil
+
+}
+
+// Check if there are new rewards the node can claim
+func (t *checkNodeAlerts) checkNewRewards(nodeAddress common.Address) error {
+
+	unclaimed, _, err := rprewards.GetClaimStatus(t.rp, nodeAddress)
+	if err != nil {
+		return fmt.Errorf("Could not get the rewards claim status: %w", err)
+	}
+	if len(unclaimed) == 0 {
+		return nil
+	}
+
+	// Only notify about the latest interval once
+	latestIndex := unclaimed[len(unclaimed)-1]
+	if t.hasNotifiedRewards && latestIndex <= t.notifiedRewardsInterval {
+		return nil
+	}
+	intervalInfo, err := rprewards.GetIntervalInfo(t.rp, t.cfg, nodeAddress, latestIndex)
+	if err != nil {
+		return fmt.Errorf("Could not get the info for interval %d: %w", latestIndex, err)
+	}
+	if !intervalInfo.TreeFileExists {
+		// Wait until the tree has been downloaded
+		return nil
+	}
+	t.hasNotifiedRewards = true
+	t.notifiedRewardsInterval = latestIndex
+	if !intervalInfo.NodeExists {
+		return nil
+	}
+
+	rpl := big.NewInt(0).Add(&intervalInfo.CollateralRplAmount.Int, &intervalInfo.ODaoRplAmount.Int)
+	t.n.Notify(cfgtypes.NotificationSeverity_Info, fmt.Sprintf("rewards-available-%d", latestIndex), "New rewards available",
+		fmt.Sprintf("Rewards for interval %d are ready to claim: %.6f RPL and %.6f ETH. You have %d unclaimed interval(s) in total.", latestIndex, eth.WeiToEth(rpl), eth.WeiToEth(&intervalInfo.SmoothingPoolEthAmount.Int), len(unclaimed)))
+	return nil
+
+}
@@ -0,0 +1,83 @@
+package collectors
+
+import (
+	"log"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Represents the collector for the proposed block fee recipient audit metrics
+type FeeRecipientAuditCollector struct {
+	// The number of blocks proposed by the node's validators that have been audited
+	checkedBlocks *prometheus.Desc
+
+	// The number of blocks proposed by the node's validators with the wrong fee recipient
+	mismatchedBlocks *prometheus.Desc
+
+	// The latest epoch that has been audited
+	lastCheckedEpoch *prometheus.Desc
+
+	// The slot of the latest block proposed with the wrong fee recipient
+	lastMismatchSlot *prometheus.Desc
+
+	// The Smartnode config
+	cfg *config.RocketPoolConfig
+}
+
+// Create a new FeeRecipientAuditCollector instance
+func NewFeeRecipientAuditCollector(cfg *config.RocketPoolConfig) *FeeRecipientAuditCollector {
+	subsystem := "fee_recipient_audit"
+	return &FeeRecipientAuditCollector{
+		checkedBlocks: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "checked_blocks"),
+			"The number of blocks proposed by the node's validators that have been audited",
+			nil, nil,
+		),
+		mismatchedBlocks: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "mismatched_blocks"),
+			"The number of blocks proposed by the node's validators with the wrong fee recipient",
+			nil, nil,
+		),
+		lastCheckedEpoch: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_checked_epoch"),
+			"The latest epoch that has been audited",
+			nil, nil,
+		),
+		lastMismatchSlot: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_mismatch_slot"),
+			"The slot of the latest block proposed with the wrong fee recipient",
+			nil, nil,
+		),
+		cfg: cfg,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *FeeRecipientAuditCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.checkedBlocks
+	channel <- collector.mismatchedBlocks
+	channel <- collector.lastCheckedEpoch
+	channel <- collector.lastMismatchSlot
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *FeeRecipientAuditCollector) Collect(channel chan<- prometheus.Metric) {
+
+	// Load the audit state written by the audit task
+	state, err := rputils.LoadFeeRecipientAuditState(collector.cfg.Smartnode.GetFeeRecipientAuditStatePath())
+	if err != nil {
+		log.Printf("%s\n", err.Error())
+		return
+	}
+	if state == nil {
+		return
+	}
+
+	channel <- prometheus.MustNewConstMetric(
+		collector.checkedBlocks, prometheus.CounterValue, float64(state.CheckedBlocks))
+	channel <- prometheus.MustNewConstMetric(
+		collector.mismatchedBlocks, prometheus.CounterValue, float64(state.MismatchedBlocks))
+	channel <- prometheus.MustNewConstMetric(
+		collector.lastCheckedEpoch, prometheus.GaugeValue, float64(state.LastCheckedEpoch))
+	channel <- prometheus.MustNewConstMetric(
+		collector.lastMismatchSlot, prometheus.GaugeValue, float64(state.LastMismatchSlot))
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"math"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -62,6 +63,12 @@ type NodeCollector struct {
 	// The RPL rewards from the last period that have not been claimed yet
 	unclaimedRewards *prometheus.Desc
 
+	// The ETH balance of the node's fee distributor
+	distributorBalance *prometheus.Desc
+
+	// The time of the last fee distributor distribution
+	lastDistributionTime *prometheus.Desc
+
 	// The Rocket Pool contract manager
 	rp *rocketpool.RocketPool
 
@@ -83,6 +90,18 @@ type NodeCollector struct {
 	// Map of reward intervals that have already been processed
 	handledIntervals map[uint64]bool
 
+	// The next block to start from when looking for fee distributions
+	nextDistributionStartBlock *big.Int
+
+	// The time of the latest fee distribution, as a Unix timestamp
+	lastDistributionTimestamp float64
+
+	// Guards the fee distribution scan state, since the scan runs in the background
+	distributionLock sync.Mutex
+
+	// Whether a fee distribution scan is running
+	distributionScanRunning bool
+
 	// The Rocket Pool config
 	cfg *config.RocketPoolConfig
 }
@@ -147,6 +166,14 @@ func NewNodeCollector(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress c
 			"The RPL rewards from the last period that have not been claimed yet",
 			nil, nil,
 		),
+		distributorBalance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "distributor_balance"),
+			"The ETH balance of the node's fee distributor",
+			nil, nil,
+		),
+		lastDistributionTime: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_distribution_time"),
+			"The time of the last fee distributor distribution, as a Unix timestamp",
+			nil, nil,
+		),
 		rp:               rp,
 		bc:               bc,
 		nodeAddress:      nodeAddress,
@@ -168,6 +195,8 @@ func (collector *NodeCollector) Describe(channel chan<- *prometheus.Desc) {
 	channel <- collector.depositedEth
 	channel <- collector.beaconShare
 	channel <- collector.unclaimedRewards
+	channel <- collector.distributorBalance
+	channel <- collector.lastDistributionTime
 }
 
 // Collect the latest metric values and pass them to Prometheus
@@ -192,6 +221,7 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 	var addresses []common.Address
 	var beaconHead beacon.BeaconHead
 	unclaimedRewards := float64(0)
+	distributorBalance := float64(0)
 
 	// Get the total staked RPL
 	wg.Go(func() error {
@@ -381,6 +411,22 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 		return nil
 	})
 
+	// Get the fee distributor balance, and refresh the last distribution time in the background since scanning for it can take a while
+	wg.Go(func() error {
+		distributorAddress, err := node.GetDistributorAddress(collector.rp, collector.nodeAddress, nil)
+		if err != nil {
+			return fmt.Errorf("Error getting fee distributor address: %w", err)
+		}
+		balanceWei, err := collector.rp.Client.BalanceAt(context.Background(), distributorAddress, nil)
+		if err != nil {
+			return fmt.Errorf("Error getting fee distributor balance: %w", err)
+		}
+		distributorBalance = eth.WeiToEth(balanceWei)
+
+		collector.startDistributionScan(distributorAddress)
+		return nil
+	})
+
 	// Wait for data
 	if err := wg.Wait(); err != nil {
 		log.Printf("%s\n", err.Error())
@@ -453,4 +499,83 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 		collector.beaconBalance, prometheus.GaugeValue, totalBeaconBalance)
 	channel <- prometheus.MustNewConstMetric(
 		collector.unclaimedRewards, prometheus.GaugeValue, unclaimedRewards)
+	channel <- prometheus.MustNewConstMetric(
+		collector.distributorBalance, prometheus.GaugeValue, distributorBalance)
+	channel <- prometheus.MustNewConstMetric(
+		collector.lastDistributionTime, prometheus.GaugeValue, collector.getLastDistributionTimestamp())
+}
+
+// Get the time of the latest fee distribution found so far
+func (collector *NodeCollector) getLastDistributionTimestamp() float64 {
+	collector.distributionLock.Lock()
+	defer collector.distributionLock.Unlock()
+	return collector.lastDistributionTimestamp
+}
+
+// Scan for new fee distributions in the background, unless a scan is already running
+func (collector *NodeCollector) startDistributionScan(distributorAddress common.Address) {
+	collector.distributionLock.Lock()
+	defer collector.distributionLock.Unlock()
+	if collector.distributionScanRunning {
+		return
+	}
+	collector.distributionScanRunning = true
+
+	go func() {
+		if err := collector.updateLastDistributionTime(distributorAddress); err != nil {
+			log.Printf("%s\n", err.Error())
+		}
+		collector.distributionLock.Lock()
+		collector.distributionScanRunning = false
+		collector.distributionLock.Unlock()
+	}()
+}
+
+// Scan the fee distributor's logs for new distributions and record the time of the latest one.
+// The first scan starts from the deploy block, so this should only run in the background.
+func (collector *NodeCollector) updateLastDistributionTime(distributorAddress common.Address) error {
+
+	distributor, err := node.NewDistributor(collector.rp, distributorAddress)
+	if err != nil {
+		return fmt.Errorf("Error creating fee distributor binding: %w", err)
+	}
+	event, exists := distributor.Contract.ABI.Events["FeesDistributed"]
+	if !exists {
+		return nil
+	}
+
+	// Get the new logs
+	latestBlock, err := collector.rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return fmt.Errorf("Error getting latest block number: %w", err)
+	}
+	toBlock := big.NewInt(0).SetUint64(latestBlock)
+	collector.distributionLock.Lock()
+	fromBlock := collector.nextDistributionStartBlock
+	collector.distributionLock.Unlock()
+	if fromBlock != nil && fromBlock.Cmp(toBlock) > 0 {
+		return nil
+	}
+	logs, err := eth.GetLogs(collector.rp, []common.Address{distributorAddress}, [][]common.Hash{{event.ID}}, collector.eventLogInterval, fromBlock, toBlock, nil)
+	if err != nil {
+		return fmt.Errorf("Error getting fee distribution events: %w", err)
+	}
+	if len(logs) == 0 {
+		collector.distributionLock.Lock()
+		collector.nextDistributionStartBlock = big.NewInt(0).Add(toBlock, big.NewInt(1))
+		collector.distributionLock.Unlock()
+		return nil
+	}
+
+	// Use the block time of the latest distribution
+	header, err := collector.rp.Client.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(logs[len(logs)-1].BlockNumber))
+	if err != nil {
+		return fmt.Errorf("Error getting fee distribution block header: %w", err)
+	}
+	collector.distributionLock.Lock()
+	collector.nextDistributionStartBlock = big.NewInt(0).Add(toBlock, big.NewInt(1))
+	collector.lastDistributionTimestamp = float64(header.Time)
+	collector.distributionLock.Unlock()
+	return nil
+
 }
@@ -0,0 +1,132 @@
+package collectors
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// The number of epochs the validator performance metrics cover (225 epochs is approx. 1 day)
+const validatorPerformanceWindowEpochs uint64 = 225
+
+// Represents the collector for the per-validator performance metrics
+type ValidatorPerformanceCollector struct {
+	// The number of attestation duties assigned to the validator
+	attestationDuties *prometheus.Desc
+
+	// The number of the validator's attestations that were included on chain
+	attestationsIncluded *prometheus.Desc
+
+	// The average inclusion distance of the validator's attestations
+	inclusionDistance *prometheus.Desc
+
+	// The number of blocks the validator was due to propose
+	proposalDuties *prometheus.Desc
+
+	// The number of blocks the validator failed to propose
+	missedProposals *prometheus.Desc
+
+	// The number of sync committee duties assigned to the validator
+	syncDuties *prometheus.Desc
+
+	// The number of sync committee duties the validator fulfilled
+	syncParticipations *prometheus.Desc
+
+	// The latest epoch that has been checked
+	lastCheckedEpoch *prometheus.Desc
+
+	// The Smartnode config
+	cfg *config.RocketPoolConfig
+}
+
+// Create a new ValidatorPerformanceCollector instance
+func NewValidatorPerformanceCollector(cfg *config.RocketPoolConfig) *ValidatorPerformanceCollector {
+	subsystem := "validator"
+	labels := []string{"minipool", "index"}
+	return &ValidatorPerformanceCollector{
+		attestationDuties: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestation_duties"),
+			"The number of attestation duties assigned to the validator over the last day",
+			labels, nil,
+		),
+		attestationsIncluded: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestations_included"),
+			"The number of the validator's attestations that were included on chain over the last day",
+			labels, nil,
+		),
+		inclusionDistance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "inclusion_distance"),
+			"The average inclusion distance of the validator's attestations over the last day",
+			labels, nil,
+		),
+		proposalDuties: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "proposal_duties"),
+			"The number of blocks the validator was due to propose over the last day",
+			labels, nil,
+		),
+		missedProposals: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "missed_proposals"),
+			"The number of blocks the validator failed to propose over the last day",
+			labels, nil,
+		),
+		syncDuties: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sync_duties"),
+			"The number of sync committee duties assigned to the validator over the last day",
+			labels, nil,
+		),
+		syncParticipations: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sync_participations"),
+			"The number of sync committee duties the validator fulfilled over the last day",
+			labels, nil,
+		),
+		lastCheckedEpoch: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "performance_last_checked_epoch"),
+			"The latest epoch that has been checked for validator performance",
+			nil, nil,
+		),
+		cfg: cfg,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *ValidatorPerformanceCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.attestationDuties
+	channel <- collector.attestationsIncluded
+	channel <- collector.inclusionDistance
+	channel <- collector.proposalDuties
+	channel <- collector.missedProposals
+	channel <- collector.syncDuties
+	channel <- collector.syncParticipations
+	channel <- collector.lastCheckedEpoch
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *ValidatorPerformanceCollector) Collect(channel chan<- prometheus.Metric) {
+
+	// Load the performance state written by the monitor task
+	state, err := rputils.LoadValidatorPerformanceState(collector.cfg.Smartnode.GetValidatorPerformancePath())
+	if err != nil {
+		log.Printf("%s\n", err.Error())
+		return
+	}
+	if state == nil {
+		return
+	}
+
+	for _, performance := range state.Summarize(validatorPerformanceWindowEpochs) {
+		minipool := performance.MinipoolAddress.Hex()
+		index := strconv.FormatUint(performance.ValidatorIndex, 10)
+		channel <- prometheus.MustNewConstMetric(
+			collector.attestationDuties, prometheus.GaugeValue, float64(performance.AttestationDuties), minipool, index)
+		channel <- prometheus.MustNewConstMetric(
+			collector.attestationsIncluded, prometheus.GaugeValue, float64(performance.AttestationsIncluded), minipool, index)
+		channel <- prometheus.MustNewConstMetric(
+			collector.inclusionDistance, prometheus.GaugeValue, performance.AverageInclusionDistance, minipool, index)
+		channel <- prometheus.MustNewConstMetric(
+			collector.proposalDuties, prometheus.GaugeValue, float64(performance.ProposalDuties), minipool, index)
+		channel <- prometheus.MustNewConstMetric(
+			collector.missedProposals, prometheus.GaugeValue, float64(performance.ProposalsMissed), minipool, index)
+		channel <- prometheus.MustNewConstMetric(
+			collector.syncDuties, prometheus.GaugeValue, float64(performance.SyncDuties), minipool, index)
+		channel <- prometheus.MustNewConstMetric(
+			collector.syncParticipations, prometheus.GaugeValue, float64(performance.SyncParticipations), minipool, index)
+	}
+	channel <- prometheus.MustNewConstMetric(
+		collector.lastCheckedEpoch, prometheus.GaugeValue, float64(state.LastCheckedEpoch))
+}
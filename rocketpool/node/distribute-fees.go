@@ -0,0 +1,222 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Distribute fees task
+type distributeFees struct {
+	c              *cli.Context
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              *wallet.Wallet
+	rp             *rocketpool.RocketPool
+	ec             *services.ExecutionClientManager
+	tm             *txmanager.Manager
+	threshold      *big.Int
+	gasThreshold   float64
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+	gasLimit       uint64
+}
+
+// Create distribute fees task
+func newDistributeFees(c *cli.Context, logger log.ColorLogger) (*distributeFees, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if auto-distributing is disabled
+	thresholdEth := cfg.Smartnode.DistributeThreshold.Value.(float64)
+	var threshold *big.Int
+	if thresholdEth > 0 {
+		threshold = eth.EthToWei(thresholdEth)
+	}
+	gasThreshold := cfg.Smartnode.DistributeGasThreshold.Value.(float64)
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested max fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &distributeFees{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		ec:             ec,
+		tm:             tm,
+		threshold:      threshold,
+		gasThreshold:   gasThreshold,
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+		gasLimit:       0,
+	}, nil
+
+}
+
+// Distribute the fee distributor's balance if it has passed the threshold
+func (t *distributeFees) run(ctx context.Context) error {
+
+	// Check if auto-distributing is disabled
+	if t.threshold == nil {
+		return nil
+	}
+
+	// Reload the wallet (in case a call to `node deposit` changed it)
+	if err := t.w.Reload(); err != nil {
+		return err
+	}
+
+	// Wait for eth client to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Println("Checking the fee distributor balance...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Make sure the distributor has been initialized
+	isInitialized, err := node.GetFeeDistributorInitialized(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return fmt.Errorf("Could not check if the fee distributor is initialized: %w", err)
+	}
+	if !isInitialized {
+		t.log.Println("The fee distributor has not been initialized yet, so it cannot be distributed.")
+		return nil
+	}
+
+	// Check the distributor's balance
+	distributorAddress, err := node.GetDistributorAddress(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get the fee distributor address: %w", err)
+	}
+	balance, err := t.rp.Client.BalanceAt(ctx, distributorAddress, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get the fee distributor balance: %w", err)
+	}
+	if balance.Cmp(t.threshold) < 0 {
+		return nil
+	}
+
+	// Log
+	t.log.Printlnf("The fee distributor balance of %.6f ETH has reached the threshold of %.6f ETH, distributing...", eth.WeiToEth(balance), eth.WeiToEth(t.threshold))
+
+	// Get transactor
+	distributor, err := node.NewDistributor(t.rp, distributorAddress)
+	if err != nil {
+		return err
+	}
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Get the gas limit
+	gasInfo, err := distributor.EstimateDistributeGas(opts)
+	if err != nil {
+		return fmt.Errorf("Could not estimate the gas required to distribute the fee distributor balance: %w", err)
+	}
+	var gas *big.Int
+	if t.gasLimit != 0 {
+		gas = new(big.Int).SetUint64(t.gasLimit)
+	} else {
+		gas = new(big.Int).SetUint64(gasInfo.SafeGasLimit)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.ec)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Print the gas info
+	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, t.log, maxFee, t.gasLimit) {
+		return nil
+	}
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = t.maxPriorityFee
+	opts.GasLimit = gas.Uint64()
+
+	// Don't send a transaction if the daemon is shutting down
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Distribute
+	hash, err := distributor.Distribute(opts)
+	if err != nil {
+		return fmt.Errorf("Could not distribute the fee distributor balance: %w", err)
+	}
+
+	// Print TX info and wait for it to be mined
+	err = api.PrintAndWaitForTransactionContext(ctx, t.cfg, hash, t.tm, t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Println("Successfully distributed the fee distributor balance.")
+	return nil
+
+}
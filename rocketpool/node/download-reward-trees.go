@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -69,7 +70,7 @@ func newDownloadRewardsTrees(c *cli.Context, logger log.ColorLogger) (*downloadR
 }
 
 // Manage fee recipient
-func (d *downloadRewardsTrees) run() error {
+func (d *downloadRewardsTrees) run(ctx context.Context) error {
 
 	// Wait for eth client to sync
 	if err := services.WaitEthClientSynced(d.c, true); err != nil {
@@ -99,6 +100,9 @@ func (d *downloadRewardsTrees) run() error {
 	// Check for missing intervals
 	missingIntervals := []rprewards.IntervalInfo{}
 	for _, interval := range unclaimed {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		intervalInfo, err := rprewards.GetIntervalInfo(d.rp, d.cfg, nodeAccount.Address, interval)
 		if err != nil {
 			return err
@@ -115,6 +119,9 @@ func (d *downloadRewardsTrees) run() error {
 
 	// Download missing intervals
 	for _, missingInterval := range missingIntervals {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		fmt.Printf("Downloading interval %d file... ", missingInterval.Index)
 		err := rprewards.DownloadRewardsFile(d.cfg, missingInterval.Index, missingInterval.CID, true)
 		if err != nil {
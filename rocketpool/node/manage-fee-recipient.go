@@ -1,18 +1,20 @@
 package node
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/docker/docker/client"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
 	rpsvc "github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
@@ -27,10 +29,11 @@ type manageFeeRecipient struct {
 	rp  *rocketpool.RocketPool
 	d   *client.Client
 	bc  beacon.Client
+	n   *notifications.Notifier
 }
 
 // Create manage fee recipient task
-func newManageFeeRecipient(c *cli.Context, logger log.ColorLogger) (*manageFeeRecipient, error) {
+func newManageFeeRecipient(c *cli.Context, logger log.ColorLogger, notifier *notifications.Notifier) (*manageFeeRecipient, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -63,12 +66,13 @@ func newManageFeeRecipient(c *cli.Context, logger log.ColorLogger) (*manageFeeRe
 		rp:  rp,
 		d:   d,
 		bc:  bc,
+		n:   notifier,
 	}, nil
 
 }
 
 // Manage fee recipient
-func (m *manageFeeRecipient) run() error {
+func (m *manageFeeRecipient) run(ctx context.Context) error {
 
 	// Wait for eth client to sync
 	if err := services.WaitEthClientSynced(m.c, true); err != nil {
@@ -91,34 +95,55 @@ func (m *manageFeeRecipient) run() error {
 	}
 
 	// Get the correct fee recipient address
-	var correctFeeRecipient common.Address
-	if feeRecipientInfo.IsInSmoothingPool || feeRecipientInfo.IsInOptOutCooldown {
-		correctFeeRecipient = feeRecipientInfo.SmoothingPoolAddress
-	} else {
-		correctFeeRecipient = feeRecipientInfo.FeeDistributorAddress
+	correctFeeRecipient := feeRecipientInfo.GetCorrectFeeRecipient()
+
+	// Get the validators that need a different fee recipient
+	validatorFeeRecipients, err := rputils.GetValidatorFeeRecipients(m.rp, m.cfg, nodeAccount.Address, feeRecipientInfo)
+	if err != nil {
+		return fmt.Errorf("error getting validator fee recipients: %w", err)
 	}
+	overrides := rputils.GetFeeRecipientOverrides(validatorFeeRecipients, correctFeeRecipient)
 
 	// Check if the VC is using the correct fee recipient
 	fileExists, correctAddress, err := rpsvc.CheckFeeRecipientFile(correctFeeRecipient, m.cfg)
 	if err != nil {
 		return fmt.Errorf("error validating fee recipient files: %w", err)
 	}
+	correctProposerConfig, err := rpsvc.CheckProposerConfigFiles(correctFeeRecipient, overrides, m.cfg)
+	if err != nil {
+		return fmt.Errorf("error validating proposer config files: %w", err)
+	}
 
 	if !fileExists {
 		m.log.Println("Fee recipient files don't all exist, regenerating...")
 	} else if !correctAddress {
 		m.log.Printlnf("WARNING: Fee recipient files did not contain the correct fee recipient of %s, regenerating...", correctFeeRecipient.Hex())
+		m.n.Notify(cfgtypes.NotificationSeverity_Warning, "fee-recipient-mismatch", "Fee recipient mismatch",
+			fmt.Sprintf("Your validator client's fee recipient was not set to the correct address of %s. The Smartnode is correcting it now.", correctFeeRecipient.Hex()))
+	} else if !correctProposerConfig {
+		m.log.Println("Per-validator fee recipients are out of date, regenerating...")
 	} else {
 		// Files are all correct, return.
+		m.n.Clear("fee-recipient-mismatch")
 		return nil
 	}
 
+	// Don't start rewriting the files if the daemon is shutting down
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Regenerate the fee recipient files
 	err = rpsvc.UpdateFeeRecipientFile(correctFeeRecipient, m.cfg)
+	if err == nil {
+		err = rpsvc.UpdateProposerConfigFiles(correctFeeRecipient, overrides, m.cfg)
+	}
 	if err != nil {
 		m.log.Println("***ERROR***")
 		m.log.Printlnf("Error updating fee recipient files: %s", err.Error())
 		m.log.Println("Shutting down the validator client for safety to prevent you from being penalized...")
+		m.n.Notify(cfgtypes.NotificationSeverity_Critical, "validator-client-stopped", "Validator client stopped",
+			fmt.Sprintf("The Smartnode couldn't update your fee recipient files (%s), so it shut down your validator client to prevent you from being penalized. Your validators are offline until this is fixed.", err.Error()))
 
 		err = validator.StopValidator(m.cfg, m.bc, &m.log, m.d)
 		if err != nil {
@@ -127,15 +152,16 @@ func (m *manageFeeRecipient) run() error {
 		return nil
 	}
 
-	// Restart the VC
-	m.log.Println("Fee recipient files updated successfully! Restarting validator client...")
-	err = validator.RestartValidator(m.cfg, m.bc, &m.log, m.d)
+	// Update the VC
+	m.log.Println("Fee recipient files updated successfully! Updating validator client...")
+	err = validator.UpdateFeeRecipient(m.cfg, m.bc, &m.log, m.d, correctFeeRecipient, overrides)
 	if err != nil {
-		return fmt.Errorf("error restarting validator client: %w", err)
+		return fmt.Errorf("error updating validator client: %w", err)
 	}
 
 	// Log & return
-	m.log.Println("Successfully restarted, you are now validating safely.")
+	m.log.Println("Successfully updated, you are now validating safely.")
+	m.n.Clear("validator-client-stopped")
 	return nil
 
 }
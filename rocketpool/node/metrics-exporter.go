@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -14,7 +15,8 @@ import (
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger) error {
+// Register the metrics handlers on the daemon's HTTP server
+func registerMetrics(c *cli.Context, logger log.ColorLogger) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -61,6 +63,8 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger) error {
 	nodeCollector := collectors.NewNodeCollector(rp, bc, nodeAccount.Address, cfg)
 	trustedNodeCollector := collectors.NewTrustedNodeCollector(rp, bc, nodeAccount.Address, cfg)
 	beaconCollector := collectors.NewBeaconCollector(rp, bc, ec, nodeAccount.Address)
+	feeRecipientAuditCollector := collectors.NewFeeRecipientAuditCollector(cfg)
+	validatorPerformanceCollector := collectors.NewValidatorPerformanceCollector(cfg)
 
 	// Set up Prometheus
 	registry := prometheus.NewRegistry()
@@ -72,14 +76,19 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger) error {
 	registry.MustRegister(nodeCollector)
 	registry.MustRegister(trustedNodeCollector)
 	registry.MustRegister(beaconCollector)
+	registry.MustRegister(feeRecipientAuditCollector)
+	registry.MustRegister(validatorPerformanceCollector)
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 
-	// Start the HTTP server
-	metricsAddress := c.GlobalString("metricsAddress")
-	metricsPort := c.GlobalUint("metricsPort")
-	logger.Printlnf("Starting metrics exporter on %s:%d.", metricsAddress, metricsPort)
+	// Register the handlers
+	logger.Println("Starting metrics exporter.")
 	metricsPath := "/metrics"
-	http.Handle(metricsPath, handler)
+	http.HandleFunc(metricsPath, func(w http.ResponseWriter, r *http.Request) {
+		// Don't collect while a queued transaction is being sent with its own settings
+		services.HoldCallSettings(func() {
+			handler.ServeHTTP(w, r)
+		})
+	})
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte(`<html>
             <head><title>Rocket Pool Metrics Exporter</title></head>
@@ -90,8 +99,29 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger) error {
             </html>`,
 		))
 	})
-	err = http.ListenAndServe(fmt.Sprintf("%s:%d", metricsAddress, metricsPort), nil)
-	if err != nil {
+
+	return nil
+
+}
+
+// Run the daemon's HTTP server, which serves the metrics and health endpoints
+func runHttpServer(ctx context.Context, c *cli.Context, logger log.ColorLogger) error {
+
+	address := c.GlobalString("metricsAddress")
+	port := c.GlobalUint("metricsPort")
+	server := &http.Server{
+		Addr: fmt.Sprintf("%s:%d", address, port),
+	}
+
+	// Stop the server when the daemon shuts down
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+
+	logger.Printlnf("Starting HTTP server on %s:%d.", address, port)
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("Error running HTTP server: %w", err)
 	}
 
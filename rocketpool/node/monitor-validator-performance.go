@@ -0,0 +1,386 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Settings
+const (
+	// Number of epochs to go back and check if the monitor hasn't run before (225 epochs is approx. 1 day)
+	ValidatorPerformanceLookbackEpochs uint64 = 225
+
+	// Maximum number of epochs to check in a single run
+	ValidatorPerformanceMaxEpochsPerRun uint64 = 50
+
+	// Number of epochs of performance history to keep (1575 epochs is approx. 7 days)
+	ValidatorPerformanceRetentionEpochs uint64 = 1575
+)
+
+// Monitor validator performance task
+type monitorValidatorPerformance struct {
+	c   *cli.Context
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+	w   *wallet.Wallet
+	rp  *rocketpool.RocketPool
+	bc  beacon.Client
+
+	// Blocks fetched during the current run, keyed by slot (nil for missed slots)
+	blocks map[uint64]*beacon.BeaconBlock
+
+	// Sync committees fetched during the current run, keyed by sync committee period
+	syncCommittees map[uint64][]uint64
+}
+
+// An attestation duty for one of the node's validators
+type attestationDuty struct {
+	slot           uint64
+	committeeIndex uint64
+	position       uint64
+	validatorIndex uint64
+}
+
+// Create monitor validator performance task
+func newMonitorValidatorPerformance(c *cli.Context, logger log.ColorLogger) (*monitorValidatorPerformance, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &monitorValidatorPerformance{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+		w:   w,
+		rp:  rp,
+		bc:  bc,
+	}, nil
+
+}
+
+// Record the duties and results of the node's validators for newly finalized epochs
+func (t *monitorValidatorPerformance) run(ctx context.Context) error {
+
+	// Wait for eth clients to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+	if err := services.WaitBeaconClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the epochs to check; attestations can be included up to an epoch late, so the following epoch must be finalized too
+	head, err := t.bc.GetBeaconHead()
+	if err != nil {
+		return fmt.Errorf("error getting beacon head: %w", err)
+	}
+	if head.FinalizedEpoch == 0 {
+		return nil
+	}
+	statePath := t.cfg.Smartnode.GetValidatorPerformancePath()
+	state, err := rputils.LoadValidatorPerformanceState(statePath)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = &rputils.ValidatorPerformanceState{
+			Validators: map[uint64]*rputils.ValidatorPerformanceRecord{},
+		}
+		if head.FinalizedEpoch-1 > ValidatorPerformanceLookbackEpochs {
+			state.LastCheckedEpoch = head.FinalizedEpoch - 1 - ValidatorPerformanceLookbackEpochs
+		}
+	}
+	startEpoch := state.LastCheckedEpoch + 1
+	endEpoch := head.FinalizedEpoch - 1
+	if endEpoch < startEpoch {
+		return nil
+	}
+	if endEpoch-startEpoch+1 > ValidatorPerformanceMaxEpochsPerRun {
+		endEpoch = startEpoch + ValidatorPerformanceMaxEpochsPerRun - 1
+	}
+
+	// Get the node's validators
+	validators, err := getNodeValidators(t.rp, t.bc, nodeAccount.Address)
+	if err != nil {
+		return err
+	}
+	if len(validators) == 0 {
+		state.LastCheckedEpoch = endEpoch
+		return state.Save(statePath)
+	}
+	indices := make([]uint64, 0, len(validators))
+	for index, validator := range validators {
+		indices = append(indices, index)
+		if _, exists := state.Validators[index]; !exists {
+			state.Validators[index] = &rputils.ValidatorPerformanceRecord{
+				Index: index,
+			}
+		}
+		state.Validators[index].MinipoolAddress = validator.minipoolAddress
+		state.Validators[index].Pubkey = validator.pubkey
+	}
+
+	// Log
+	t.log.Printlnf("Checking validator performance for epochs %d to %d...", startEpoch, endEpoch)
+
+	eth2Config, err := t.bc.GetEth2Config()
+	if err != nil {
+		return fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	t.blocks = map[uint64]*beacon.BeaconBlock{}
+	t.syncCommittees = map[uint64][]uint64{}
+
+	// Check each epoch, stopping between epochs if the daemon is shutting down
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		if ctx.Err() != nil {
+			t.log.Printlnf("Stopped checking validator performance after epoch %d.", state.LastCheckedEpoch)
+			return nil
+		}
+		results, err := t.checkEpoch(epoch, eth2Config, validators, indices)
+		if err != nil {
+			return err
+		}
+		for index, result := range results {
+			record := state.Validators[index]
+			record.Epochs = append(record.Epochs, *result)
+		}
+
+		// Drop blocks that can no longer include attestations for the remaining epochs
+		for slot := range t.blocks {
+			if slot < (epoch+1)*eth2Config.SlotsPerEpoch {
+				delete(t.blocks, slot)
+			}
+		}
+
+		// Save progress
+		state.LastCheckedEpoch = epoch
+		state.Prune(ValidatorPerformanceRetentionEpochs)
+		if err := state.Save(statePath); err != nil {
+			return err
+		}
+	}
+
+	// Log & return
+	t.log.Printlnf("Finished checking validator performance up to epoch %d.", endEpoch)
+	return nil
+
+}
+
+// Check the duties of the node's validators in an epoch, returning the results for each validator that had any
+func (t *monitorValidatorPerformance) checkEpoch(epoch uint64, eth2Config beacon.Eth2Config, validators map[uint64]nodeValidator, indices []uint64) (map[uint64]*rputils.ValidatorEpochPerformance, error) {
+
+	results := map[uint64]*rputils.ValidatorEpochPerformance{}
+	getResult := func(index uint64) *rputils.ValidatorEpochPerformance {
+		result, exists := results[index]
+		if !exists {
+			result = &rputils.ValidatorEpochPerformance{
+				Epoch: epoch,
+			}
+			results[index] = result
+		}
+		return result
+	}
+	firstSlot := epoch * eth2Config.SlotsPerEpoch
+	lastSlot := firstSlot + eth2Config.SlotsPerEpoch - 1
+
+	// Map out the attestation duties
+	committees, err := t.bc.GetCommitteesForEpoch(&epoch)
+	if err != nil {
+		return nil, fmt.Errorf("error getting committees for epoch %d: %w", epoch, err)
+	}
+	duties := map[uint64]map[uint64][]*attestationDuty{}
+	for _, committee := range committees {
+		for position, validatorIndex := range committee.Validators {
+			if _, exists := validators[validatorIndex]; !exists {
+				continue
+			}
+			slotDuties, exists := duties[committee.Slot]
+			if !exists {
+				slotDuties = map[uint64][]*attestationDuty{}
+				duties[committee.Slot] = slotDuties
+			}
+			slotDuties[committee.Index] = append(slotDuties[committee.Index], &attestationDuty{
+				slot:           committee.Slot,
+				committeeIndex: committee.Index,
+				position:       uint64(position),
+				validatorIndex: validatorIndex,
+			})
+			getResult(validatorIndex).AttestationDuties++
+		}
+	}
+
+	// Find the earliest inclusion of each duty, up to an epoch after the attestation's slot
+	for slot := firstSlot + 1; slot <= lastSlot+eth2Config.SlotsPerEpoch; slot++ {
+		block, err := t.getBlock(slot)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			continue
+		}
+		for _, attestation := range block.Attestations {
+			slotDuties, exists := duties[attestation.SlotIndex]
+			if !exists {
+				continue
+			}
+			committeeDuties := slotDuties[attestation.CommitteeIndex]
+			remaining := committeeDuties[:0]
+			for _, duty := range committeeDuties {
+				if attestation.AggregationBits.BitAt(duty.position) {
+					result := getResult(duty.validatorIndex)
+					result.AttestationsIncluded++
+					result.InclusionDistance += block.Slot - duty.slot
+				} else {
+					remaining = append(remaining, duty)
+				}
+			}
+			slotDuties[attestation.CommitteeIndex] = remaining
+		}
+	}
+
+	// Check the proposals; if the duties can't be retrieved, only the proposals that were found are counted and the epoch is marked as unknown
+	proposerDuties, err := t.bc.GetValidatorProposerDuties(indices, epoch)
+	proposalsUnknown := false
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't get the proposer duties for epoch %d, so missed proposals can't be counted: %s", epoch, err.Error())
+		proposalsUnknown = true
+	}
+	proposals := map[uint64]uint64{}
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		block, err := t.getBlock(slot)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			continue
+		}
+		if _, exists := validators[block.ProposerIndex]; exists {
+			proposals[block.ProposerIndex]++
+		}
+	}
+	for _, index := range indices {
+		if proposalsUnknown {
+			result := getResult(index)
+			result.ProposalsUnknown = true
+			result.ProposalDuties = proposals[index]
+			continue
+		}
+		expected, hasDuties := proposerDuties[index]
+		if !hasDuties {
+			expected = proposals[index]
+		}
+		if expected == 0 {
+			continue
+		}
+		result := getResult(index)
+		result.ProposalDuties = expected
+		if proposals[index] < expected {
+			result.ProposalsMissed = expected - proposals[index]
+		}
+	}
+
+	// Check sync committee participation
+	syncCommittee, err := t.getSyncCommittee(epoch, eth2Config)
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't get the sync committee for epoch %d, skipping its sync duties: %s", epoch, err.Error())
+		return results, nil
+	}
+	syncPositions := map[uint64][]uint64{}
+	for position, validatorIndex := range syncCommittee {
+		if _, exists := validators[validatorIndex]; exists {
+			syncPositions[validatorIndex] = append(syncPositions[validatorIndex], uint64(position))
+		}
+	}
+	if len(syncPositions) == 0 {
+		return results, nil
+	}
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		block, err := t.getBlock(slot)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil || !block.HasSyncAggregate {
+			continue
+		}
+		for validatorIndex, positions := range syncPositions {
+			result := getResult(validatorIndex)
+			for _, position := range positions {
+				result.SyncDuties++
+				if block.SyncCommitteeBits.BitAt(position) {
+					result.SyncParticipations++
+				}
+			}
+		}
+	}
+
+	return results, nil
+
+}
+
+// Get the block for a slot, or nil if it was missed
+func (t *monitorValidatorPerformance) getBlock(slot uint64) (*beacon.BeaconBlock, error) {
+	block, cached := t.blocks[slot]
+	if cached {
+		return block, nil
+	}
+	beaconBlock, exists, err := t.bc.GetBeaconBlock(strconv.FormatUint(slot, 10))
+	if err != nil {
+		return nil, fmt.Errorf("error getting beacon block %d: %w", slot, err)
+	}
+	if exists {
+		block = &beaconBlock
+	}
+	t.blocks[slot] = block
+	return block, nil
+}
+
+// Get the sync committee for an epoch
+func (t *monitorValidatorPerformance) getSyncCommittee(epoch uint64, eth2Config beacon.Eth2Config) ([]uint64, error) {
+	period := epoch
+	if eth2Config.EpochsPerSyncCommitteePeriod > 0 {
+		period = epoch / eth2Config.EpochsPerSyncCommitteePeriod
+	}
+	syncCommittee, cached := t.syncCommittees[period]
+	if cached {
+		return syncCommittee, nil
+	}
+	syncCommittee, err := t.bc.GetSyncCommitteeForEpoch(epoch)
+	if err != nil {
+		return nil, err
+	}
+	t.syncCommittees[period] = syncCommittee
+	return syncCommittee, nil
+}
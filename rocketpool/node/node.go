@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -14,15 +15,22 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/health"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
 // Config
-var tasksInterval, _ = time.ParseDuration("5m")
+var minTasksInterval, _ = time.ParseDuration("4m")
+var maxTasksInterval, _ = time.ParseDuration("6m")
 var taskCooldown, _ = time.ParseDuration("10s")
 
 const (
@@ -33,6 +41,12 @@ const (
 	DownloadRewardsTreesColor    = color.FgGreen
 	MetricsColor                 = color.FgHiYellow
 	ManageFeeRecipientColor      = color.FgHiCyan
+	AuditFeeRecipientsColor      = color.FgHiBlue
+	DistributeFeesColor          = color.FgHiGreen
+	ValidatorPerformanceColor    = color.FgHiMagenta
+	NodeAlertsColor              = color.FgMagenta
+	ManageTransactionsColor      = color.FgHiWhite
+	TransactionQueueColor        = color.FgCyan
 	ErrorColor                   = color.FgRed
 	WarningColor                 = color.FgYellow
 )
@@ -64,16 +78,73 @@ func run(c *cli.Context) error {
 		return err
 	}
 
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+
 	// Configure
 	configureHTTP()
 
+	// Initialize loggers
+	errorLog := log.NewColorLogger(ErrorColor)
+
+	// Get the shutdown context and start the HTTP server so the health endpoints are available while waiting
+	ctx, cancel := tasks.NewShutdownContext(log.NewColorLogger(WarningColor))
+	defer cancel()
+	monitor := health.NewMonitor(ctx, c)
+	monitor.RegisterHandlers(http.DefaultServeMux)
+
+	// Wait group to handle the various threads
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+
+	// Run the HTTP server
+	go func() {
+		err := runHttpServer(ctx, c, log.NewColorLogger(MetricsColor))
+		if err != nil {
+			errorLog.Println(err)
+		}
+		wg.Done()
+	}()
+
+	// Start the password agent so the node wallet can be unlocked interactively
+	if err := startPasswordAgent(c); err != nil {
+		return err
+	}
+
 	// Wait until node is registered
-	if err := services.WaitNodeRegistered(c, true); err != nil {
+	monitor.SetStage(health.StageWaitingRegistration)
+	if err := tasks.WaitFor(ctx, func() error {
+		return services.WaitNodeRegistered(c, true)
+	}); err != nil {
+		if ctx.Err() != nil {
+			wg.Wait()
+			return nil
+		}
 		return err
 	}
 
+	// Set up notifications
+	notifier := notifications.NewNotifier(cfg, "node", log.NewColorLogger(WarningColor))
+	if notifier.IsEnabled() {
+		if err := setFailoverHandlers(c, notifier); err != nil {
+			return err
+		}
+	}
+
+	// Record the daemon's transactions and replace them automatically if they get stuck
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return err
+	}
+	tm.SetSource(txmanager.Source_Node)
+	tm.EnableAutoReplace(services.GetAutoReplaceMaxFee(cfg))
+	manageTransactionsLog := log.NewColorLogger(ManageTransactionsColor)
+
 	// Initialize tasks
-	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
+	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor), notifier)
 	if err != nil {
 		return err
 	}
@@ -85,65 +156,147 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	autoClaimRewards, err := newAutoClaimRewards(c, log.NewColorLogger(ClaimRplRewardsColor))
+	if err != nil {
+		return err
+	}
+	distributeFees, err := newDistributeFees(c, log.NewColorLogger(DistributeFeesColor))
+	if err != nil {
+		return err
+	}
 
-	// Initialize loggers
-	errorLog := log.NewColorLogger(ErrorColor)
+	auditFeeRecipients, err := newAuditFeeRecipients(c, log.NewColorLogger(AuditFeeRecipientsColor), errorLog, notifier)
+	if err != nil {
+		return err
+	}
+	monitorValidatorPerformance, err := newMonitorValidatorPerformance(c, log.NewColorLogger(ValidatorPerformanceColor))
+	if err != nil {
+		return err
+	}
+	checkNodeAlerts, err := newCheckNodeAlerts(c, log.NewColorLogger(NodeAlertsColor), notifier)
+	if err != nil {
+		return err
+	}
+	processTransactionQueue, err := newProcessTransactionQueue(c, log.NewColorLogger(TransactionQueueColor))
+	if err != nil {
+		return err
+	}
 
-	// Wait group to handle the various threads
-	wg := new(sync.WaitGroup)
-	wg.Add(2)
+	// Get the task scheduler
+	scheduler := tasks.NewScheduler(ctx, log.NewColorLogger(WarningColor), errorLog, taskCooldown)
 
-	// Run task loop
-	go func() {
-		for {
-			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
-			if err != nil {
-				errorLog.Println(err)
-			} else {
-				// Check the BC status
-				err := services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
-				if err != nil {
-					errorLog.Println(err)
-				} else {
-					// Manage the fee recipient for the node
-					if err := manageFeeRecipient.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the rewards download check
-					if err := downloadRewardsTrees.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the minipool stake check
-					if err := stakePrelaunchMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
-				}
-			}
-			time.Sleep(tasksInterval)
+	// Check the EC and BC status before running tasks
+	scheduler.SetPrecondition(func() error {
+		// Force refresh the primary / fallback EC status
+		if err := services.WaitEthClientSynced(c, false); err != nil {
+			return err
 		}
-		wg.Done()
-	}()
+		// Force refresh the primary / fallback BC status
+		return services.WaitBeaconClientSynced(c, false)
+	})
+
+	// Register tasks; the intervals are randomized between the min and max
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "manage fee recipient",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  true,
+	}, manageFeeRecipient.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "download rewards trees",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  true,
+	}, downloadRewardsTrees.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "auto-claim rewards",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  cfg.Smartnode.AutoClaimEnabled.Value.(bool),
+	}, autoClaimRewards.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "distribute fees",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  cfg.Smartnode.DistributeThreshold.Value.(float64) > 0,
+	}, distributeFees.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "stake prelaunch minipools",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  true,
+	}, stakePrelaunchMinipools.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "audit fee recipients",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  true,
+	}, auditFeeRecipients.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "monitor validator performance",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Timeout:  minTasksInterval,
+		Enabled:  true,
+	}, monitorValidatorPerformance.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "replace stuck transactions",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  true,
+	}, func(ctx context.Context) error {
+		return tm.ReplaceStuckTransactions(ctx, manageTransactionsLog)
+	}))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "process transaction queue",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  true,
+	}, processTransactionQueue.run))
+	scheduler.Add(tasks.New(tasks.Settings{
+		Name:     "check node alerts",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Enabled:  notifier.IsEnabled(),
+	}, checkNodeAlerts.run))
+
+	// Report the task statuses in the health endpoints
+	monitor.SetScheduler(scheduler)
+	monitor.SetStage(health.StageRunning)
+
+	// Register the metrics handlers
+	if err := registerMetrics(c, log.NewColorLogger(MetricsColor)); err != nil {
+		errorLog.Println(err)
+	}
 
-	// Run metrics loop
+	// Run task loop
+	wg.Add(1)
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor))
-		if err != nil {
-			errorLog.Println(err)
-		}
+		scheduler.Run()
 		wg.Done()
 	}()
 
-	// Wait for both threads to stop
+	// Wait for the threads to stop
 	wg.Wait()
 	return nil
 
 }
 
+// Notify the operator when the EC or BC fails over to its fallback
+func setFailoverHandlers(c *cli.Context, notifier *notifications.Notifier) error {
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return err
+	}
+	ec.SetFailoverHandler(notifier.GetFailoverHandler("ec-failover"))
+	bc.SetFailoverHandler(notifier.GetFailoverHandler("bc-failover"))
+	return nil
+}
+
 // Configure HTTP transport settings
 func configureHTTP() {
 
@@ -154,6 +307,26 @@ func configureHTTP() {
 
 }
 
+// Start the password agent if the node password is provided by interactive unlock
+func startPasswordAgent(c *cli.Context) error {
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	if cfg.Smartnode.GetPasswordBackend() != cfgtypes.PasswordBackend_Interactive {
+		return nil
+	}
+
+	agent := passwords.NewAgent(os.ExpandEnv(cfg.Smartnode.GetPasswordAgentSocketPath()))
+	if err := agent.Start(); err != nil {
+		return err
+	}
+	fmt.Println("The node wallet is locked; run `rocketpool wallet unlock` to unlock it.")
+	return nil
+
+}
+
 // Copy the default fee recipient file into the proper location
 func deployDefaultFeeRecipientFile(c *cli.Context) error {
 
@@ -0,0 +1,210 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Process transaction queue task
+type processTransactionQueue struct {
+	c     *cli.Context
+	log   log.ColorLogger
+	cfg   *config.RocketPoolConfig
+	ec    *services.ExecutionClientManager
+	bc    *services.BeaconClientManager
+	queue *txmanager.Queue
+}
+
+// The parts of an API response needed to record the result of a queued transaction
+type queuedTransactionResponse struct {
+	Status      string       `json:"status"`
+	Error       string       `json:"error"`
+	TxHash      *common.Hash `json:"txHash"`
+	StakeTxHash *common.Hash `json:"stakeTxHash"`
+}
+
+// Create process transaction queue task
+func newProcessTransactionQueue(c *cli.Context, logger log.ColorLogger) (*processTransactionQueue, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transactions that were being sent when the daemon last stopped can't be retried safely
+	queue := txmanager.NewQueue(cfg.Smartnode.GetTransactionQueuePath())
+	if err := queue.FailInterruptedTransactions(); err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &processTransactionQueue{
+		c:     c,
+		log:   logger,
+		cfg:   cfg,
+		ec:    ec,
+		bc:    bc,
+		queue: queue,
+	}, nil
+
+}
+
+// Send the queued transactions that are due at the current base fee
+func (t *processTransactionQueue) run(ctx context.Context) error {
+
+	// Get the latest base fee
+	header, err := t.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get the latest block header: %w", err)
+	}
+	if header.BaseFee == nil {
+		return nil
+	}
+	baseFeeGwei := eth.WeiToGwei(header.BaseFee)
+
+	// Get the due transactions
+	transactions, err := t.queue.TakeDueTransactions(baseFeeGwei)
+	if err != nil {
+		return err
+	}
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	// Send them; if the daemon is shutting down, put the rest back in the queue
+	for _, tx := range transactions {
+		if err := ctx.Err(); err != nil {
+			if err := t.queue.Requeue(tx.ID, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if baseFeeGwei < tx.GasThreshold {
+			t.log.Printlnf("The base fee is %.2f gwei, sending queued transaction %d (%s)...", baseFeeGwei, tx.ID, tx.Description)
+		} else {
+			t.log.Printlnf("The deadline has passed, sending queued transaction %d (%s) at a base fee of %.2f gwei...", tx.ID, tx.Description, baseFeeGwei)
+		}
+		txHash, temporary, sendErr := t.send(tx)
+		if sendErr != nil && temporary {
+			t.log.Printlnf("Could not send queued transaction %d, it will be retried: %s", tx.ID, sendErr.Error())
+			if err := t.queue.Requeue(tx.ID, sendErr); err != nil {
+				return err
+			}
+			continue
+		}
+		if sendErr != nil {
+			t.log.Printlnf("Could not send queued transaction %d: %s", tx.ID, sendErr.Error())
+		} else {
+			t.log.Printlnf("Sent queued transaction %d with hash %s.", tx.ID, txHash.Hex())
+		}
+		if err := t.queue.SetResult(tx.ID, txHash, sendErr); err != nil {
+			return err
+		}
+	}
+
+	// Return
+	return nil
+
+}
+
+// Run a queued transaction's API command with its fees.
+// Errors are temporary if the command couldn't be run, or if it failed because the clients aren't ready.
+func (t *processTransactionQueue) send(tx *txmanager.QueuedTransaction) (*common.Hash, bool, error) {
+
+	// Get the fees, falling back to the daemon's settings
+	maxFeeGwei := tx.MaxFee
+	if maxFeeGwei == 0 {
+		maxFeeGwei = t.cfg.Smartnode.ManualMaxFee.Value.(float64)
+	}
+	if maxFeeGwei == 0 {
+		maxFee, err := rpgas.GetHeadlessMaxFeeWei(t.cfg, t.ec)
+		if err != nil {
+			return nil, true, err
+		}
+		maxFeeGwei = eth.WeiToGwei(maxFee)
+	}
+	maxPriorityFeeGwei := tx.MaxPriorityFee
+	if maxPriorityFeeGwei == 0 {
+		maxPriorityFeeGwei = t.cfg.Smartnode.PriorityFee.Value.(float64)
+	}
+
+	// Run the command as if it was called from the command line, with the fees applied to the services
+	args := []string{
+		t.c.App.Name,
+		"--settings", os.ExpandEnv(t.c.GlobalString("settings")),
+		"--maxFee", strconv.FormatFloat(maxFeeGwei, 'f', -1, 64),
+		"--maxPrioFee", strconv.FormatFloat(maxPriorityFeeGwei, 'f', -1, 64),
+	}
+	if tx.GasLimit != 0 {
+		args = append(args, "--gasLimit", strconv.FormatUint(tx.GasLimit, 10))
+	}
+	args = append(args, "api")
+	args = append(args, tx.Args...)
+	var output []byte
+	if err := services.RunWithCallSettings(t.c, services.CallSettings{
+		MaxFee:         maxFeeGwei,
+		MaxPriorityFee: maxPriorityFeeGwei,
+		GasLimit:       tx.GasLimit,
+	}, func() {
+		output = apiutils.RunCommand(t.c.App, args)
+	}); err != nil {
+		if output == nil {
+			return nil, true, err
+		}
+		t.log.Printlnf("WARNING: Could not restore the daemon's gas settings: %s", err.Error())
+	}
+
+	// Get the result
+	var response queuedTransactionResponse
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, false, fmt.Errorf("Could not decode the API response: %w", err)
+	}
+	if response.Status == "error" {
+		return nil, !t.areClientsReady(), errors.New(response.Error)
+	}
+	if response.StakeTxHash != nil {
+		return response.StakeTxHash, false, nil
+	}
+	if response.TxHash == nil {
+		return nil, false, fmt.Errorf("The API command did not return a transaction hash")
+	}
+	return response.TxHash, false, nil
+
+}
+
+// Check if the primary or fallback EC and BC are working and synced
+func (t *processTransactionQueue) areClientsReady() bool {
+	for _, status := range []*api.ClientManagerStatus{t.ec.CheckStatus(), t.bc.CheckStatus()} {
+		primaryReady := status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced
+		fallbackReady := status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced
+		if !primaryReady && !fallbackReady {
+			return false
+		}
+	}
+	return true
+}
@@ -14,15 +14,18 @@ import (
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
@@ -33,6 +36,8 @@ type stakePrelaunchMinipools struct {
 	cfg            *config.RocketPoolConfig
 	w              *wallet.Wallet
 	rp             *rocketpool.RocketPool
+	ec             *services.ExecutionClientManager
+	tm             *txmanager.Manager
 	bc             beacon.Client
 	d              *client.Client
 	gasThreshold   float64
@@ -57,6 +62,14 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 	if err != nil {
 		return nil, err
 	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
@@ -95,6 +108,8 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 		cfg:            cfg,
 		w:              w,
 		rp:             rp,
+		ec:             ec,
+		tm:             tm,
 		bc:             bc,
 		d:              d,
 		gasThreshold:   gasThreshold,
@@ -106,7 +121,7 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 }
 
 // Stake prelaunch minipools
-func (t *stakePrelaunchMinipools) run() error {
+func (t *stakePrelaunchMinipools) run(ctx context.Context) error {
 
 	// Reload the wallet (in case a call to `node deposit` changed it)
 	if err := t.w.Reload(); err != nil {
@@ -128,7 +143,7 @@ func (t *stakePrelaunchMinipools) run() error {
 	}
 
 	// Get prelaunch minipools
-	minipools, err := t.getPrelaunchMinipools(nodeAccount.Address)
+	minipools, err := t.getPrelaunchMinipools(ctx, nodeAccount.Address)
 	if err != nil {
 		return err
 	}
@@ -145,33 +160,66 @@ func (t *stakePrelaunchMinipools) run() error {
 	// Log
 	t.log.Printlnf("%d minipool(s) are ready for staking...", len(minipools))
 
-	// Stake minipools
-	successCount := 0
+	// Stake minipools; if the daemon is shutting down, stop staking but still load the keys of the ones already staked
+	stakedMinipools := []*minipool.Minipool{}
 	for _, mp := range minipools {
-		success, err := t.stakeMinipool(mp, eth2Config)
+		if ctx.Err() != nil {
+			break
+		}
+		success, err := t.stakeMinipool(ctx, mp, eth2Config)
 		if err != nil {
 			t.log.Println(fmt.Errorf("Could not stake minipool %s: %w", mp.Address.Hex(), err))
 			return err
 		}
 		if success {
-			successCount++
+			stakedMinipools = append(stakedMinipools, mp)
 		}
 	}
 
-	// Restart validator process if any minipools were staked successfully
-	if successCount > 0 {
-		if err := validator.RestartValidator(t.cfg, t.bc, &t.log, t.d); err != nil {
+	// Load the validator keys if any minipools were staked successfully
+	if len(stakedMinipools) > 0 {
+		if err := t.loadValidatorKeys(nodeAccount.Address, stakedMinipools); err != nil {
 			return err
 		}
 	}
 
 	// Return
-	return nil
+	return ctx.Err()
+
+}
+
+// Load the validator keys for staked minipools into the validator client
+func (t *stakePrelaunchMinipools) loadValidatorKeys(nodeAddress common.Address, minipools []*minipool.Minipool) error {
+
+	// Get the validator keys
+	keys := make([]*eth2types.BLSPrivateKey, len(minipools))
+	for i, mp := range minipools {
+		validatorPubkey, err := minipool.GetMinipoolPubkey(t.rp, mp.Address, nil)
+		if err != nil {
+			return err
+		}
+		keys[i], err = t.w.GetValidatorKeyByPubkey(validatorPubkey)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Get the fee recipient the new validators should use
+	var feeRecipient common.Address
+	feeRecipientInfo, err := rputils.GetFeeRecipientInfo(t.rp, t.bc, nodeAddress)
+	if err != nil {
+		t.log.Printlnf("WARNING: Could not get fee recipient info, the validator client's default will be used: %s", err.Error())
+	} else {
+		feeRecipient = feeRecipientInfo.GetCorrectFeeRecipient()
+	}
+
+	// Load them
+	return validator.LoadValidatorKeys(t.cfg, t.bc, &t.log, t.d, keys, feeRecipient)
 
 }
 
 // Get prelaunch minipools
-func (t *stakePrelaunchMinipools) getPrelaunchMinipools(nodeAddress common.Address) ([]*minipool.Minipool, error) {
+func (t *stakePrelaunchMinipools) getPrelaunchMinipools(ctx context.Context, nodeAddress common.Address) ([]*minipool.Minipool, error) {
 
 	// Get node minipool addresses
 	addresses, err := minipool.GetNodeMinipoolAddresses(t.rp, nodeAddress, nil)
@@ -218,7 +266,7 @@ func (t *stakePrelaunchMinipools) getPrelaunchMinipools(nodeAddress common.Addre
 	scrubPeriod := time.Duration(scrubPeriodSeconds) * time.Second
 
 	// Get the time of the latest block
-	latestEth1Block, err := t.rp.Client.HeaderByNumber(context.Background(), nil)
+	latestEth1Block, err := t.rp.Client.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return []*minipool.Minipool{}, fmt.Errorf("Can't get the latest block time: %w", err)
 	}
@@ -244,7 +292,7 @@ func (t *stakePrelaunchMinipools) getPrelaunchMinipools(nodeAddress common.Addre
 }
 
 // Stake a minipool
-func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool, eth2Config beacon.Eth2Config) (bool, error) {
+func (t *stakePrelaunchMinipools) stakeMinipool(ctx context.Context, mp *minipool.Minipool, eth2Config beacon.Eth2Config) (bool, error) {
 
 	// Log
 	t.log.Printlnf("Staking minipool %s...", mp.Address.Hex())
@@ -293,7 +341,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool, eth2Confi
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.ec)
 		if err != nil {
 			return false, err
 		}
@@ -332,8 +380,12 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mp *minipool.Minipool, eth2Confi
 		return false, err
 	}
 
-	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	// Print TX info and wait for it to be mined; if the daemon is shutting down, the stake has already been sent so its key still needs to be loaded
+	err = api.PrintAndWaitForTransactionContext(ctx, t.cfg, hash, t.tm, t.log)
+	if err != nil && ctx.Err() != nil {
+		t.log.Printlnf("Stopped waiting for minipool %s to be staked because the daemon is shutting down.", mp.Address.Hex())
+		return true, nil
+	}
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,46 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// A validator belonging to one of the node's minipools
+type nodeValidator struct {
+	pubkey          rptypes.ValidatorPubkey
+	minipoolAddress common.Address
+}
+
+// Get the node's validators that are on the Beacon Chain, keyed by index
+func getNodeValidators(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress common.Address) (map[uint64]nodeValidator, error) {
+	minipools, err := minipool.GetNodeMinipools(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting node minipools: %w", err)
+	}
+	pubkeys := make([]rptypes.ValidatorPubkey, len(minipools))
+	for i, details := range minipools {
+		pubkeys[i] = details.Pubkey
+	}
+	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting validator statuses: %w", err)
+	}
+	validators := map[uint64]nodeValidator{}
+	for _, details := range minipools {
+		status, exists := statuses[details.Pubkey]
+		if !exists || !status.Exists {
+			continue
+		}
+		validators[status.Index] = nodeValidator{
+			pubkey:          details.Pubkey,
+			minipoolAddress: details.Address,
+		}
+	}
+	return validators, nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/rocketpool/api"
+	"github.com/rocket-pool/smartnode/rocketpool/api/schema"
+	"github.com/rocket-pool/smartnode/rocketpool/apiserver"
 	"github.com/rocket-pool/smartnode/rocketpool/node"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower"
 	"github.com/rocket-pool/smartnode/shared"
@@ -124,6 +126,8 @@ func main() {
 
 	// Register commands
 	api.RegisterCommands(app, "api", []string{"a"})
+	apiserver.RegisterCommands(app, "api-server", []string{})
+	schema.RegisterCommands(app, "api-schema", []string{})
 	node.RegisterCommands(app, "node", []string{"n"})
 	watchtower.RegisterCommands(app, "watchtower", []string{"w"})
 
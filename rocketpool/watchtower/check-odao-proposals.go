@@ -0,0 +1,102 @@
+package watchtower
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/dao"
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Check oDAO proposals task
+type checkOdaoProposals struct {
+	c   *cli.Context
+	log log.ColorLogger
+	w   *wallet.Wallet
+	rp  *rocketpool.RocketPool
+	n   *notifications.Notifier
+}
+
+// Create check oDAO proposals task
+func newCheckOdaoProposals(c *cli.Context, logger log.ColorLogger, notifier *notifications.Notifier) (*checkOdaoProposals, error) {
+
+	// Get services
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &checkOdaoProposals{
+		c:   c,
+		log: logger,
+		w:   w,
+		rp:  rp,
+		n:   notifier,
+	}, nil
+
+}
+
+// Notify the operator about active oDAO proposals the node hasn't voted on
+func (t *checkOdaoProposals) run() error {
+
+	// Check if notifications are enabled
+	if !t.n.IsEnabled() {
+		return nil
+	}
+
+	// Wait for eth client to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Check node trusted status
+	nodeTrusted, err := trustednode.GetMemberExists(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	if !nodeTrusted {
+		return nil
+	}
+
+	// Log
+	t.log.Println("Checking for oDAO proposals that need a vote...")
+
+	// Get the proposals
+	proposals, err := dao.GetDAOProposalsWithMember(t.rp, "rocketDAONodeTrustedProposals", nodeAccount.Address, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get the oDAO proposals: %w", err)
+	}
+
+	for _, proposal := range proposals {
+		key := fmt.Sprintf("odao-proposal-%d", proposal.ID)
+		if proposal.State != rptypes.Active || proposal.MemberVoted {
+			t.n.Clear(key)
+			continue
+		}
+		endTime := time.Unix(int64(proposal.EndTime), 0)
+		t.n.Notify(cfgtypes.NotificationSeverity_Warning, key, "oDAO proposal needs a vote",
+			fmt.Sprintf("Proposal %d ('%s') from %s is active and your node hasn't voted on it yet. Voting ends at %s.", proposal.ID, proposal.Message, proposal.ProposerAddress.Hex(), endTime.UTC().Format(time.RFC1123)))
+	}
+	return nil
+
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -34,6 +35,7 @@ type dissolveTimedOutMinipools struct {
 	w   *wallet.Wallet
 	ec  rocketpool.ExecutionClient
 	rp  *rocketpool.RocketPool
+	tm  *txmanager.Manager
 }
 
 // Create dissolve timed out minipools task
@@ -56,6 +58,10 @@ func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*diss
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return task
 	return &dissolveTimedOutMinipools{
@@ -65,6 +71,7 @@ func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*diss
 		w:   w,
 		ec:  ec,
 		rp:  rp,
+		tm:  tm,
 	}, nil
 
 }
@@ -244,7 +251,7 @@ func (t *dissolveTimedOutMinipools) dissolveMinipool(mp *minipool.Minipool) erro
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return err
 	}
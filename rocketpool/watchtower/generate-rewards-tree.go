@@ -23,6 +23,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
@@ -38,10 +39,11 @@ type generateRewardsTree struct {
 	bc        beacon.Client
 	lock      *sync.Mutex
 	isRunning bool
+	scheduler *tasks.Scheduler
 }
 
 // Create generate rewards Merkle Tree task
-func newGenerateRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*generateRewardsTree, error) {
+func newGenerateRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, scheduler *tasks.Scheduler) (*generateRewardsTree, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -72,6 +74,7 @@ func newGenerateRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger
 		rp:        rp,
 		lock:      lock,
 		isRunning: false,
+		scheduler: scheduler,
 	}
 
 	return generator, nil
@@ -124,7 +127,9 @@ func (t *generateRewardsTree) run() error {
 			t.lock.Lock()
 			t.isRunning = true
 			t.lock.Unlock()
-			go t.generateRewardsTree(index)
+			t.scheduler.Go(func(ctx context.Context) {
+				t.generateRewardsTree(ctx, index)
+			})
 
 			// Return after the first request, do others at other intervals
 			return nil
@@ -134,7 +139,7 @@ func (t *generateRewardsTree) run() error {
 	return nil
 }
 
-func (t *generateRewardsTree) generateRewardsTree(index uint64) {
+func (t *generateRewardsTree) generateRewardsTree(ctx context.Context, index uint64) {
 	// Begin generation of the tree
 	generationPrefix := fmt.Sprintf("[Interval %d Tree]", index)
 	t.log.Printlnf("%s Starting generation of Merkle rewards tree for interval %d.", generationPrefix, index)
@@ -204,16 +209,16 @@ func (t *generateRewardsTree) generateRewardsTree(index uint64) {
 	}
 
 	// Generate the tree
-	t.generateRewardsTreeImpl(client, index, generationPrefix, rewardsEvent, elBlockHeader)
+	t.generateRewardsTreeImpl(ctx, client, index, generationPrefix, rewardsEvent, elBlockHeader)
 }
 
 // Implementation for rewards tree generation using a viable EC
-func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool, index uint64, generationPrefix string, rewardsEvent rewards.RewardsEvent, elBlockHeader *types.Header) {
+func (t *generateRewardsTree) generateRewardsTreeImpl(ctx context.Context, rp *rocketpool.RocketPool, index uint64, generationPrefix string, rewardsEvent rewards.RewardsEvent, elBlockHeader *types.Header) {
 
 	// Generate the rewards file
 	start := time.Now()
 	rewardsFile := rprewards.NewRewardsFile(t.log, generationPrefix, index, rewardsEvent.IntervalStartTime, rewardsEvent.IntervalEndTime, rewardsEvent.ConsensusBlock.Uint64(), elBlockHeader, rewardsEvent.IntervalsPassed.Uint64())
-	err := rewardsFile.GenerateTree(rp, t.cfg, t.bc)
+	err := rewardsFile.GenerateTree(ctx, rp, t.cfg, t.bc)
 	if err != nil {
 		t.handleError(fmt.Errorf("%s Error generating Merkle tree: %w", generationPrefix, err))
 		return
@@ -245,6 +250,12 @@ func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool,
 		return
 	}
 
+	// Don't start writing the files if the daemon is shutting down
+	if ctx.Err() != nil {
+		t.handleError(fmt.Errorf("%s The watchtower is shutting down, so the tree was not saved; please request generation for interval %d again.", generationPrefix, index))
+		return
+	}
+
 	// Write the files
 	path := t.cfg.Smartnode.GetRewardsTreePath(index, true)
 	minipoolPerformancePath := t.cfg.Smartnode.GetMinipoolPerformancePath(index, true)
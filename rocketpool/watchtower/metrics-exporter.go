@@ -1,6 +1,7 @@
 package watchtower
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -12,7 +13,8 @@ import (
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *collectors.ScrubCollector) error {
+// Register the metrics handlers on the daemon's HTTP server
+func registerMetrics(c *cli.Context, logger log.ColorLogger, scrubCollector *collectors.ScrubCollector) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -30,10 +32,8 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *co
 	registry.MustRegister(scrubCollector)
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 
-	// Start the HTTP server
-	metricsAddress := c.GlobalString("metricsAddress")
-	metricsPort := c.GlobalUint("metricsPort")
-	logger.Printlnf("Starting metrics exporter on %s:%d.", metricsAddress, metricsPort)
+	// Register the handlers
+	logger.Println("Starting metrics exporter.")
 	metricsPath := "/metrics"
 	http.Handle(metricsPath, handler)
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -46,8 +46,29 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *co
             </html>`,
 		))
 	})
-	err = http.ListenAndServe(fmt.Sprintf("%s:%d", metricsAddress, metricsPort), nil)
-	if err != nil {
+
+	return nil
+
+}
+
+// Run the daemon's HTTP server, which serves the metrics and health endpoints
+func runHttpServer(ctx context.Context, c *cli.Context, logger log.ColorLogger) error {
+
+	address := c.GlobalString("metricsAddress")
+	port := c.GlobalUint("metricsPort")
+	server := &http.Server{
+		Addr: fmt.Sprintf("%s:%d", address, port),
+	}
+
+	// Stop the server when the daemon shuts down
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+
+	logger.Printlnf("Starting HTTP server on %s:%d.", address, port)
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("Error running HTTP server: %w", err)
 	}
 
@@ -27,6 +27,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -43,7 +44,8 @@ type processPenalties struct {
 	cfg            *config.RocketPoolConfig
 	w              *wallet.Wallet
 	rp             *rocketpool.RocketPool
-	ec             rocketpool.ExecutionClient
+	tm             *txmanager.Manager
+	ec             *services.ExecutionClientManager
 	bc             beacon.Client
 	lock           *sync.Mutex
 	isRunning      bool
@@ -76,6 +78,10 @@ func newProcessPenalties(c *cli.Context, logger log.ColorLogger, errorLogger log
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
@@ -117,6 +123,7 @@ func newProcessPenalties(c *cli.Context, logger log.ColorLogger, errorLogger log
 		ec:             ec,
 		bc:             bc,
 		rp:             rp,
+		tm:             tm,
 		lock:           lock,
 		isRunning:      false,
 		maxFee:         maxFee,
@@ -501,7 +508,7 @@ func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.ec)
 		if err != nil {
 			return err
 		}
@@ -522,7 +529,7 @@ func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return err
 	}
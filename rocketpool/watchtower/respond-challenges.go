@@ -10,6 +10,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -22,6 +23,7 @@ type respondChallenges struct {
 	cfg *config.RocketPoolConfig
 	w   *wallet.Wallet
 	rp  *rocketpool.RocketPool
+	tm  *txmanager.Manager
 }
 
 // Create respond to challenges task
@@ -40,6 +42,10 @@ func newRespondChallenges(c *cli.Context, logger log.ColorLogger) (*respondChall
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return task
 	return &respondChallenges{
@@ -48,6 +54,7 @@ func newRespondChallenges(c *cli.Context, logger log.ColorLogger) (*respondChall
 		cfg: cfg,
 		w:   w,
 		rp:  rp,
+		tm:  tm,
 	}, nil
 
 }
@@ -120,7 +127,7 @@ func (t *respondChallenges) run() error {
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return err
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
@@ -48,6 +49,7 @@ type submitNetworkBalances struct {
 	w   *wallet.Wallet
 	ec  rocketpool.ExecutionClient
 	rp  *rocketpool.RocketPool
+	tm  *txmanager.Manager
 	bc  beacon.Client
 }
 
@@ -89,6 +91,10 @@ func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger) (*submitNe
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
@@ -102,6 +108,7 @@ func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger) (*submitNe
 		w:   w,
 		ec:  ec,
 		rp:  rp,
+		tm:  tm,
 		bc:  bc,
 	}, nil
 
@@ -822,7 +829,7 @@ func (t *submitNetworkBalances) submitBalances(balances networkBalances) error {
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return fmt.Errorf("error waiting for transaction: %w", err)
 	}
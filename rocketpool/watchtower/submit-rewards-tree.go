@@ -24,6 +24,8 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -42,15 +44,17 @@ type submitRewardsTree struct {
 	cfg              *config.RocketPoolConfig
 	w                *wallet.Wallet
 	rp               *rocketpool.RocketPool
+	tm               *txmanager.Manager
 	ec               rocketpool.ExecutionClient
 	bc               beacon.Client
 	lock             *sync.Mutex
 	isRunning        bool
 	generationPrefix string
+	scheduler        *tasks.Scheduler
 }
 
 // Create submit rewards Merkle Tree task
-func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitRewardsTree, error) {
+func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, scheduler *tasks.Scheduler) (*submitRewardsTree, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -73,6 +77,10 @@ func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger lo
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 
 	lock := &sync.Mutex{}
 	generator := &submitRewardsTree{
@@ -84,9 +92,11 @@ func newSubmitRewardsTree(c *cli.Context, logger log.ColorLogger, errorLogger lo
 		bc:               bc,
 		w:                w,
 		rp:               rp,
+		tm:               tm,
 		lock:             lock,
 		isRunning:        false,
 		generationPrefix: "[Merkle Tree]",
+		scheduler:        scheduler,
 	}
 
 	return generator, nil
@@ -289,7 +299,7 @@ func (t *submitRewardsTree) isExistingFileValid(rewardsTreePath string, interval
 // Kick off the tree generation goroutine
 func (t *submitRewardsTree) generateTree(intervalsPassed time.Duration, nodeTrusted bool, currentIndex uint64, snapshotBeaconBlock uint64, elBlockIndex uint64, startTime time.Time, endTime time.Time, snapshotElBlockHeader *types.Header, rewardsTreePath string, compressedRewardsTreePath string, minipoolPerformancePath string, compressedMinipoolPerformancePath string) {
 
-	go func() {
+	t.scheduler.Go(func(ctx context.Context) {
 		t.lock.Lock()
 		t.isRunning = true
 		t.lock.Unlock()
@@ -302,7 +312,7 @@ func (t *submitRewardsTree) generateTree(intervalsPassed time.Duration, nodeTrus
 		}
 
 		// Generate the tree
-		err = t.generateTreeImpl(client, intervalsPassed, nodeTrusted, currentIndex, snapshotBeaconBlock, elBlockIndex, startTime, endTime, snapshotElBlockHeader, rewardsTreePath, compressedRewardsTreePath, minipoolPerformancePath, compressedMinipoolPerformancePath)
+		err = t.generateTreeImpl(ctx, client, intervalsPassed, nodeTrusted, currentIndex, snapshotBeaconBlock, elBlockIndex, startTime, endTime, snapshotElBlockHeader, rewardsTreePath, compressedRewardsTreePath, minipoolPerformancePath, compressedMinipoolPerformancePath)
 		if err != nil {
 			t.handleError(err)
 		}
@@ -310,12 +320,12 @@ func (t *submitRewardsTree) generateTree(intervalsPassed time.Duration, nodeTrus
 		t.lock.Lock()
 		t.isRunning = false
 		t.lock.Unlock()
-	}()
+	})
 
 }
 
 // Implementation for rewards tree generation using a viable EC
-func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, intervalsPassed time.Duration, nodeTrusted bool, currentIndex uint64, snapshotBeaconBlock uint64, elBlockIndex uint64, startTime time.Time, endTime time.Time, snapshotElBlockHeader *types.Header, rewardsTreePath string, compressedRewardsTreePath string, minipoolPerformancePath string, compressedMinipoolPerformancePath string) error {
+func (t *submitRewardsTree) generateTreeImpl(ctx context.Context, rp *rocketpool.RocketPool, intervalsPassed time.Duration, nodeTrusted bool, currentIndex uint64, snapshotBeaconBlock uint64, elBlockIndex uint64, startTime time.Time, endTime time.Time, snapshotElBlockHeader *types.Header, rewardsTreePath string, compressedRewardsTreePath string, minipoolPerformancePath string, compressedMinipoolPerformancePath string) error {
 
 	// Log
 	if uint64(intervalsPassed) > 1 {
@@ -325,7 +335,7 @@ func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, interval
 
 	// Generate the rewards file
 	rewardsFile := rprewards.NewRewardsFile(t.log, t.generationPrefix, currentIndex, startTime, endTime, snapshotBeaconBlock, snapshotElBlockHeader, uint64(intervalsPassed))
-	err := rewardsFile.GenerateTree(rp, t.cfg, t.bc)
+	err := rewardsFile.GenerateTree(ctx, rp, t.cfg, t.bc)
 	if err != nil {
 		return fmt.Errorf("Error generating Merkle tree: %w", err)
 	}
@@ -333,6 +343,11 @@ func (t *submitRewardsTree) generateTreeImpl(rp *rocketpool.RocketPool, interval
 		t.printMessage(fmt.Sprintf("WARNING: Node %s has invalid network %d assigned! Using 0 (mainnet) instead.", address.Hex(), network))
 	}
 
+	// Don't start writing the files if the daemon is shutting down; the tree will be regenerated on the next run
+	if ctx.Err() != nil {
+		return fmt.Errorf("The watchtower is shutting down, so the tree for interval %d was not saved", currentIndex)
+	}
+
 	// Serialize the minipool performance file
 	minipoolPerformanceBytes, err := json.Marshal(rewardsFile.MinipoolPerformanceFile)
 	if err != nil {
@@ -470,7 +485,7 @@ func (t *submitRewardsTree) submitRewardsSnapshot(index *big.Int, consensusBlock
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return err
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/contracts"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
@@ -68,6 +69,7 @@ type submitRplPrice struct {
 	ec  rocketpool.ExecutionClient
 	w   *wallet.Wallet
 	rp  *rocketpool.RocketPool
+	tm  *txmanager.Manager
 	oio *contracts.OneInchOracle
 	bc  beacon.Client
 }
@@ -92,6 +94,10 @@ func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger) (*submitRplPrice,
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	oio, err := services.GetOneInchOracle(c)
 	if err != nil {
 		return nil, err
@@ -109,6 +115,7 @@ func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger) (*submitRplPrice,
 		ec:  ec,
 		w:   w,
 		rp:  rp,
+		tm:  tm,
 		oio: oio,
 		bc:  bc,
 	}, nil
@@ -379,7 +386,7 @@ func (t *submitRplPrice) submitRplPrice(blockNumber uint64, rplPrice, effectiveR
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return err
 	}
@@ -519,7 +526,7 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 		}
 
 		// Print TX info and wait for it to be mined
-		err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+		err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 		if err != nil {
 			return err
 		}
@@ -24,6 +24,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -44,6 +45,7 @@ type submitScrubMinipools struct {
 	cfg       *config.RocketPoolConfig
 	w         *wallet.Wallet
 	rp        *rocketpool.RocketPool
+	tm        *txmanager.Manager
 	ec        rocketpool.ExecutionClient
 	bc        beacon.Client
 	it        *iterationData
@@ -99,6 +101,10 @@ func newSubmitScrubMinipools(c *cli.Context, logger log.ColorLogger, errorLogger
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
@@ -113,6 +119,7 @@ func newSubmitScrubMinipools(c *cli.Context, logger log.ColorLogger, errorLogger
 		cfg:       cfg,
 		w:         w,
 		rp:        rp,
+		tm:        tm,
 		ec:        ec,
 		bc:        bc,
 		coll:      coll,
@@ -646,7 +653,7 @@ func (t *submitScrubMinipools) submitVoteScrubMinipool(mp *minipool.Minipool) er
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return err
 	}
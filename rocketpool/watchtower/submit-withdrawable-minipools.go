@@ -19,6 +19,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth2"
@@ -36,6 +37,7 @@ type submitWithdrawableMinipools struct {
 	cfg *config.RocketPoolConfig
 	w   *wallet.Wallet
 	rp  *rocketpool.RocketPool
+	tm  *txmanager.Manager
 	bc  beacon.Client
 }
 
@@ -63,6 +65,10 @@ func newSubmitWithdrawableMinipools(c *cli.Context, logger log.ColorLogger) (*su
 	if err != nil {
 		return nil, err
 	}
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
@@ -75,6 +81,7 @@ func newSubmitWithdrawableMinipools(c *cli.Context, logger log.ColorLogger) (*su
 		cfg: cfg,
 		w:   w,
 		rp:  rp,
+		tm:  tm,
 		bc:  bc,
 	}, nil
 
@@ -385,7 +392,7 @@ func (t *submitWithdrawableMinipools) submitWithdrawableMinipool(details minipoo
 	}
 
 	// Print TX info and wait for it to be mined
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.tm, t.log)
 	if err != nil {
 		return err
 	}
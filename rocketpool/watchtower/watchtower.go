@@ -1,8 +1,8 @@
 package watchtower
 
 import (
+	"context"
 	"fmt"
-	"math/rand"
 	"net/http"
 	"sync"
 	"time"
@@ -12,6 +12,10 @@ import (
 
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/health"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
@@ -36,6 +40,8 @@ const (
 	SubmitRewardsTreeColor           = color.FgHiCyan
 	WarningColor                     = color.FgYellow
 	ProcessPenaltiesColor            = color.FgHiMagenta
+	CheckOdaoProposalsColor          = color.FgHiBlue
+	ManageTransactionsColor          = color.FgHiWhite
 )
 
 // Register watchtower command
@@ -56,16 +62,66 @@ func run(c *cli.Context) error {
 	// Configure
 	configureHTTP()
 
+	// Initialize error logger
+	errorLog := log.NewColorLogger(ErrorColor)
+
+	// Get the shutdown context and start the HTTP server so the health endpoints are available while waiting
+	ctx, cancel := tasks.NewShutdownContext(log.NewColorLogger(WarningColor))
+	defer cancel()
+	monitor := health.NewMonitor(ctx, c)
+	monitor.RegisterHandlers(http.DefaultServeMux)
+
+	// Wait group to handle the various threads
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+
+	// Run the HTTP server
+	go func() {
+		err := runHttpServer(ctx, c, log.NewColorLogger(MetricsColor))
+		if err != nil {
+			errorLog.Println(err)
+		}
+		wg.Done()
+	}()
+
 	// Wait until node is registered
-	if err := services.WaitNodeRegistered(c, true); err != nil {
+	monitor.SetStage(health.StageWaitingRegistration)
+	if err := tasks.WaitFor(ctx, func() error {
+		return services.WaitNodeRegistered(c, true)
+	}); err != nil {
+		if ctx.Err() != nil {
+			wg.Wait()
+			return nil
+		}
+		return err
+	}
+
+	// Set up notifications
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	notifier := notifications.NewNotifier(cfg, "watchtower", log.NewColorLogger(WarningColor))
+	if notifier.IsEnabled() {
+		if err := setFailoverHandlers(c, notifier); err != nil {
+			return err
+		}
+	}
+
+	// Record the watchtower's transactions and replace them automatically if they get stuck
+	tm, err := services.GetTransactionManager(c)
+	if err != nil {
 		return err
 	}
+	tm.SetSource(txmanager.Source_Watchtower)
+	tm.EnableAutoReplace(services.GetAutoReplaceMaxFee(cfg))
+	manageTransactionsLog := log.NewColorLogger(ManageTransactionsColor)
 
 	// Initialize the scrub metrics reporter
 	scrubCollector := collectors.NewScrubCollector()
 
-	// Initialize error logger
-	errorLog := log.NewColorLogger(ErrorColor)
+	// Get the task scheduler
+	scheduler := tasks.NewScheduler(ctx, log.NewColorLogger(WarningColor), errorLog, taskCooldown)
 
 	// Initialize tasks
 	respondChallenges, err := newRespondChallenges(c, log.NewColorLogger(RespondChallengesColor))
@@ -96,7 +152,7 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during scrub check: %w", err)
 	}
-	submitRewardsTree, err := newSubmitRewardsTree(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog)
+	submitRewardsTree, err := newSubmitRewardsTree(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, scheduler)
 	if err != nil {
 		return fmt.Errorf("error during rewards tree check: %w", err)
 	}
@@ -104,115 +160,90 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during penalties check: %w", err)
 	}*/
-	generateRewardsTree, err := newGenerateRewardsTree(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog)
+	generateRewardsTree, err := newGenerateRewardsTree(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, scheduler)
 	if err != nil {
 		return fmt.Errorf("error during manual tree generation check: %w", err)
 	}
+	checkOdaoProposals, err := newCheckOdaoProposals(c, log.NewColorLogger(CheckOdaoProposalsColor), notifier)
+	if err != nil {
+		return fmt.Errorf("error during oDAO proposals check: %w", err)
+	}
 
-	intervalDelta := maxTasksInterval - minTasksInterval
-	secondsDelta := intervalDelta.Seconds()
+	// Check the EC and BC status before running tasks
+	scheduler.SetPrecondition(func() error {
+		// Force refresh the primary / fallback EC status
+		if err := services.WaitEthClientSynced(c, false); err != nil {
+			return err
+		}
+		// Force refresh the primary / fallback BC status
+		return services.WaitBeaconClientSynced(c, false)
+	})
 
-	// Wait group to handle the various threads
-	wg := new(sync.WaitGroup)
-	wg.Add(2)
+	// Register tasks; the intervals are randomized between the min and max
+	addTask := func(name string, run func() error) {
+		scheduler.Add(tasks.New(tasks.Settings{
+			Name:     name,
+			Interval: minTasksInterval,
+			Jitter:   maxTasksInterval - minTasksInterval,
+			Enabled:  true,
+		}, func(ctx context.Context) error {
+			return run()
+		}))
+	}
+	addTask("manual rewards tree generation", generateRewardsTree.run)
+	addTask("respond to challenges", respondChallenges.run)
+	addTask("submit rewards tree", submitRewardsTree.run)
+	addTask("submit RPL price", submitRplPrice.run)
+	addTask("submit network balances", submitNetworkBalances.run)
+	addTask("submit withdrawable minipools", submitWithdrawableMinipools.run)
+	addTask("dissolve timed-out minipools", dissolveTimedOutMinipools.run)
+	addTask("process withdrawals", processWithdrawals.run)
+	addTask("submit scrub minipools", submitScrubMinipools.run)
+	addTask("replace stuck transactions", func() error {
+		return tm.ReplaceStuckTransactions(ctx, manageTransactionsLog)
+	})
+	if notifier.IsEnabled() {
+		addTask("check oDAO proposals", checkOdaoProposals.run)
+	}
+	// DISABLED until MEV-Boost can support it
+	//addTask("process penalties", processPenalties.run)
 
-	// Run task loop
-	go func() {
-		for {
-			// Randomize the next interval
-			randomSeconds := rand.Intn(int(secondsDelta))
-			interval := time.Duration(randomSeconds)*time.Second + minTasksInterval
-
-			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
-			if err != nil {
-				errorLog.Println(err)
-			} else {
-				// Check the BC status
-				err := services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
-				if err != nil {
-					errorLog.Println(err)
-				} else {
-					// Run the manual rewards tree generation
-					if err := generateRewardsTree.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the challenge check
-					if err := respondChallenges.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the rewards tree submission check
-					if err := submitRewardsTree.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the price submission check
-					if err := submitRplPrice.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the network balance submission check
-					if err := submitNetworkBalances.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the withdrawable status submission check
-					if err := submitWithdrawableMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the minipool dissolve check
-					if err := dissolveTimedOutMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the withdrawal processing check
-					if err := processWithdrawals.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the minipool scrub check
-					if err := submitScrubMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
-					/*time.Sleep(taskCooldown)
-
-					// Run the fee recipient penalty check
-					if err := processPenalties.run(); err != nil {
-						errorLog.Println(err)
-					}*/
-					// DISABLED until MEV-Boost can support it
-				}
-			}
-			time.Sleep(interval)
-		}
-		wg.Done()
-	}()
+	// Report the task statuses in the health endpoints
+	monitor.SetScheduler(scheduler)
+	monitor.SetStage(health.StageRunning)
+
+	// Register the metrics handlers
+	if err := registerMetrics(c, log.NewColorLogger(MetricsColor), scrubCollector); err != nil {
+		errorLog.Println(err)
+	}
 
-	// Run metrics loop
+	// Run task loop
+	wg.Add(1)
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector)
-		if err != nil {
-			errorLog.Println(err)
-		}
+		scheduler.Run()
 		wg.Done()
 	}()
 
-	// Wait for both threads to stop
+	// Wait for the threads to stop
 	wg.Wait()
 	return nil
 }
 
+// Notify the operator when the EC or BC fails over to its fallback
+func setFailoverHandlers(c *cli.Context, notifier *notifications.Notifier) error {
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return err
+	}
+	ec.SetFailoverHandler(notifier.GetFailoverHandler("ec-failover"))
+	bc.SetFailoverHandler(notifier.GetFailoverHandler("bc-failover"))
+	return nil
+}
+
 // Configure HTTP transport settings
 func configureHTTP() {
 
@@ -0,0 +1,334 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Config
+const (
+	ArchiveVersion   uint   = 1
+	FileMode                = 0600
+	ManifestFilename string = "manifest.json"
+
+	kdfFunction    string = "scrypt"
+	kdfN           int    = 1 << 18
+	kdfR           int    = 8
+	kdfP           int    = 1
+	kdfKeyLength   int    = 32
+	kdfSaltLength  int    = 32
+	cipherFunction string = "aes-256-gcm"
+
+	dataFolderPrefix          string = "data/"
+	minimumPassphraseLength   int    = 12
+	maxUncompressedBackupSize int64  = 256 * 1024 * 1024
+)
+
+// Archive-relative paths of the files that make up a backup
+const (
+	SettingsFile            string = "user-settings.yml"
+	WalletFile              string = "data/wallet"
+	PasswordFile            string = "data/password"
+	CustomKeyFolder         string = "data/custom-keys"
+	CustomKeyPasswordFile   string = "data/custom-key-passwords"
+	ImportedKeyFolder       string = "data/imported-keys"
+	CustomFeeRecipientsFile string = "data/custom-fee-recipients"
+	FeeRecipientFolder      string = "data/validators"
+	WatchtowerStateFile     string = "data/watchtower/state.yml"
+	SlashingProtectionFile  string = "data/slashing-protection.json"
+)
+
+// Describes the contents of a backup archive
+type Manifest struct {
+	Version          uint           `json:"version"`
+	SmartnodeVersion string         `json:"smartnodeVersion"`
+	Network          string         `json:"network"`
+	NodeAddress      common.Address `json:"nodeAddress"`
+	CreatedAt        time.Time      `json:"createdAt"`
+	Files            []string       `json:"files"`
+}
+
+// The unencrypted header of a backup archive; it's authenticated as additional data by the cipher
+type header struct {
+	Version     uint           `json:"version"`
+	NodeAddress common.Address `json:"nodeAddress"`
+	Network     string         `json:"network"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	Kdf         kdfParams      `json:"kdf"`
+	Cipher      cipherParams   `json:"cipher"`
+}
+
+type kdfParams struct {
+	Function string `json:"function"`
+	N        int    `json:"n"`
+	R        int    `json:"r"`
+	P        int    `json:"p"`
+	Salt     []byte `json:"salt"`
+}
+
+type cipherParams struct {
+	Function string `json:"function"`
+	Nonce    []byte `json:"nonce"`
+}
+
+// A backup archive as it's stored on disk
+type archive struct {
+	Header     header `json:"header"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Returns true if the given archive path belongs in the Smartnode's data folder, along with its path relative to that folder
+func GetDataFolderPath(name string) (string, bool) {
+	if strings.HasPrefix(name, dataFolderPrefix) && len(name) > len(dataFolderPrefix) {
+		return strings.TrimPrefix(name, dataFolderPrefix), true
+	}
+	return "", false
+}
+
+// Bundle the provided files into an encrypted, authenticated backup archive and write it to the given path.
+// The keys of the files map are archive-relative paths.
+func Create(archivePath string, passphrase string, manifest Manifest, files map[string][]byte) error {
+
+	// Check the passphrase
+	if len(passphrase) < minimumPassphraseLength {
+		return fmt.Errorf("The backup passphrase must be at least %d characters long", minimumPassphraseLength)
+	}
+
+	// Record the files in the manifest
+	manifest.Version = ArchiveVersion
+	manifest.Files = make([]string, 0, len(files))
+	for name := range files {
+		if name == ManifestFilename {
+			return fmt.Errorf("%s is a reserved backup filename", ManifestFilename)
+		}
+		manifest.Files = append(manifest.Files, name)
+	}
+	sort.Strings(manifest.Files)
+
+	// Serialize the manifest
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing backup manifest: %w", err)
+	}
+
+	// Build the tarball
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := addTarEntry(tarWriter, ManifestFilename, manifestBytes, manifest.CreatedAt); err != nil {
+		return err
+	}
+	for _, name := range manifest.Files {
+		if err := addTarEntry(tarWriter, name, files[name], manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("error finalizing backup tarball: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("error compressing backup tarball: %w", err)
+	}
+
+	// Create the header
+	salt := make([]byte, kdfSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("error generating backup salt: %w", err)
+	}
+	hdr := header{
+		Version:     ArchiveVersion,
+		NodeAddress: manifest.NodeAddress,
+		Network:     manifest.Network,
+		CreatedAt:   manifest.CreatedAt,
+		Kdf: kdfParams{
+			Function: kdfFunction,
+			N:        kdfN,
+			R:        kdfR,
+			P:        kdfP,
+			Salt:     salt,
+		},
+	}
+
+	// Encrypt the tarball
+	aead, err := getCipher(passphrase, hdr.Kdf)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("error generating backup nonce: %w", err)
+	}
+	hdr.Cipher = cipherParams{
+		Function: cipherFunction,
+		Nonce:    nonce,
+	}
+	additionalData, err := json.Marshal(hdr)
+	if err != nil {
+		return fmt.Errorf("error serializing backup header: %w", err)
+	}
+	ciphertext := aead.Seal(nil, nonce, buffer.Bytes(), additionalData)
+
+	// Write the archive
+	archiveBytes, err := json.Marshal(archive{
+		Header:     hdr,
+		Ciphertext: ciphertext,
+	})
+	if err != nil {
+		return fmt.Errorf("error serializing backup archive: %w", err)
+	}
+	if err := ioutil.WriteFile(archivePath, archiveBytes, FileMode); err != nil {
+		return fmt.Errorf("error writing backup archive to %s: %w", archivePath, err)
+	}
+	return nil
+
+}
+
+// Decrypt and authenticate the backup archive at the given path, returning its manifest and files.
+// Nothing is written to disk.
+func Open(archivePath string, passphrase string) (*Manifest, map[string][]byte, error) {
+
+	// Read the archive
+	archiveBytes, err := ioutil.ReadFile(archivePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading backup archive %s: %w", archivePath, err)
+	}
+	var a archive
+	if err := json.Unmarshal(archiveBytes, &a); err != nil {
+		return nil, nil, fmt.Errorf("error deserializing backup archive %s: %w", archivePath, err)
+	}
+	if a.Header.Version != ArchiveVersion {
+		return nil, nil, fmt.Errorf("unsupported backup archive version %d (expected %d)", a.Header.Version, ArchiveVersion)
+	}
+	if a.Header.Kdf.Function != kdfFunction || a.Header.Cipher.Function != cipherFunction {
+		return nil, nil, fmt.Errorf("unsupported backup encryption scheme %s / %s", a.Header.Kdf.Function, a.Header.Cipher.Function)
+	}
+
+	// Only accept the KDF parameters this version writes, so a tampered header can't make key derivation exhaust the machine's memory or CPU
+	kdf := a.Header.Kdf
+	if kdf.N != kdfN || kdf.R != kdfR || kdf.P != kdfP {
+		return nil, nil, fmt.Errorf("unsupported backup key derivation parameters N=%d, r=%d, p=%d", kdf.N, kdf.R, kdf.P)
+	}
+	if len(kdf.Salt) != kdfSaltLength {
+		return nil, nil, fmt.Errorf("backup archive has an invalid salt length %d (expected %d)", len(kdf.Salt), kdfSaltLength)
+	}
+
+	// Decrypt and authenticate it
+	aead, err := getCipher(passphrase, a.Header.Kdf)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(a.Header.Cipher.Nonce) != aead.NonceSize() {
+		return nil, nil, errors.New("backup archive has an invalid nonce")
+	}
+	additionalData, err := json.Marshal(a.Header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error serializing backup header: %w", err)
+	}
+	plaintext, err := aead.Open(nil, a.Header.Cipher.Nonce, a.Ciphertext, additionalData)
+	if err != nil {
+		return nil, nil, errors.New("could not decrypt the backup archive; either the passphrase is incorrect or the archive has been tampered with")
+	}
+
+	// Unpack the tarball
+	gzipReader, err := gzip.NewReader(bytes.NewReader(plaintext))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decompressing backup tarball: %w", err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(io.LimitReader(gzipReader, maxUncompressedBackupSize))
+	files := map[string][]byte{}
+	var manifest *Manifest
+	for {
+		entry, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("error reading backup tarball: %w", err)
+		}
+		name := path.Clean(entry.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, nil, fmt.Errorf("backup contains an invalid file path [%s]", entry.Name)
+		}
+		contents, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading %s from backup tarball: %w", name, err)
+		}
+		if name == ManifestFilename {
+			manifest = new(Manifest)
+			if err := json.Unmarshal(contents, manifest); err != nil {
+				return nil, nil, fmt.Errorf("error deserializing backup manifest: %w", err)
+			}
+			continue
+		}
+		files[name] = contents
+	}
+
+	// Make sure the manifest agrees with the header and contents
+	if manifest == nil {
+		return nil, nil, errors.New("backup archive does not contain a manifest")
+	}
+	if manifest.NodeAddress != a.Header.NodeAddress || manifest.Network != a.Header.Network {
+		return nil, nil, errors.New("backup manifest does not match the archive header")
+	}
+	for _, name := range manifest.Files {
+		if _, exists := files[name]; !exists {
+			return nil, nil, fmt.Errorf("backup manifest lists %s but the archive does not contain it", name)
+		}
+	}
+	if len(manifest.Files) != len(files) {
+		return nil, nil, errors.New("backup archive contains files that are not listed in its manifest")
+	}
+
+	return manifest, files, nil
+
+}
+
+// Derive the archive encryption key from the passphrase and create the cipher
+func getCipher(passphrase string, params kdfParams) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), params.Salt, params.N, params.R, params.P, kdfKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving backup encryption key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating backup cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error creating backup cipher: %w", err)
+	}
+	return aead, nil
+}
+
+// Add a file to the backup tarball
+func addTarEntry(tarWriter *tar.Writer, name string, contents []byte, modTime time.Time) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    FileMode,
+		Size:    int64(len(contents)),
+		ModTime: modTime,
+	})
+	if err != nil {
+		return fmt.Errorf("error adding %s to backup tarball: %w", name, err)
+	}
+	if _, err := tarWriter.Write(contents); err != nil {
+		return fmt.Errorf("error writing %s to backup tarball: %w", name, err)
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ type BeaconClientManager struct {
 	primaryReady    bool
 	fallbackReady   bool
 	ignoreSyncCheck bool
+	failoverHandler FailoverHandler
 }
 
 // This is a signature for a wrapped Beacon client function that only returns an error
@@ -292,6 +293,22 @@ func (m *BeaconClientManager) GetCommitteesForEpoch(epoch *uint64) ([]beacon.Com
 	return result.([]beacon.Committee), nil
 }
 
+// Get the sync committee for an epoch
+func (m *BeaconClientManager) GetSyncCommitteeForEpoch(epoch uint64) ([]uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetSyncCommitteeForEpoch(epoch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]uint64), nil
+}
+
+// Set a function to call when the primary client fails or recovers
+func (m *BeaconClientManager) SetFailoverHandler(handler FailoverHandler) {
+	m.failoverHandler = handler
+}
+
 /// ==================
 /// Internal Functions
 /// ==================
@@ -322,8 +339,14 @@ func (m *BeaconClientManager) CheckStatus() *api.ClientManagerStatus {
 	}
 
 	// Flag the ready clients
+	wasPrimaryReady := m.primaryReady
 	m.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
 	m.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
+	if wasPrimaryReady && !m.primaryReady {
+		m.reportFailover(true, m.fallbackReady, fmt.Sprintf("Primary Beacon client is not ready (%s)", getStatusProblem(status.PrimaryClientStatus)))
+	} else if !wasPrimaryReady && m.primaryReady {
+		m.reportFailover(false, false, "Primary Beacon client is ready again")
+	}
 
 	return status
 
@@ -369,6 +392,7 @@ func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
 				m.primaryReady = false
+				m.reportFailover(true, m.fallbackReady, fmt.Sprintf("Primary Beacon client disconnected (%s)", err.Error()))
 				return m.runFunction0(function)
 			}
 			// If it's a different error, just return it
@@ -411,6 +435,7 @@ func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, e
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
 				m.primaryReady = false
+				m.reportFailover(true, m.fallbackReady, fmt.Sprintf("Primary Beacon client disconnected (%s)", err.Error()))
 				return m.runFunction1(function)
 			}
 			// If it's a different error, just return it
@@ -453,6 +478,7 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
 				m.primaryReady = false
+				m.reportFailover(true, m.fallbackReady, fmt.Sprintf("Primary Beacon client disconnected (%s)", err.Error()))
 				return m.runFunction2(function)
 			}
 			// If it's a different error, just return it
@@ -487,3 +513,10 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 func (m *BeaconClientManager) isDisconnected(err error) bool {
 	return strings.Contains(err.Error(), "dial tcp")
 }
+
+// Tell the failover handler about a change in the primary client's state
+func (m *BeaconClientManager) reportFailover(primaryFailed bool, fallbackReady bool, message string) {
+	if m.failoverHandler != nil {
+		m.failoverHandler(primaryFailed, fallbackReady, message)
+	}
+}
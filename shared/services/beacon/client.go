@@ -26,6 +26,7 @@ type Eth2Config struct {
 	SlotsPerEpoch                uint64
 	SecondsPerEpoch              uint64
 	EpochsPerSyncCommitteePeriod uint64
+	CapellaForkVersion           []byte
 }
 type Eth2DepositContract struct {
 	ChainID uint64
@@ -62,6 +63,8 @@ type BeaconBlock struct {
 	Attestations         []AttestationInfo
 	FeeRecipient         common.Address
 	ExecutionBlockNumber uint64
+	HasSyncAggregate     bool
+	SyncCommitteeBits    bitfield.Bitvector512
 }
 
 type Committee struct {
@@ -114,4 +117,5 @@ type Client interface {
 	Close() error
 	GetEth1DataForEth2Block(blockId string) (Eth1Data, bool, error)
 	GetCommitteesForEpoch(epoch *uint64) ([]Committee, error)
+	GetSyncCommitteeForEpoch(epoch uint64) ([]uint64, error)
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v2/crypto/bls"
 	"github.com/rocket-pool/rocketpool-go/types"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
@@ -32,6 +33,7 @@ const (
 	RequestEth2DepositContractMethod = "/eth/v1/config/deposit_contract"
 	RequestGenesisPath               = "/eth/v1/beacon/genesis"
 	RequestCommitteePath             = "/eth/v1/beacon/states/%s/committees"
+	RequestSyncCommitteePath         = "/eth/v1/beacon/states/%s/sync_committees"
 	RequestFinalityCheckpointsPath   = "/eth/v1/beacon/states/%s/finality_checkpoints"
 	RequestForkPath                  = "/eth/v1/beacon/states/%s/fork"
 	RequestValidatorsPath            = "/eth/v1/beacon/states/%s/validators"
@@ -123,6 +125,7 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 		SlotsPerEpoch:                uint64(eth2Config.Data.SlotsPerEpoch),
 		SecondsPerEpoch:              uint64(eth2Config.Data.SecondsPerSlot * eth2Config.Data.SlotsPerEpoch),
 		EpochsPerSyncCommitteePeriod: uint64(eth2Config.Data.EpochsPerSyncCommitteePeriod),
+		CapellaForkVersion:           eth2Config.Data.CapellaForkVersion,
 	}, nil
 
 }
@@ -509,6 +512,12 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 		beaconBlock.ExecutionBlockNumber = uint64(block.Data.Message.Body.ExecutionPayload.BlockNumber)
 	}
 
+	// Sync aggregates only exist after Altair
+	if block.Data.Message.Body.SyncAggregate != nil {
+		beaconBlock.HasSyncAggregate = true
+		beaconBlock.SyncCommitteeBits = bitfield.Bitvector512(block.Data.Message.Body.SyncAggregate.SyncCommitteeBits)
+	}
+
 	// Add attestation info
 	for i, attestation := range block.Data.Message.Body.Attestations {
 		bitString := hexutil.RemovePrefix(attestation.AggregationBits)
@@ -789,6 +798,26 @@ func (c *StandardHttpClient) getCommittees(stateId string, epoch *uint64) (Commi
 	return committees, nil
 }
 
+// Get the validator indices of the sync committee for the given epoch, in committee order
+func (c *StandardHttpClient) GetSyncCommitteeForEpoch(epoch uint64) ([]uint64, error) {
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestSyncCommitteePath, "head") + fmt.Sprintf("?epoch=%d", epoch))
+	if err != nil {
+		return nil, fmt.Errorf("Could not get sync committee: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get sync committee: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var response SyncCommitteeResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode sync committee: %w", err)
+	}
+	validators := make([]uint64, len(response.Data.Validators))
+	for i, validator := range response.Data.Validators {
+		validators[i] = uint64(validator)
+	}
+	return validators, nil
+}
+
 // Make a GET request to the beacon node
 func (c *StandardHttpClient) getRequest(requestPath string) ([]byte, int, error) {
 
@@ -29,9 +29,10 @@ type SyncStatusResponse struct {
 }
 type Eth2ConfigResponse struct {
 	Data struct {
-		SecondsPerSlot               uinteger `json:"SECONDS_PER_SLOT"`
-		SlotsPerEpoch                uinteger `json:"SLOTS_PER_EPOCH"`
-		EpochsPerSyncCommitteePeriod uinteger `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		SecondsPerSlot               uinteger  `json:"SECONDS_PER_SLOT"`
+		SlotsPerEpoch                uinteger  `json:"SLOTS_PER_EPOCH"`
+		EpochsPerSyncCommitteePeriod uinteger  `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		CapellaForkVersion           byteArray `json:"CAPELLA_FORK_VERSION"`
 	} `json:"data"`
 }
 type Eth2DepositContractResponse struct {
@@ -81,7 +82,10 @@ type BeaconBlockResponse struct {
 					DepositCount uinteger  `json:"deposit_count"`
 					BlockHash    byteArray `json:"block_hash"`
 				} `json:"eth1_data"`
-				Attestations     []Attestation `json:"attestations"`
+				Attestations  []Attestation `json:"attestations"`
+				SyncAggregate *struct {
+					SyncCommitteeBits byteArray `json:"sync_committee_bits"`
+				} `json:"sync_aggregate"`
 				ExecutionPayload *struct {
 					FeeRecipient byteArray `json:"fee_recipient"`
 					BlockNumber  uinteger  `json:"block_number"`
@@ -133,6 +137,12 @@ type Committee struct {
 	Validators []uinteger `json:"validators"`
 }
 
+type SyncCommitteeResponse struct {
+	Data struct {
+		Validators []uinteger `json:"validators"`
+	} `json:"data"`
+}
+
 type Attestation struct {
 	AggregationBits string `json:"aggregation_bits"`
 	Data            struct {
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli"
+)
+
+// Per-call settings for API commands run by the long-running API server
+type CallSettings struct {
+	IgnoreSyncCheck bool
+	ForceFallbacks  bool
+	MaxFee          float64
+	MaxPriorityFee  float64
+	GasLimit        uint64
+}
+
+// The client manager flags that ApplyCallSettings resets, saved so they can be restored
+type ClientManagerState struct {
+	ec *clientManagerFlags
+	bc *clientManagerFlags
+}
+type clientManagerFlags struct {
+	ignoreSyncCheck bool
+	primaryReady    bool
+	fallbackReady   bool
+}
+
+// The modification times of the wallet files when the wallet was last loaded
+var walletFileTimes []time.Time
+
+// Held while an API command runs in-process with its own call settings
+var callSettingsLock sync.Mutex
+
+// Run an API command in-process with its own call settings, restoring the services' settings afterwards.
+// Only one command runs at a time, and work wrapped in HoldCallSettings waits for it to finish.
+func RunWithCallSettings(c *cli.Context, settings CallSettings, run func()) error {
+	callSettingsLock.Lock()
+	defer callSettingsLock.Unlock()
+
+	state := GetClientManagerState()
+	defer RestoreClientManagerState(state)
+	if err := ApplyCallSettings(c, settings); err != nil {
+		return err
+	}
+
+	run()
+	return ApplyCallSettings(c, CallSettings{})
+}
+
+// Run work that uses the shared services, without an API command changing their settings in the meantime
+func HoldCallSettings(work func()) {
+	callSettingsLock.Lock()
+	defer callSettingsLock.Unlock()
+	work()
+}
+
+// Apply the settings for an API call to the services that have already been created.
+// The wallet is reloaded if its files have changed since it was loaded, so changes made by other processes are picked up.
+func ApplyCallSettings(c *cli.Context, settings CallSettings) error {
+
+	cfg, err := getConfig(c)
+	if err != nil {
+		return err
+	}
+
+	// Check if the wallet needs to be reloaded
+	fileTimes := []time.Time{
+		getModTime(os.ExpandEnv(cfg.Smartnode.GetWalletPath())),
+		getModTime(os.ExpandEnv(cfg.Smartnode.GetPasswordPath())),
+		getModTime(os.ExpandEnv(cfg.Smartnode.GetOfflineNodeAddressPath())),
+	}
+	nodeWalletLock.Lock()
+	defer nodeWalletLock.Unlock()
+	if nodeWallet == nil || !timesEqual(fileTimes, walletFileTimes) {
+		nodeWallet = nil
+		walletFileTimes = fileTimes
+	} else {
+		maxFee, maxPriorityFee := getGasSettings(cfg, settings.MaxFee, settings.MaxPriorityFee)
+		nodeWallet.SetGasSettings(maxFee, maxPriorityFee, settings.GasLimit)
+	}
+
+	// Reset the client managers to the state a new process would start with
+	if ecManager != nil {
+		ecManager.ignoreSyncCheck = settings.IgnoreSyncCheck
+		ecManager.primaryReady = !settings.ForceFallbacks
+		ecManager.fallbackReady = ecManager.fallbackEc != nil
+	}
+	if bcManager != nil {
+		bcManager.ignoreSyncCheck = settings.IgnoreSyncCheck
+		bcManager.primaryReady = !settings.ForceFallbacks
+		bcManager.fallbackReady = bcManager.fallbackBc != nil
+	}
+
+	return nil
+
+}
+
+// Get the current state of the client managers, so it can be restored after running API commands in the same process
+func GetClientManagerState() ClientManagerState {
+	state := ClientManagerState{}
+	if ecManager != nil {
+		state.ec = &clientManagerFlags{
+			ignoreSyncCheck: ecManager.ignoreSyncCheck,
+			primaryReady:    ecManager.primaryReady,
+			fallbackReady:   ecManager.fallbackReady,
+		}
+	}
+	if bcManager != nil {
+		state.bc = &clientManagerFlags{
+			ignoreSyncCheck: bcManager.ignoreSyncCheck,
+			primaryReady:    bcManager.primaryReady,
+			fallbackReady:   bcManager.fallbackReady,
+		}
+	}
+	return state
+}
+
+// Restore the client managers to a state saved with GetClientManagerState
+func RestoreClientManagerState(state ClientManagerState) {
+	if ecManager != nil && state.ec != nil {
+		ecManager.ignoreSyncCheck = state.ec.ignoreSyncCheck
+		ecManager.primaryReady = state.ec.primaryReady
+		ecManager.fallbackReady = state.ec.fallbackReady
+	}
+	if bcManager != nil && state.bc != nil {
+		bcManager.ignoreSyncCheck = state.bc.ignoreSyncCheck
+		bcManager.primaryReady = state.bc.primaryReady
+		bcManager.fallbackReady = state.bc.fallbackReady
+	}
+}
+
+// Get the modification time of a file, or the zero time if it doesn't exist
+func getModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// Check if two lists of times are the same
+func timesEqual(a []time.Time, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,333 @@
+package config
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Defaults
+const (
+	defaultNotificationsSmtpPort             uint16  = 587
+	defaultNotificationsLowEthBalance        float64 = 0.05
+	defaultNotificationsDissolveWarningHours uint64  = 24
+	defaultNotificationsRepeatIntervalHours  uint64  = 12
+)
+
+// Configuration for operator notifications
+type NotificationsConfig struct {
+	Title string `yaml:"-"`
+
+	// The least important notifications that will be sent
+	MinimumSeverity config.Parameter `yaml:"minimumSeverity,omitempty"`
+
+	// How long to wait before repeating a notification for an unresolved problem
+	RepeatInterval config.Parameter `yaml:"repeatInterval,omitempty"`
+
+	// The node ETH balance below which a warning is sent
+	LowEthBalance config.Parameter `yaml:"lowEthBalance,omitempty"`
+
+	// How long before a prelaunch minipool is dissolved to send a warning
+	DissolveWarningTime config.Parameter `yaml:"dissolveWarningTime,omitempty"`
+
+	// Generic JSON webhook
+	WebhookUrl config.Parameter `yaml:"webhookUrl,omitempty"`
+
+	// Chat webhooks
+	DiscordWebhookUrl config.Parameter `yaml:"discordWebhookUrl,omitempty"`
+	SlackWebhookUrl   config.Parameter `yaml:"slackWebhookUrl,omitempty"`
+	TelegramBotToken  config.Parameter `yaml:"telegramBotToken,omitempty"`
+	TelegramChatID    config.Parameter `yaml:"telegramChatID,omitempty"`
+
+	// Push services
+	NtfyUrl     config.Parameter `yaml:"ntfyUrl,omitempty"`
+	NtfyToken   config.Parameter `yaml:"ntfyToken,omitempty"`
+	GotifyUrl   config.Parameter `yaml:"gotifyUrl,omitempty"`
+	GotifyToken config.Parameter `yaml:"gotifyToken,omitempty"`
+
+	// Email
+	SmtpHost     config.Parameter `yaml:"smtpHost,omitempty"`
+	SmtpPort     config.Parameter `yaml:"smtpPort,omitempty"`
+	SmtpUsername config.Parameter `yaml:"smtpUsername,omitempty"`
+	SmtpPassword config.Parameter `yaml:"smtpPassword,omitempty"`
+	SmtpFrom     config.Parameter `yaml:"smtpFrom,omitempty"`
+	SmtpTo       config.Parameter `yaml:"smtpTo,omitempty"`
+}
+
+// Generates a new notifications config
+func NewNotificationsConfig(cfg *RocketPoolConfig) *NotificationsConfig {
+	daemons := []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower}
+
+	return &NotificationsConfig{
+		Title: "Notification Settings",
+
+		MinimumSeverity: config.Parameter{
+			ID:                   "minimumSeverity",
+			Name:                 "Minimum Severity",
+			Description:          "The least important notifications that should be sent.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.NotificationSeverity_Warning},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Info",
+				Description: "Send everything, including informational notices such as new rewards being available.",
+				Value:       config.NotificationSeverity_Info,
+			}, {
+				Name:        "Warning",
+				Description: "Send warnings about problems that need your attention soon, and critical alerts.",
+				Value:       config.NotificationSeverity_Warning,
+			}, {
+				Name:        "Critical",
+				Description: "Only send alerts about problems that are costing you rewards or may cost you ETH.",
+				Value:       config.NotificationSeverity_Critical,
+			}},
+		},
+
+		RepeatInterval: config.Parameter{
+			ID:                   "repeatInterval",
+			Name:                 "Repeat Interval (Hours)",
+			Description:          "The number of hours to wait before repeating a notification about a problem that hasn't been resolved yet.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultNotificationsRepeatIntervalHours},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		LowEthBalance: config.Parameter{
+			ID:                   "lowEthBalance",
+			Name:                 "Low ETH Balance Threshold",
+			Description:          "Send a warning when your node wallet's ETH balance drops below this amount, since it won't be able to pay for gas.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultNotificationsLowEthBalance},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		DissolveWarningTime: config.Parameter{
+			ID:                   "dissolveWarningTime",
+			Name:                 "Dissolve Warning Time (Hours)",
+			Description:          "Send an alert when a prelaunch minipool will be dissolved within this many hours if it isn't staked.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultNotificationsDissolveWarningHours},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WebhookUrl: config.Parameter{
+			ID:                   "webhookUrl",
+			Name:                 "Webhook URL",
+			Description:          "(Optional) A URL that each notification will be sent to as a JSON object in a POST request.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		DiscordWebhookUrl: config.Parameter{
+			ID:                   "discordWebhookUrl",
+			Name:                 "Discord Webhook URL",
+			Description:          "(Optional) The URL of a Discord channel webhook to send notifications to.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SlackWebhookUrl: config.Parameter{
+			ID:                   "slackWebhookUrl",
+			Name:                 "Slack Webhook URL",
+			Description:          "(Optional) The URL of a Slack incoming webhook to send notifications to.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TelegramBotToken: config.Parameter{
+			ID:                   "telegramBotToken",
+			Name:                 "Telegram Bot Token",
+			Description:          "(Optional) The token of the Telegram bot that will send notifications. Requires the chat ID below.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TelegramChatID: config.Parameter{
+			ID:                   "telegramChatID",
+			Name:                 "Telegram Chat ID",
+			Description:          "(Optional) The ID of the Telegram chat the bot should send notifications to.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NtfyUrl: config.Parameter{
+			ID:                   "ntfyUrl",
+			Name:                 "ntfy Topic URL",
+			Description:          "(Optional) The full URL of the ntfy topic to publish notifications to, such as `https://ntfy.sh/my-node-alerts`.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NtfyToken: config.Parameter{
+			ID:                   "ntfyToken",
+			Name:                 "ntfy Access Token",
+			Description:          "(Optional) The access token for the ntfy topic, if it requires one.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		GotifyUrl: config.Parameter{
+			ID:                   "gotifyUrl",
+			Name:                 "Gotify Server URL",
+			Description:          "(Optional) The URL of the Gotify server to send notifications to. Requires the application token below.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		GotifyToken: config.Parameter{
+			ID:                   "gotifyToken",
+			Name:                 "Gotify Application Token",
+			Description:          "(Optional) The token of the Gotify application that notifications will be sent as.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpHost: config.Parameter{
+			ID:                   "smtpHost",
+			Name:                 "SMTP Server",
+			Description:          "(Optional) The hostname of the SMTP server to send notification emails through.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpPort: config.Parameter{
+			ID:                   "smtpPort",
+			Name:                 "SMTP Port",
+			Description:          "The port of the SMTP server.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultNotificationsSmtpPort},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpUsername: config.Parameter{
+			ID:                   "smtpUsername",
+			Name:                 "SMTP Username",
+			Description:          "(Optional) The username to log into the SMTP server with.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpPassword: config.Parameter{
+			ID:                   "smtpPassword",
+			Name:                 "SMTP Password",
+			Description:          "(Optional) The password to log into the SMTP server with.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpFrom: config.Parameter{
+			ID:                   "smtpFrom",
+			Name:                 "Email Sender",
+			Description:          "The address notification emails will be sent from.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpTo: config.Parameter{
+			ID:                   "smtpTo",
+			Name:                 "Email Recipients",
+			Description:          "A comma-separated list of the addresses notification emails will be sent to.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    daemons,
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *NotificationsConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.MinimumSeverity,
+		&cfg.RepeatInterval,
+		&cfg.LowEthBalance,
+		&cfg.DissolveWarningTime,
+		&cfg.WebhookUrl,
+		&cfg.DiscordWebhookUrl,
+		&cfg.SlackWebhookUrl,
+		&cfg.TelegramBotToken,
+		&cfg.TelegramChatID,
+		&cfg.NtfyUrl,
+		&cfg.NtfyToken,
+		&cfg.GotifyUrl,
+		&cfg.GotifyToken,
+		&cfg.SmtpHost,
+		&cfg.SmtpPort,
+		&cfg.SmtpUsername,
+		&cfg.SmtpPassword,
+		&cfg.SmtpFrom,
+		&cfg.SmtpTo,
+	}
+}
+
+// The the title for the config
+func (cfg *NotificationsConfig) GetConfigTitle() string {
+	return cfg.Title
+}
@@ -119,6 +119,10 @@ type RocketPoolConfig struct {
 	EnableMevBoost config.Parameter `yaml:"enableMevBoost,omitempty"`
 	MevBoost       *MevBoostConfig  `yaml:"mevBoost,omitempty"`
 
+	// Notifications
+	EnableNotifications config.Parameter     `yaml:"enableNotifications,omitempty"`
+	Notifications       *NotificationsConfig `yaml:"notifications,omitempty"`
+
 	// Addons
 	GraffitiWallWriter addontypes.SmartnodeAddon `yaml:"addon-gww,omitempty"`
 }
@@ -138,6 +142,13 @@ func LoadFromFile(path string) (*RocketPoolConfig, error) {
 		return nil, fmt.Errorf("could not read Rocket Pool settings file at %s: %w", shellescape.Quote(path), err)
 	}
 
+	return LoadFromBytes(configBytes, filepath.Dir(path))
+
+}
+
+// Load a configuration from the contents of a settings file in the provided directory
+func LoadFromBytes(configBytes []byte, rpDir string) (*RocketPoolConfig, error) {
+
 	// Attempt to parse it out into a settings map
 	var settings map[string]map[string]string
 	if err := yaml.Unmarshal(configBytes, &settings); err != nil {
@@ -145,8 +156,8 @@ func LoadFromFile(path string) (*RocketPoolConfig, error) {
 	}
 
 	// Deserialize it into a config object
-	cfg := NewRocketPoolConfig(filepath.Dir(path), false)
-	err = cfg.Deserialize(settings)
+	cfg := NewRocketPoolConfig(rpDir, false)
+	err := cfg.Deserialize(settings)
 	if err != nil {
 		return nil, fmt.Errorf("could not deserialize settings file: %w", err)
 	}
@@ -421,6 +432,18 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 			CanBeBlank:           false,
 			OverwriteOnUpgrade:   false,
 		},
+
+		EnableNotifications: config.Parameter{
+			ID:                   "enableNotifications",
+			Name:                 "Enable Notifications",
+			Description:          "Send alerts about problems with your node, such as a low ETH balance or a minipool that is about to be dissolved, to the services of your choice.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
 	}
 
 	// Set the defaults for choices
@@ -449,6 +472,7 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 	cfg.BitflyNodeMetrics = NewBitflyNodeMetricsConfig(cfg)
 	cfg.Native = NewNativeConfig(cfg)
 	cfg.MevBoost = NewMevBoostConfig(cfg)
+	cfg.Notifications = NewNotificationsConfig(cfg)
 
 	// Addons
 	cfg.GraffitiWallWriter = addons.NewGraffitiWallWriter()
@@ -515,6 +539,7 @@ func (cfg *RocketPoolConfig) GetParameters() []*config.Parameter {
 		&cfg.ExporterMetricsPort,
 		&cfg.WatchtowerMetricsPort,
 		&cfg.EnableMevBoost,
+		&cfg.EnableNotifications,
 	}
 }
 
@@ -543,6 +568,7 @@ func (cfg *RocketPoolConfig) GetSubconfigs() map[string]config.Config {
 		"bitflyNodeMetrics":  cfg.BitflyNodeMetrics,
 		"native":             cfg.Native,
 		"mevBoost":           cfg.MevBoost,
+		"notifications":      cfg.Notifications,
 		"addons-gww":         cfg.GraffitiWallWriter.GetConfig(),
 	}
 }
@@ -1085,6 +1111,11 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		}
 	}
 
+	// The Docker containers can't get the password from the host's credentials, keyring, environment or file descriptors
+	if err := cfg.Smartnode.CheckPasswordBackend(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
 	return errors
 }
 
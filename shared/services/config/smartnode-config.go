@@ -30,10 +30,21 @@ const (
 	SecondaryRewardsFileUrl            string = "https://ipfs.io/ipfs/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	ProposerConfigFilename             string = "rp-proposer-config.json"
+	CustomFeeRecipientsFilename        string = "custom-fee-recipients"
+	FeeRecipientAuditStateFilename     string = "fee-recipient-audit.yml"
+	FeeRecipientAuditLogFilename       string = "fee-recipient-audit.log"
+	ValidatorPerformanceFilename       string = "validator-performance.json"
+	PasswordAgentSocketFilename        string = "password.sock"
+	ApiSocketFilename                  string = "api.sock"
+	TransactionJournalFilename         string = "transactions.json"
+	TransactionQueueFilename           string = "transaction-queue.json"
+	OfflineNodeAddressFilename         string = "offline-node-address"
 )
 
 // Defaults
 const defaultProjectName string = "rocketpool"
+const defaultPasswordSource string = "rocketpool-password"
 
 // Configuration for the Smartnode
 type SmartnodeConfig struct {
@@ -52,6 +63,18 @@ type SmartnodeConfig struct {
 	// The path of the data folder where everything is stored
 	DataPath config.Parameter `yaml:"dataPath,omitempty"`
 
+	// Where the node password is stored
+	PasswordBackend config.Parameter `yaml:"passwordBackend,omitempty"`
+
+	// The backend-specific name of the node password
+	PasswordSource config.Parameter `yaml:"passwordSource,omitempty"`
+
+	// The URL of the validator client's Keymanager API
+	KeymanagerApiUrl config.Parameter `yaml:"keymanagerApiUrl,omitempty"`
+
+	// The path of the validator client's Keymanager API token
+	KeymanagerApiTokenPath config.Parameter `yaml:"keymanagerApiTokenPath,omitempty"`
+
 	// The path of the watchtower's persistent state storage
 	WatchtowerStatePath config.Parameter `yaml:"watchtowerStatePath"`
 
@@ -61,15 +84,48 @@ type SmartnodeConfig struct {
 	// Which network we're on
 	Network config.Parameter `yaml:"network,omitempty"`
 
+	// Where gas fee suggestions come from
+	FeeOracle config.Parameter `yaml:"feeOracle,omitempty"`
+
 	// Manual max fee override
 	ManualMaxFee config.Parameter `yaml:"manualMaxFee,omitempty"`
 
 	// Manual priority fee override
 	PriorityFee config.Parameter `yaml:"priorityFee,omitempty"`
 
+	// The most to pay when replacing stuck automatic transactions
+	AutoReplaceMaxFee config.Parameter `yaml:"autoReplaceMaxFee,omitempty"`
+
 	// Threshold for auto minipool stakes
 	MinipoolStakeGasThreshold config.Parameter `yaml:"minipoolStakeGasThreshold,omitempty"`
 
+	// Toggle for automatically claiming rewards
+	AutoClaimEnabled config.Parameter `yaml:"autoClaimEnabled,omitempty"`
+
+	// Minimum amount of RPL to claim automatically
+	AutoClaimMinRpl config.Parameter `yaml:"autoClaimMinRpl,omitempty"`
+
+	// Minimum amount of ETH to claim automatically
+	AutoClaimMinEth config.Parameter `yaml:"autoClaimMinEth,omitempty"`
+
+	// Threshold for automatic rewards claims
+	AutoClaimGasThreshold config.Parameter `yaml:"autoClaimGasThreshold,omitempty"`
+
+	// How much of the claimed RPL to restake automatically
+	AutoRestakeMode config.Parameter `yaml:"autoRestakeMode,omitempty"`
+
+	// The collateral ratio to restake up to
+	AutoRestakeCollateralTarget config.Parameter `yaml:"autoRestakeCollateralTarget,omitempty"`
+
+	// The fraction of claimed RPL to restake
+	AutoRestakeFraction config.Parameter `yaml:"autoRestakeFraction,omitempty"`
+
+	// The fee distributor balance that triggers an automatic distribution
+	DistributeThreshold config.Parameter `yaml:"distributeThreshold,omitempty"`
+
+	// Threshold for automatic fee distributions
+	DistributeGasThreshold config.Parameter `yaml:"distributeGasThreshold,omitempty"`
+
 	// Mode for acquiring Merkle rewards trees
 	RewardsTreeMode config.Parameter `yaml:"rewardsTreeMode,omitempty"`
 
@@ -113,6 +169,9 @@ type SmartnodeConfig struct {
 	// The contract address of rETH
 	rethAddress map[config.Network]string `yaml:"-"`
 
+	// The Beacon Chain's genesis fork version, used to check deposits signed offline
+	genesisForkVersion map[config.Network]string `yaml:"-"`
+
 	// The contract address of rocketRewardsPool from v1.0.0
 	legacyRewardsPoolAddress map[config.Network]string `yaml:"-"`
 
@@ -166,6 +225,79 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		PasswordBackend: config.Parameter{
+			ID:                   "passwordBackend",
+			Name:                 "Password Backend",
+			Description:          "Select where the Smartnode gets the password for your node wallet from.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.PasswordBackend_File},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "File",
+				Description: "Store the password in a plain file named `password` in your data folder. This is the default.",
+				Value:       config.PasswordBackend_File,
+			}, {
+				Name:        "systemd Credential",
+				Description: "Read the password from an encrypted systemd credential (see `systemd-creds`). The Smartnode services must load it with `LoadCredentialEncrypted=`. Only available in Native mode.",
+				Value:       config.PasswordBackend_SystemdCreds,
+			}, {
+				Name:        "Kernel Keyring",
+				Description: "Store the password in the Linux kernel's user keyring. It will not survive a reboot, so you will need to set it again afterwards. Only available in Native mode.",
+				Value:       config.PasswordBackend_Keyring,
+			}, {
+				Name:        "Environment Variable",
+				Description: "Read the password from an environment variable provided by your orchestrator. Only available in Native mode.",
+				Value:       config.PasswordBackend_Env,
+			}, {
+				Name:        "File Descriptor",
+				Description: "Read the password from a file descriptor provided by your orchestrator when the process starts. Only available in Native mode.",
+				Value:       config.PasswordBackend_Fd,
+			}, {
+				Name:        "Interactive Unlock",
+				Description: "Never store the password. The node daemon starts locked and holds the password in memory once you run `rocketpool wallet unlock`; you will need to unlock it again every time the daemon restarts.",
+				Value:       config.PasswordBackend_Interactive,
+			}},
+		},
+
+		PasswordSource: config.Parameter{
+			ID:                   "passwordSource",
+			Name:                 "Password Source",
+			Description:          "The name of the password for the selected password backend: the credential name for systemd, the key name for the kernel keyring, the variable name for an environment variable, or the descriptor number for a file descriptor. Leave it blank to use the default (`rocketpool-password` for systemd and the keyring, `ROCKETPOOL_PASSWORD` for environment variables, and 3 for file descriptors).",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		KeymanagerApiUrl: config.Parameter{
+			ID:                   "keymanagerApiUrl",
+			Name:                 "Keymanager API URL",
+			Description:          "The URL of your Validator Client's standard Keymanager API, such as `http://localhost:5062`. When set, new validator keys and fee recipient changes will be loaded into the Validator Client through this API instead of restarting it. Leave it blank to always restart the Validator Client, which is also used as a fallback if the API can't be reached.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		KeymanagerApiTokenPath: config.Parameter{
+			ID:                   "keymanagerApiTokenPath",
+			Name:                 "Keymanager API Token Path",
+			Description:          "The path of the file containing the bearer token for your Validator Client's Keymanager API. In Docker mode, this must be a path inside the Smartnode's data folder.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
 		WatchtowerStatePath: config.Parameter{
 			ID:                   "watchtowerPath",
 			Name:                 "Watchtower Path",
@@ -208,6 +340,31 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 				}*/},
 		},
 
+		FeeOracle: config.Parameter{
+			ID:                   "feeOracle",
+			Name:                 "Fee Oracle",
+			Description:          "Select where the Smartnode gets its suggested max fees from, both when you send transactions and for its automatic transactions.\n\nThe Execution client suggestions are calculated from the priority fees and base fees of recent blocks, so they work on every network and don't depend on any third-party services.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.FeeOracle_ExecutionClientWithWeb},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Execution Client",
+				Description: "Only use the fee history of your Execution client.",
+				Value:       config.FeeOracle_ExecutionClient,
+			}, {
+				Name:        "Execution Client + Web",
+				Description: "Use the fee history of your Execution client, and fall back to the Etherchain and Etherscan gas oracles if it isn't available.",
+				Value:       config.FeeOracle_ExecutionClientWithWeb,
+			}, {
+				Name:        "Web + Execution Client",
+				Description: "Use the Etherchain and Etherscan gas oracles, and fall back to the fee history of your Execution client if they aren't available.\n\n[orange]NOTE: The web oracles only provide suggestions for Mainnet.",
+				Value:       config.FeeOracle_Web,
+			}},
+		},
+
 		ManualMaxFee: config.Parameter{
 			ID:                   "manualMaxFee",
 			Name:                 "Manual Max Fee",
@@ -232,10 +389,22 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		AutoReplaceMaxFee: config.Parameter{
+			ID:                   "autoReplaceMaxFee",
+			Name:                 "Auto Replacement Max Fee",
+			Description:          "When one of the daemons' automatic transactions gets stuck, the Smartnode replaces it with a higher fee. This is the most (in gwei) it will raise the max fee to, unless you've set a Manual Max Fee, which is used as the limit instead.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(150)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		MinipoolStakeGasThreshold: config.Parameter{
 			ID:   "minipoolStakeGasThreshold",
 			Name: "Minipool Stake Gas Threshold",
-			Description: "Once a newly created minipool passes the scrub check and is ready to perform its second 16 ETH deposit (the `stake` transaction), your node will try to do so automatically using the `Rapid` suggestion from the gas estimator as its max fee. This threshold is a limit (in gwei) you can put on that suggestion; your node will not `stake` the new minipool until the suggestion is below this limit.\n\n" +
+			Description: "Once a newly created minipool passes the scrub check and is ready to perform its second 16 ETH deposit (the `stake` transaction), your node will try to do so automatically using the `Fast` suggestion from the Fee Oracle as its max fee. This threshold is a limit (in gwei) you can put on that suggestion; your node will not `stake` the new minipool until the suggestion is below this limit.\n\n" +
 				"Note that to ensure your minipool does not get dissolved, the node will ignore this limit and automatically execute the `stake` transaction at whatever the suggested fee happens to be once too much time has passed since its first deposit (currently 7 days).",
 			Type:                 config.ParameterType_Float,
 			Default:              map[config.Network]interface{}{config.Network_All: float64(150)},
@@ -245,6 +414,127 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		AutoClaimEnabled: config.Parameter{
+			ID:                   "autoClaimEnabled",
+			Name:                 "Enable Automatic Claims",
+			Description:          "Enable this to have your node automatically claim its unclaimed rewards intervals (and optionally restake some of the RPL) once the conditions below are met.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoClaimMinRpl: config.Parameter{
+			ID:                   "autoClaimMinRpl",
+			Name:                 "Automatic Claim Min RPL",
+			Description:          "The node will not automatically claim its rewards until at least this much RPL is claimable. Rewards are also claimed if the ETH minimum is met.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(10)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoClaimMinEth: config.Parameter{
+			ID:                   "autoClaimMinEth",
+			Name:                 "Automatic Claim Min ETH",
+			Description:          "The node will not automatically claim its rewards until at least this much ETH from the Smoothing Pool is claimable. Rewards are also claimed if the RPL minimum is met.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0.1)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoClaimGasThreshold: config.Parameter{
+			ID:                   "autoClaimGasThreshold",
+			Name:                 "Automatic Claim Gas Threshold",
+			Description:          "The node will only automatically claim its rewards when the `Fast` suggestion from the Fee Oracle is below this limit (in gwei).",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(30)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoRestakeMode: config.Parameter{
+			ID:                   "autoRestakeMode",
+			Name:                 "Automatic Restake Mode",
+			Description:          "Select how much of the RPL from automatic claims should be restaked.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.RestakeMode_None},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "None",
+				Description: "Do not restake any RPL; all of it will be sent to your withdrawal address.",
+				Value:       config.RestakeMode_None,
+			}, {
+				Name:        "Collateral Target",
+				Description: "Restake as much RPL as needed to bring your collateral up to the target below. Once your collateral reaches the target, claimed RPL is no longer restaked.",
+				Value:       config.RestakeMode_CollateralTarget,
+			}, {
+				Name:        "Fixed Fraction",
+				Description: "Restake a fixed fraction of the claimed RPL, regardless of your collateral.",
+				Value:       config.RestakeMode_Fraction,
+			}},
+		},
+
+		AutoRestakeCollateralTarget: config.Parameter{
+			ID:                   "autoRestakeCollateralTarget",
+			Name:                 "Automatic Restake Collateral Target",
+			Description:          "When using the `Collateral Target` restake mode, the collateral ratio (in percent of your borrowed ETH) to restake up to. Staking beyond 150% does not earn more rewards.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(150)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoRestakeFraction: config.Parameter{
+			ID:                   "autoRestakeFraction",
+			Name:                 "Automatic Restake Fraction",
+			Description:          "When using the `Fixed Fraction` restake mode, the fraction of the claimed RPL to restake, from 0 to 1.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0.5)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		DistributeThreshold: config.Parameter{
+			ID:                   "distributeThreshold",
+			Name:                 "Auto-Distribute Threshold",
+			Description:          "Once the balance of your node's fee distributor reaches this amount (in ETH), your node will automatically distribute it between you and the rETH holders. Set this to 0 to disable automatic distributions.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		DistributeGasThreshold: config.Parameter{
+			ID:                   "distributeGasThreshold",
+			Name:                 "Auto-Distribute Gas Threshold",
+			Description:          "Your node will only automatically distribute its fee distributor balance when the `Fast` suggestion from the Fee Oracle is below this limit (in gwei).",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(30)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		RewardsTreeMode: config.Parameter{
 			ID:                   "rewardsTreeMode",
 			Name:                 "Rewards Tree Mode",
@@ -346,6 +636,13 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			config.Network_Ropsten: "0x00651FC69eFd13F76fC7dEBC2540F2662A09fa8c",
 		},
 
+		genesisForkVersion: map[config.Network]string{
+			config.Network_Mainnet: "0x00000000",
+			config.Network_Prater:  "0x00001020",
+			config.Network_Kiln:    "0x70000069",
+			config.Network_Ropsten: "0x80000069",
+		},
+
 		legacyRewardsPoolAddress: map[config.Network]string{
 			config.Network_Mainnet: "0xA3a18348e6E2d3897B6f2671bb8c120e36554802",
 			config.Network_Prater:  "0xf9aE18eB0CE4930Bc3d7d1A5E33e4286d4FB0f8B",
@@ -449,9 +746,24 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.Network,
 		&cfg.ProjectName,
 		&cfg.DataPath,
+		&cfg.PasswordBackend,
+		&cfg.PasswordSource,
+		&cfg.KeymanagerApiUrl,
+		&cfg.KeymanagerApiTokenPath,
+		&cfg.FeeOracle,
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
+		&cfg.AutoReplaceMaxFee,
 		&cfg.MinipoolStakeGasThreshold,
+		&cfg.AutoClaimEnabled,
+		&cfg.AutoClaimMinRpl,
+		&cfg.AutoClaimMinEth,
+		&cfg.AutoClaimGasThreshold,
+		&cfg.AutoRestakeMode,
+		&cfg.AutoRestakeCollateralTarget,
+		&cfg.AutoRestakeFraction,
+		&cfg.DistributeThreshold,
+		&cfg.DistributeGasThreshold,
 		&cfg.RewardsTreeMode,
 		&cfg.ArchiveECUrl,
 		&cfg.Web3StorageApiToken,
@@ -488,6 +800,56 @@ func (cfg *SmartnodeConfig) GetPasswordPath() string {
 	return filepath.Join(DaemonDataPath, "password")
 }
 
+func (cfg *SmartnodeConfig) GetPasswordAgentSocketPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), PasswordAgentSocketFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, PasswordAgentSocketFilename)
+}
+
+func (cfg *SmartnodeConfig) GetApiSocketPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), ApiSocketFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, ApiSocketFilename)
+}
+
+func (cfg *SmartnodeConfig) GetPasswordBackend() config.PasswordBackend {
+	backend := cfg.PasswordBackend.Value.(config.PasswordBackend)
+	if backend == config.PasswordBackend_Unknown {
+		return config.PasswordBackend_File
+	}
+	return backend
+}
+
+// Check that the password backend can be used in the Smartnode's mode
+func (cfg *SmartnodeConfig) CheckPasswordBackend() error {
+	if cfg.parent.IsNativeMode {
+		return nil
+	}
+	switch backend := cfg.GetPasswordBackend(); backend {
+	case config.PasswordBackend_SystemdCreds, config.PasswordBackend_Keyring, config.PasswordBackend_Env, config.PasswordBackend_Fd:
+		return fmt.Errorf("The '%s' password backend is only available in Native mode; the Docker containers can't read the password from it. Please select a different password backend.", backend)
+	}
+	return nil
+}
+
+func (cfg *SmartnodeConfig) GetPasswordSource() string {
+	source := cfg.PasswordSource.Value.(string)
+	if source != "" {
+		return source
+	}
+	switch cfg.GetPasswordBackend() {
+	case config.PasswordBackend_Env:
+		return "ROCKETPOOL_PASSWORD"
+	case config.PasswordBackend_Fd:
+		return "3"
+	}
+	return defaultPasswordSource
+}
+
 func (cfg *SmartnodeConfig) GetValidatorKeychainPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "validators")
@@ -577,6 +939,10 @@ func (cfg *SmartnodeConfig) GetRethAddress() common.Address {
 	return common.HexToAddress(cfg.rethAddress[cfg.Network.Value.(config.Network)])
 }
 
+func (cfg *SmartnodeConfig) GetGenesisForkVersion() []byte {
+	return common.FromHex(cfg.genesisForkVersion[cfg.Network.Value.(config.Network)])
+}
+
 func getDefaultDataDir(config *RocketPoolConfig) string {
 	return filepath.Join(config.RocketPoolDirectory, "data")
 }
@@ -621,6 +987,66 @@ func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "validators", NativeFeeRecipientFilename)
 }
 
+func (cfg *SmartnodeConfig) GetProposerConfigFilePath() string {
+	return filepath.Join(cfg.GetValidatorKeychainPath(), ProposerConfigFilename)
+}
+
+func (cfg *SmartnodeConfig) GetCustomFeeRecipientsFilePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), CustomFeeRecipientsFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, CustomFeeRecipientsFilename)
+}
+
+func (cfg *SmartnodeConfig) GetFeeRecipientAuditStatePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), FeeRecipientAuditStateFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, FeeRecipientAuditStateFilename)
+}
+
+func (cfg *SmartnodeConfig) GetFeeRecipientAuditLogPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), FeeRecipientAuditLogFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, FeeRecipientAuditLogFilename)
+}
+
+func (cfg *SmartnodeConfig) GetValidatorPerformancePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), ValidatorPerformanceFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, ValidatorPerformanceFilename)
+}
+
+func (cfg *SmartnodeConfig) GetTransactionJournalPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), TransactionJournalFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, TransactionJournalFilename)
+}
+
+func (cfg *SmartnodeConfig) GetTransactionQueuePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), TransactionQueueFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, TransactionQueueFilename)
+}
+
+func (cfg *SmartnodeConfig) GetOfflineNodeAddressPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), OfflineNodeAddressFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, OfflineNodeAddressFilename)
+}
+
 func (cfg *SmartnodeConfig) GetLegacyRewardsPoolAddress() common.Address {
 	return common.HexToAddress(cfg.legacyRewardsPoolAddress[cfg.Network.Value.(config.Network)])
 }
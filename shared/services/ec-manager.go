@@ -9,11 +9,15 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/fatih/color"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/gas/feehistory"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -25,15 +29,26 @@ type ExecutionClientManager struct {
 	fallbackEcUrl   string
 	primaryEc       *ethclient.Client
 	fallbackEc      *ethclient.Client
+	primaryRpc      *rpc.Client
+	fallbackRpc     *rpc.Client
 	logger          log.ColorLogger
 	primaryReady    bool
 	fallbackReady   bool
 	ignoreSyncCheck bool
+	failoverHandler FailoverHandler
+	resolveContract ContractResolver
+	sentHandler     TransactionSentHandler
 }
 
 // This is a signature for a wrapped ethclient.Client function
 type ecFunction func(*ethclient.Client) (interface{}, error)
 
+// Called when a client manager's primary client fails or recovers
+type FailoverHandler func(primaryFailed bool, fallbackReady bool, message string)
+
+// Called after a client manager sends a transaction, with the error from sending it if there was one
+type TransactionSentHandler func(tx *types.Transaction, err error)
+
 // Creates a new ExecutionClientManager instance based on the Rocket Pool config
 func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientManager, error) {
 
@@ -64,17 +79,20 @@ func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientMa
 		}
 	}
 
-	primaryEc, err := ethclient.Dial(primaryEcUrl)
+	primaryRpc, err := rpc.Dial(primaryEcUrl)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to primary EC at [%s]: %w", primaryEcUrl, err)
 	}
+	primaryEc := ethclient.NewClient(primaryRpc)
 
+	var fallbackRpc *rpc.Client
 	var fallbackEc *ethclient.Client
 	if fallbackEcUrl != "" {
-		fallbackEc, err = ethclient.Dial(fallbackEcUrl)
+		fallbackRpc, err = rpc.Dial(fallbackEcUrl)
 		if err != nil {
 			return nil, fmt.Errorf("error connecting to fallback EC at [%s]: %w", fallbackEcUrl, err)
 		}
+		fallbackEc = ethclient.NewClient(fallbackRpc)
 	}
 
 	return &ExecutionClientManager{
@@ -82,6 +100,8 @@ func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientMa
 		fallbackEcUrl: fallbackEcUrl,
 		primaryEc:     primaryEc,
 		fallbackEc:    fallbackEc,
+		primaryRpc:    primaryRpc,
+		fallbackRpc:   fallbackRpc,
 		logger:        log.NewColorLogger(color.FgYellow),
 		primaryReady:  true,
 		fallbackReady: fallbackEc != nil,
@@ -117,6 +137,17 @@ func (p *ExecutionClientManager) CallContract(ctx context.Context, call ethereum
 	return result.([]byte), err
 }
 
+// PendingCallContract executes an Ethereum contract call against the pending state.
+func (p *ExecutionClientManager) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.PendingCallContract(ctx, call)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), err
+}
+
 /// ============================
 /// ContractTransactor Functions
 /// ============================
@@ -190,6 +221,22 @@ func (p *ExecutionClientManager) SuggestGasTipCap(ctx context.Context) (*big.Int
 	return result.(*big.Int), err
 }
 
+// FeeHistory retrieves the base fees, gas usage ratios and priority fee percentiles of the
+// blockCount blocks ending at lastBlock (or the latest block if lastBlock is nil).
+func (p *ExecutionClientManager) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*feehistory.FeeHistory, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		rpcClient := p.primaryRpc
+		if client == p.fallbackEc {
+			rpcClient = p.fallbackRpc
+		}
+		return getFeeHistory(ctx, rpcClient, blockCount, lastBlock, rewardPercentiles)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*feehistory.FeeHistory), err
+}
+
 // EstimateGas tries to estimate the gas needed to execute a specific
 // transaction based on the current pending state of the backend blockchain.
 // There is no guarantee that this is the true gas limit requirement as other
@@ -200,7 +247,7 @@ func (p *ExecutionClientManager) EstimateGas(ctx context.Context, call ethereum.
 		return client.EstimateGas(ctx, call)
 	})
 	if err != nil {
-		return 0, err
+		return 0, p.preflight(ctx, call, err)
 	}
 	return result.(uint64), err
 }
@@ -210,6 +257,9 @@ func (p *ExecutionClientManager) SendTransaction(ctx context.Context, tx *types.
 	_, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return nil, client.SendTransaction(ctx, tx)
 	})
+	if p.sentHandler != nil {
+		p.sentHandler(tx, err)
+	}
 	return err
 }
 
@@ -328,6 +378,29 @@ func (p *ExecutionClientManager) SyncProgress(ctx context.Context) (*ethereum.Sy
 	return result.(*ethereum.SyncProgress), err
 }
 
+// Set a function to call when the primary client fails or recovers
+func (p *ExecutionClientManager) SetFailoverHandler(handler FailoverHandler) {
+	p.failoverHandler = handler
+}
+
+// Set a function to call after sending a transaction
+func (p *ExecutionClientManager) SetTransactionSentHandler(handler TransactionSentHandler) {
+	p.sentHandler = handler
+}
+
+// Set the resolver used to find the contracts that transactions are sent to when decoding why they would revert
+func (p *ExecutionClientManager) SetContractResolver(resolver ContractResolver) {
+	p.resolveContract = resolver
+}
+
+// Find the name and ABI of the contract at an address, if a resolver has been set
+func (p *ExecutionClientManager) ResolveContract(address common.Address) (string, *abi.ABI) {
+	if p.resolveContract == nil {
+		return "", nil
+	}
+	return p.resolveContract(address)
+}
+
 /// ==================
 /// Internal functions
 /// ==================
@@ -358,8 +431,14 @@ func (p *ExecutionClientManager) CheckStatus() *api.ClientManagerStatus {
 	}
 
 	// Flag the ready clients
+	wasPrimaryReady := p.primaryReady
 	p.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
 	p.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
+	if wasPrimaryReady && !p.primaryReady {
+		p.reportFailover(true, p.fallbackReady, fmt.Sprintf("Primary Execution client is not ready (%s)", getStatusProblem(status.PrimaryClientStatus)))
+	} else if !wasPrimaryReady && p.primaryReady {
+		p.reportFailover(false, false, "Primary Execution client is ready again")
+	}
 
 	return status
 
@@ -433,6 +512,7 @@ func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{},
 				// If it's disconnected, log it and try the fallback
 				p.logger.Printlnf("WARNING: Primary Execution client disconnected (%s), using fallback...", err.Error())
 				p.primaryReady = false
+				p.reportFailover(true, p.fallbackReady, fmt.Sprintf("Primary Execution client disconnected (%s)", err.Error()))
 				return p.runFunction(function)
 			}
 
@@ -470,3 +550,55 @@ func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{},
 func (p *ExecutionClientManager) isDisconnected(err error) bool {
 	return strings.Contains(err.Error(), "dial tcp")
 }
+
+// Tell the failover handler about a change in the primary client's state
+func (p *ExecutionClientManager) reportFailover(primaryFailed bool, fallbackReady bool, message string) {
+	if p.failoverHandler != nil {
+		p.failoverHandler(primaryFailed, fallbackReady, message)
+	}
+}
+
+// The response to eth_feeHistory
+type feeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// Call eth_feeHistory, which the ethclient package doesn't support yet
+func getFeeHistory(ctx context.Context, client *rpc.Client, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*feehistory.FeeHistory, error) {
+	block := "latest"
+	if lastBlock != nil {
+		block = hexutil.EncodeBig(lastBlock)
+	}
+	var result feeHistoryResult
+	if err := client.CallContext(ctx, &result, "eth_feeHistory", hexutil.Uint64(blockCount), block, rewardPercentiles); err != nil {
+		return nil, err
+	}
+
+	history := &feehistory.FeeHistory{
+		OldestBlock:  (*big.Int)(result.OldestBlock),
+		Reward:       make([][]*big.Int, len(result.Reward)),
+		BaseFee:      make([]*big.Int, len(result.BaseFee)),
+		GasUsedRatio: result.GasUsedRatio,
+	}
+	for i, blockRewards := range result.Reward {
+		history.Reward[i] = make([]*big.Int, len(blockRewards))
+		for j, reward := range blockRewards {
+			history.Reward[i][j] = (*big.Int)(reward)
+		}
+	}
+	for i, baseFee := range result.BaseFee {
+		history.BaseFee[i] = (*big.Int)(baseFee)
+	}
+	return history, nil
+}
+
+// Get a description of why a client isn't ready
+func getStatusProblem(status api.ClientStatus) string {
+	if !status.IsWorking {
+		return status.Error
+	}
+	return fmt.Sprintf("syncing, %.2f%% done", status.SyncProgress*100)
+}
@@ -0,0 +1,152 @@
+package feehistory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+// Settings
+const (
+	BlockCount             uint64  = 20
+	SlowPercentile         float64 = 10
+	StandardPercentile     float64 = 50
+	FastPercentile         float64 = 90
+	DefaultPriorityFeeGwei float64 = 1
+)
+
+// The fee history of a range of blocks, as reported by eth_feeHistory
+type FeeHistory struct {
+	OldestBlock  *big.Int
+	Reward       [][]*big.Int
+	BaseFee      []*big.Int
+	GasUsedRatio []float64
+}
+
+// A client that can provide the fee history of recent blocks
+type Client interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*FeeHistory, error)
+}
+
+// A suggested fee for a single speed
+type FeeSuggestion struct {
+	MaxBaseFeeWei  *big.Int `json:"maxBaseFeeWei"`
+	PriorityFeeWei *big.Int `json:"priorityFeeWei"`
+}
+
+type GasFeeSuggestion struct {
+	BaseFeeWei *big.Int      `json:"baseFeeWei"`
+	Slow       FeeSuggestion `json:"slow"`
+	Standard   FeeSuggestion `json:"standard"`
+	Fast       FeeSuggestion `json:"fast"`
+}
+
+// Get gas prices from the fee history of the latest blocks
+func GetGasPrices(client Client) (GasFeeSuggestion, error) {
+
+	// Get the fee history
+	history, err := client.FeeHistory(context.Background(), BlockCount, nil, []float64{SlowPercentile, StandardPercentile, FastPercentile})
+	if err != nil {
+		return GasFeeSuggestion{}, fmt.Errorf("Could not get fee history from the Execution client: %w", err)
+	}
+	if len(history.BaseFee) < 2 {
+		return GasFeeSuggestion{}, fmt.Errorf("The Execution client did not return any fee history")
+	}
+
+	// The last base fee is the one for the next block; the rest are for the blocks in the history
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	meanBaseFee := big.NewInt(0)
+	for _, baseFee := range history.BaseFee[:len(history.BaseFee)-1] {
+		meanBaseFee.Add(meanBaseFee, baseFee)
+	}
+	meanBaseFee.Div(meanBaseFee, big.NewInt(int64(len(history.BaseFee)-1)))
+	if nextBaseFee.Sign() == 0 || meanBaseFee.Sign() == 0 {
+		return GasFeeSuggestion{}, fmt.Errorf("The Execution client's chain does not have an EIP-1559 base fee")
+	}
+
+	suggestion := GasFeeSuggestion{
+		BaseFeeWei: nextBaseFee,
+		Slow: FeeSuggestion{
+			MaxBaseFeeWei:  getSlowMaxBaseFee(nextBaseFee, meanBaseFee),
+			PriorityFeeWei: getPriorityFee(history, 0),
+		},
+		Standard: FeeSuggestion{
+			MaxBaseFeeWei:  getMaxBaseFee(nextBaseFee, meanBaseFee, 2, 1),
+			PriorityFeeWei: getPriorityFee(history, 1),
+		},
+		Fast: FeeSuggestion{
+			MaxBaseFeeWei:  getMaxBaseFee(nextBaseFee, meanBaseFee, 6, 2),
+			PriorityFeeWei: getPriorityFee(history, 2),
+		},
+	}
+
+	// Return
+	return suggestion, nil
+
+}
+
+// Get the max fee for a suggestion, using the provided priority fee if it's higher than the suggested one
+func (s FeeSuggestion) GetMaxFee(priorityFee *big.Int) *big.Int {
+	if priorityFee == nil || priorityFee.Cmp(s.PriorityFeeWei) < 0 {
+		priorityFee = s.PriorityFeeWei
+	}
+	return new(big.Int).Add(s.MaxBaseFeeWei, priorityFee)
+}
+
+// A slow transaction can wait for the base fee to fall back to its recent average, but not by more than one full block's decrease
+func getSlowMaxBaseFee(nextBaseFee *big.Int, meanBaseFee *big.Int) *big.Int {
+	minBaseFee := new(big.Int).Mul(nextBaseFee, big.NewInt(7))
+	minBaseFee.Div(minBaseFee, big.NewInt(8))
+	if meanBaseFee.Cmp(nextBaseFee) >= 0 {
+		return new(big.Int).Set(nextBaseFee)
+	}
+	if meanBaseFee.Cmp(minBaseFee) < 0 {
+		return minBaseFee
+	}
+	return new(big.Int).Set(meanBaseFee)
+}
+
+// Leave room for the base fee to rise by 12.5% for the given number of full blocks, or to keep following its
+// recent trend for the given number of periods if that's higher
+func getMaxBaseFee(nextBaseFee *big.Int, meanBaseFee *big.Int, fullBlocks int, trendPeriods int) *big.Int {
+	headroom := new(big.Int).Set(nextBaseFee)
+	for i := 0; i < fullBlocks; i++ {
+		headroom.Mul(headroom, big.NewInt(9))
+		headroom.Div(headroom, big.NewInt(8))
+	}
+
+	trend := new(big.Int).Set(nextBaseFee)
+	for i := 0; i < trendPeriods; i++ {
+		trend.Mul(trend, nextBaseFee)
+		trend.Div(trend, meanBaseFee)
+	}
+
+	if trend.Cmp(headroom) > 0 {
+		return trend
+	}
+	return headroom
+}
+
+// Get the median priority fee paid at a reward percentile, ignoring empty blocks
+func getPriorityFee(history *FeeHistory, percentileIndex int) *big.Int {
+	rewards := []*big.Int{}
+	for i, blockRewards := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if percentileIndex < len(blockRewards) && blockRewards[percentileIndex] != nil {
+			rewards = append(rewards, blockRewards[percentileIndex])
+		}
+	}
+	if len(rewards) == 0 {
+		return eth.GweiToWei(DefaultPriorityFeeGwei)
+	}
+
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].Cmp(rewards[j]) < 0
+	})
+	return new(big.Int).Set(rewards[len(rewards)/2])
+}
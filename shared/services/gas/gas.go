@@ -7,9 +7,12 @@ import (
 
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/gas/etherchain"
 	"github.com/rocket-pool/smartnode/shared/services/gas/etherscan"
+	"github.com/rocket-pool/smartnode/shared/services/gas/feehistory"
 	rpsvc "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
@@ -66,30 +69,9 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 		fmt.Printf("Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, colorReset)
 
 	} else {
-		if headless {
-			maxFeeWei, err := GetHeadlessMaxFeeWei()
-			if err != nil {
-				return err
-			}
-			maxFeeGwei = eth.WeiToGwei(maxFeeWei)
-		} else {
-			// Try to get the latest gas prices from Etherchain
-			etherchainData, err := etherchain.GetGasPrices()
-			if err == nil {
-				// Print the Etherchain data and ask for an amount
-				maxFeeGwei = handleEtherchainGasPrices(etherchainData, gasInfo, maxPriorityFeeGwei, gasLimit)
-
-			} else {
-				// Fallback to Etherscan
-				fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", colorYellow, err.Error(), colorReset)
-				etherscanData, err := etherscan.GetGasPrices()
-				if err == nil {
-					// Print the Etherscan data and ask for an amount
-					maxFeeGwei = handleEtherscanGasPrices(etherscanData, gasInfo, maxPriorityFeeGwei, gasLimit)
-				} else {
-					return fmt.Errorf("Error getting gas price suggestions: %w", err)
-				}
-			}
+		maxFeeGwei, err = getSuggestedMaxFee(rp, cfg.Smartnode.FeeOracle.Value.(cfgtypes.FeeOracle), gasInfo, maxPriorityFeeGwei, gasLimit, headless)
+		if err != nil {
+			return err
 		}
 		fmt.Printf("%sUsing a max fee of %.2f gwei and a priority fee of %.2f gwei.\n%s", colorBlue, maxFeeGwei, maxPriorityFeeGwei, colorReset)
 	}
@@ -108,7 +90,89 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 }
 
 // Get the suggested max fee for service operations
-func GetHeadlessMaxFeeWei() (*big.Int, error) {
+func GetHeadlessMaxFeeWei(cfg *config.RocketPoolConfig, ec feehistory.Client) (*big.Int, error) {
+	oracle := cfg.Smartnode.FeeOracle.Value.(cfgtypes.FeeOracle)
+
+	// Try the web oracles first if they're preferred
+	if oracle == cfgtypes.FeeOracle_Web {
+		maxFee, err := getWebHeadlessMaxFeeWei()
+		if err == nil {
+			return maxFee, nil
+		}
+		fmt.Printf("%sWarning: couldn't get gas estimates from the web oracles - %s\nFalling back to the Execution client%s\n", colorYellow, err.Error(), colorReset)
+	}
+
+	// Use the fee history of the Execution client
+	suggestion, err := feehistory.GetGasPrices(ec)
+	if err == nil {
+		return suggestion.Fast.GetMaxFee(eth.GweiToWei(cfg.Smartnode.PriorityFee.Value.(float64))), nil
+	}
+	if oracle != cfgtypes.FeeOracle_ExecutionClientWithWeb {
+		return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
+	}
+
+	fmt.Printf("%sWarning: couldn't get gas estimates from the Execution client - %s\nFalling back to the web oracles%s\n", colorYellow, err.Error(), colorReset)
+	return getWebHeadlessMaxFeeWei()
+}
+
+// Get the suggested max fee for a transaction from the configured oracles, prompting for it unless running headless
+func getSuggestedMaxFee(rp *rpsvc.Client, oracle cfgtypes.FeeOracle, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64, headless bool) (float64, error) {
+
+	// Try the web oracles first if they're preferred
+	if oracle == cfgtypes.FeeOracle_Web {
+		maxFee, err := getWebMaxFee(gasInfo, priorityFee, gasLimit, headless)
+		if err == nil {
+			return maxFee, nil
+		}
+		fmt.Printf("%sWarning: couldn't get gas estimates from the web oracles - %s\nFalling back to the Execution client%s\n", colorYellow, err.Error(), colorReset)
+	}
+
+	// Use the fee history of the Execution client
+	response, err := rp.FeeSuggestions()
+	if err == nil {
+		if headless {
+			return eth.WeiToGwei(response.Suggestion.Fast.GetMaxFee(eth.GweiToWei(priorityFee))), nil
+		}
+		return handleFeeHistoryGasPrices(response.Suggestion, gasInfo, priorityFee, gasLimit), nil
+	}
+	if oracle != cfgtypes.FeeOracle_ExecutionClientWithWeb {
+		return 0, fmt.Errorf("Error getting gas price suggestions: %w", err)
+	}
+
+	fmt.Printf("%sWarning: couldn't get gas estimates from the Execution client - %s\nFalling back to the web oracles%s\n", colorYellow, err.Error(), colorReset)
+	return getWebMaxFee(gasInfo, priorityFee, gasLimit, headless)
+
+}
+
+// Get the suggested max fee for a transaction from Etherchain or Etherscan, prompting for it unless running headless
+func getWebMaxFee(gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64, headless bool) (float64, error) {
+	if headless {
+		maxFeeWei, err := getWebHeadlessMaxFeeWei()
+		if err != nil {
+			return 0, err
+		}
+		return eth.WeiToGwei(maxFeeWei), nil
+	}
+
+	// Try to get the latest gas prices from Etherchain
+	etherchainData, err := etherchain.GetGasPrices()
+	if err == nil {
+		// Print the Etherchain data and ask for an amount
+		return handleEtherchainGasPrices(etherchainData, gasInfo, priorityFee, gasLimit), nil
+	}
+
+	// Fallback to Etherscan
+	fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", colorYellow, err.Error(), colorReset)
+	etherscanData, err := etherscan.GetGasPrices()
+	if err == nil {
+		// Print the Etherscan data and ask for an amount
+		return handleEtherscanGasPrices(etherscanData, gasInfo, priorityFee, gasLimit), nil
+	}
+	return 0, fmt.Errorf("Error getting gas price suggestions: %w", err)
+}
+
+// Get the suggested max fee for service operations from Etherchain or Etherscan
+func getWebHeadlessMaxFeeWei() (*big.Int, error) {
 	etherchainData, err := etherchain.GetGasPrices()
 	if err == nil {
 		return etherchainData.RapidWei, nil
@@ -123,6 +187,36 @@ func GetHeadlessMaxFeeWei() (*big.Int, error) {
 	return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
 }
 
+func handleFeeHistoryGasPrices(gasSuggestion feehistory.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
+
+	priorityFeeWei := eth.GweiToWei(priorityFee)
+	fastGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.Fast.GetMaxFee(priorityFeeWei)), 0)
+	fastLowLimit, fastHighLimit := getCostRange(fastGwei, gasInfo, gasLimit)
+	standardGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.Standard.GetMaxFee(priorityFeeWei)), 0)
+	standardLowLimit, standardHighLimit := getCostRange(standardGwei, gasInfo, gasLimit)
+	slowGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.Slow.GetMaxFee(priorityFeeWei)), 0)
+	slowLowLimit, slowHighLimit := getCostRange(slowGwei, gasInfo, gasLimit)
+
+	fmt.Printf("%s+============ Suggested Gas Prices ============+\n", colorBlue)
+	fmt.Println("|   Speed   |  Max Fee  |    Total Gas Cost    |")
+	fmt.Printf("| Fast      | %-9s | %.4f to %.4f ETH |\n",
+		fmt.Sprintf("%d gwei", int(fastGwei)), fastLowLimit, fastHighLimit)
+	fmt.Printf("| Standard  | %-9s | %.4f to %.4f ETH |\n",
+		fmt.Sprintf("%d gwei", int(standardGwei)), standardLowLimit, standardHighLimit)
+	fmt.Printf("| Slow      | %-9s | %.4f to %.4f ETH |\n",
+		fmt.Sprintf("%d gwei", int(slowGwei)), slowLowLimit, slowHighLimit)
+	fmt.Printf("+==============================================+\n\n%s", colorReset)
+
+	fmt.Printf("These prices are based on your Execution client's fee history. The current base fee is %.2f gwei.\n", eth.WeiToGwei(gasSuggestion.BaseFeeWei))
+	fmt.Printf("They include a maximum priority fee of %.2f gwei, or the priority fee recently paid for each speed if that is higher.\n", priorityFee)
+	if priorityFeeWei.Cmp(gasSuggestion.Standard.PriorityFeeWei) < 0 {
+		fmt.Printf("%sNOTE: recent blocks paid a median priority fee of %.2f gwei, so your priority fee of %.2f gwei may take a while to be included.%s\n", colorYellow, eth.WeiToGwei(gasSuggestion.Standard.PriorityFeeWei), priorityFee, colorReset)
+	}
+
+	return promptForMaxFee(fastGwei)
+
+}
+
 func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
 
 	rapidGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.RapidWei)+priorityFee, 0)
@@ -191,28 +285,7 @@ func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInf
 
 	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
 
-	for {
-		desiredPrice := cliutils.Prompt(
-			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fastGwei)),
-			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
-			"Not a valid gas price, try again:")
-
-		if desiredPrice == "" {
-			return fastGwei
-		}
-
-		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
-		if err != nil {
-			fmt.Println("Not a valid gas price (%s), try again.", err.Error())
-			continue
-		}
-		if desiredPriceFloat <= 0 {
-			fmt.Println("Max fee must be greater than zero.")
-			continue
-		}
-
-		return desiredPriceFloat
-	}
+	return promptForMaxFee(fastGwei)
 
 }
 
@@ -269,19 +342,34 @@ func handleEtherscanGasPrices(gasSuggestion etherscan.GasFeeSuggestion, gasInfo
 
 	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
 
+	return promptForMaxFee(fastGwei)
+
+}
+
+// Get the range of total costs for a transaction at the given max fee, in ETH
+func getCostRange(maxFeeGwei float64, gasInfo rocketpool.GasInfo, gasLimit uint64) (float64, float64) {
+	if gasLimit != 0 {
+		cost := maxFeeGwei / eth.WeiPerGwei * float64(gasLimit)
+		return cost, cost
+	}
+	return maxFeeGwei / eth.WeiPerGwei * float64(gasInfo.EstGasLimit), maxFeeGwei / eth.WeiPerGwei * float64(gasInfo.SafeGasLimit)
+}
+
+// Prompt for the max fee to use, defaulting to the provided one
+func promptForMaxFee(defaultGwei float64) float64 {
 	for {
 		desiredPrice := cliutils.Prompt(
-			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fastGwei)),
+			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(defaultGwei)),
 			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
 			"Not a valid gas price, try again:")
 
 		if desiredPrice == "" {
-			return fastGwei
+			return defaultGwei
 		}
 
 		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
 		if err != nil {
-			fmt.Println("Not a valid gas price (%s), try again.", err.Error())
+			fmt.Printf("Not a valid gas price (%s), try again.\n", err.Error())
 			continue
 		}
 		if desiredPriceFloat <= 0 {
@@ -291,5 +379,4 @@ func handleEtherscanGasPrices(gasSuggestion etherscan.GasFeeSuggestion, gasInfo
 
 		return desiredPriceFloat
 	}
-
 }
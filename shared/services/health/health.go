@@ -0,0 +1,241 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Daemon stages
+const (
+	StageStarting            string = "starting"
+	StageWaitingRegistration string = "waiting for node registration"
+	StageRunning             string = "running"
+	StageShuttingDown        string = "shutting down"
+)
+
+// Settings
+const (
+	HealthPath = "/healthz"
+	ReadyPath  = "/readyz"
+
+	// A task that has been running for longer than this is considered stuck
+	StuckTaskThreshold = time.Hour
+
+	// How long the client statuses are cached for, so frequent probes don't flood the clients
+	ClientStatusCacheTime = 15 * time.Second
+)
+
+// A health or readiness report
+type Report struct {
+	Status                string                   `json:"status"`
+	Stage                 string                   `json:"stage"`
+	Errors                []string                 `json:"errors,omitempty"`
+	NodePasswordSet       *bool                    `json:"nodePasswordSet,omitempty"`
+	NodeWalletInitialized *bool                    `json:"nodeWalletInitialized,omitempty"`
+	WatchOnly             *bool                    `json:"watchOnly,omitempty"`
+	EcStatus              *api.ClientManagerStatus `json:"ecStatus,omitempty"`
+	BcStatus              *api.ClientManagerStatus `json:"bcStatus,omitempty"`
+	Tasks                 []tasks.TaskStatus       `json:"tasks,omitempty"`
+}
+
+// Tracks the state of a daemon and serves its health and readiness endpoints
+type Monitor struct {
+	ctx       context.Context
+	c         *cli.Context
+	stage     string
+	scheduler *tasks.Scheduler
+	lock      sync.Mutex
+
+	// Cached client statuses
+	statusLock   sync.Mutex
+	statusTime   time.Time
+	ecStatus     *api.ClientManagerStatus
+	bcStatus     *api.ClientManagerStatus
+	statusErrors []string
+}
+
+// Create a monitor for a daemon; the context controls when the daemon shuts down
+func NewMonitor(ctx context.Context, c *cli.Context) *Monitor {
+	return &Monitor{
+		ctx:   ctx,
+		c:     c,
+		stage: StageStarting,
+	}
+}
+
+// Set the daemon's current stage
+func (m *Monitor) SetStage(stage string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.stage = stage
+}
+
+// Set the scheduler running the daemon's tasks
+func (m *Monitor) SetScheduler(scheduler *tasks.Scheduler) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.scheduler = scheduler
+}
+
+// Register the health and readiness handlers
+func (m *Monitor) RegisterHandlers(mux *http.ServeMux) {
+	mux.HandleFunc(HealthPath, func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, m.Health())
+	})
+	mux.HandleFunc(ReadyPath, func(w http.ResponseWriter, r *http.Request) {
+		var report Report
+		services.HoldCallSettings(func() {
+			report = m.Readiness()
+		})
+		writeReport(w, report)
+	})
+}
+
+// Check if the daemon is alive; it's unhealthy if any task is stuck
+func (m *Monitor) Health() Report {
+	report := Report{
+		Stage: m.getStage(),
+		Tasks: m.getTaskStatuses(),
+	}
+	for _, task := range report.Tasks {
+		if task.RunningSince != nil && time.Since(*task.RunningSince) > StuckTaskThreshold {
+			report.Errors = append(report.Errors, fmt.Sprintf("task '%s' has been running since %s", task.Name, task.RunningSince.Format(time.RFC3339)))
+		}
+	}
+	return finishReport(report)
+}
+
+// Check if the daemon is ready to do its work
+func (m *Monitor) Readiness() Report {
+	report := m.Health()
+	report.Status = ""
+	if report.Stage != StageRunning {
+		report.Errors = append(report.Errors, fmt.Sprintf("daemon is %s", report.Stage))
+	}
+
+	// Check the node wallet; a watch-only node has no password or wallet to check
+	walletOffline, err := services.IsNodeWalletOffline(m.c)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("error checking for a watch-only node: %s", err.Error()))
+	} else if walletOffline {
+		report.WatchOnly = &walletOffline
+	} else {
+		passwordSet, err := services.IsNodePasswordSet(m.c)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("error checking node password: %s", err.Error()))
+		} else {
+			report.NodePasswordSet = &passwordSet
+			if !passwordSet {
+				report.Errors = append(report.Errors, "node password is not set or the wallet is locked")
+			}
+		}
+		walletInitialized, err := services.IsNodeWalletInitialized(m.c)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("error checking node wallet: %s", err.Error()))
+		} else {
+			report.NodeWalletInitialized = &walletInitialized
+			if !walletInitialized {
+				report.Errors = append(report.Errors, "node wallet is not initialized")
+			}
+		}
+	}
+
+	// Check the clients
+	ecStatus, bcStatus, statusErrors := m.getClientStatuses()
+	report.EcStatus = ecStatus
+	report.BcStatus = bcStatus
+	report.Errors = append(report.Errors, statusErrors...)
+
+	return finishReport(report)
+}
+
+// Get the daemon's current stage
+func (m *Monitor) getStage() string {
+	if m.ctx.Err() != nil {
+		return StageShuttingDown
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.stage
+}
+
+// Get the statuses of the daemon's tasks, if they've been scheduled
+func (m *Monitor) getTaskStatuses() []tasks.TaskStatus {
+	m.lock.Lock()
+	scheduler := m.scheduler
+	m.lock.Unlock()
+	if scheduler == nil {
+		return nil
+	}
+	return scheduler.Status()
+}
+
+// Get the statuses of the EC and BC managers, refreshing them if the cache has expired
+func (m *Monitor) getClientStatuses() (*api.ClientManagerStatus, *api.ClientManagerStatus, []string) {
+	m.statusLock.Lock()
+	defer m.statusLock.Unlock()
+	if time.Since(m.statusTime) < ClientStatusCacheTime {
+		return m.ecStatus, m.bcStatus, m.statusErrors
+	}
+
+	m.ecStatus = nil
+	m.bcStatus = nil
+	m.statusErrors = nil
+	ec, err := services.GetEthClient(m.c)
+	if err != nil {
+		m.statusErrors = append(m.statusErrors, fmt.Sprintf("error getting execution client manager: %s", err.Error()))
+	} else {
+		m.ecStatus = ec.CheckStatus()
+		if !isClientReady(m.ecStatus) {
+			m.statusErrors = append(m.statusErrors, "no execution client is synced")
+		}
+	}
+	bc, err := services.GetBeaconClient(m.c)
+	if err != nil {
+		m.statusErrors = append(m.statusErrors, fmt.Sprintf("error getting beacon client manager: %s", err.Error()))
+	} else {
+		m.bcStatus = bc.CheckStatus()
+		if !isClientReady(m.bcStatus) {
+			m.statusErrors = append(m.statusErrors, "no consensus client is synced")
+		}
+	}
+	m.statusTime = time.Now()
+	return m.ecStatus, m.bcStatus, m.statusErrors
+}
+
+// Check if the primary or fallback client is working and synced
+func isClientReady(status *api.ClientManagerStatus) bool {
+	if status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced {
+		return true
+	}
+	return status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced
+}
+
+// Set the report's status from its errors
+func finishReport(report Report) Report {
+	if len(report.Errors) == 0 {
+		report.Status = "ok"
+	} else {
+		report.Status = "error"
+	}
+	return report
+}
+
+// Write a report as JSON, with a 503 status if it has errors
+func writeReport(w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	if len(report.Errors) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
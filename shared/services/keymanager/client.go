@@ -0,0 +1,239 @@
+package keymanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	RequestUrlFormat   = "%s%s"
+	RequestContentType = "application/json"
+	RequestTimeout     = 30 * time.Second
+
+	RequestKeystoresPath    = "/eth/v1/keystores"
+	RequestRemoteKeysPath   = "/eth/v1/remotekeys"
+	RequestFeeRecipientPath = "/eth/v1/validator/%s/feerecipient"
+)
+
+// Returned when the validator client doesn't support the requested Keymanager API endpoint
+var ErrNotSupported = errors.New("The validator client does not support this Keymanager API endpoint")
+
+// Client for the standard validator Keymanager API (https://ethereum.github.io/keymanager-APIs/)
+type Client struct {
+	providerAddress string
+	token           string
+	client          *http.Client
+}
+
+// Create a new client instance
+func NewClient(providerAddress string, token string) *Client {
+	return &Client{
+		providerAddress: strings.TrimSuffix(providerAddress, "/"),
+		token:           strings.TrimSpace(token),
+		client:          &http.Client{Timeout: RequestTimeout},
+	}
+}
+
+// Create a new client instance, reading the bearer token from a file
+func NewClientWithTokenFile(providerAddress string, tokenPath string) (*Client, error) {
+	token := ""
+	if tokenPath != "" {
+		tokenBytes, err := ioutil.ReadFile(tokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read Keymanager API token: %w", err)
+		}
+		token = string(tokenBytes)
+	}
+	return NewClient(providerAddress, token), nil
+}
+
+// Get the local keystores loaded by the validator client
+func (c *Client) ListKeystores() ([]Keystore, error) {
+	var response listKeystoresResponse
+	if err := c.request(http.MethodGet, RequestKeystoresPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("Could not list keystores: %w", err)
+	}
+	keystores := make([]Keystore, len(response.Data))
+	for i, keystore := range response.Data {
+		keystores[i] = Keystore{
+			Pubkey:         types.ValidatorPubkey(keystore.Pubkey),
+			DerivationPath: keystore.DerivationPath,
+			Readonly:       keystore.Readonly,
+		}
+	}
+	return keystores, nil
+}
+
+// Import EIP-2335 keystores into the validator client and start validating with them.
+// The slashing protection data is an EIP-3076 interchange document and may be blank.
+func (c *Client) ImportKeystores(keystores []string, passwords []string, slashingProtection string) ([]KeyStatus, error) {
+	request := importKeystoresRequest{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	}
+	var response keyStatusesResponse
+	if err := c.request(http.MethodPost, RequestKeystoresPath, request, &response); err != nil {
+		return nil, fmt.Errorf("Could not import keystores: %w", err)
+	}
+	return response.Data, nil
+}
+
+// Delete keystores from the validator client, returning their statuses and the EIP-3076 slashing protection data for them
+func (c *Client) DeleteKeystores(pubkeys []types.ValidatorPubkey) ([]KeyStatus, string, error) {
+	var response keyStatusesResponse
+	if err := c.request(http.MethodDelete, RequestKeystoresPath, deleteKeysRequest{Pubkeys: toPubkeys(pubkeys)}, &response); err != nil {
+		return nil, "", fmt.Errorf("Could not delete keystores: %w", err)
+	}
+	return response.Data, response.SlashingProtection, nil
+}
+
+// Get the remote signer keys loaded by the validator client
+func (c *Client) ListRemoteKeys() ([]RemoteKey, error) {
+	var response listRemoteKeysResponse
+	if err := c.request(http.MethodGet, RequestRemoteKeysPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("Could not list remote keys: %w", err)
+	}
+	remoteKeys := make([]RemoteKey, len(response.Data))
+	for i, key := range response.Data {
+		remoteKeys[i] = RemoteKey{
+			Pubkey:   types.ValidatorPubkey(key.Pubkey),
+			Url:      key.Url,
+			Readonly: key.Readonly,
+		}
+	}
+	return remoteKeys, nil
+}
+
+// Import remote signer keys into the validator client
+func (c *Client) ImportRemoteKeys(remoteKeys []RemoteKey) ([]KeyStatus, error) {
+	request := importRemoteKeysRequest{
+		RemoteKeys: make([]remoteKey, len(remoteKeys)),
+	}
+	for i, key := range remoteKeys {
+		request.RemoteKeys[i] = remoteKey{
+			Pubkey: pubkey(key.Pubkey),
+			Url:    key.Url,
+		}
+	}
+	var response keyStatusesResponse
+	if err := c.request(http.MethodPost, RequestRemoteKeysPath, request, &response); err != nil {
+		return nil, fmt.Errorf("Could not import remote keys: %w", err)
+	}
+	return response.Data, nil
+}
+
+// Delete remote signer keys from the validator client
+func (c *Client) DeleteRemoteKeys(pubkeys []types.ValidatorPubkey) ([]KeyStatus, error) {
+	var response keyStatusesResponse
+	if err := c.request(http.MethodDelete, RequestRemoteKeysPath, deleteKeysRequest{Pubkeys: toPubkeys(pubkeys)}, &response); err != nil {
+		return nil, fmt.Errorf("Could not delete remote keys: %w", err)
+	}
+	return response.Data, nil
+}
+
+// Get the fee recipient the validator client uses for a validator
+func (c *Client) GetFeeRecipient(pubkey types.ValidatorPubkey) (common.Address, error) {
+	var response feeRecipientResponse
+	if err := c.request(http.MethodGet, getFeeRecipientPath(pubkey), nil, &response); err != nil {
+		return common.Address{}, fmt.Errorf("Could not get fee recipient for validator %s: %w", pubkey.Hex(), err)
+	}
+	return response.Data.EthAddress, nil
+}
+
+// Set the fee recipient the validator client uses for a validator
+func (c *Client) SetFeeRecipient(pubkey types.ValidatorPubkey, feeRecipient common.Address) error {
+	if err := c.request(http.MethodPost, getFeeRecipientPath(pubkey), setFeeRecipientRequest{EthAddress: feeRecipient}, nil); err != nil {
+		return fmt.Errorf("Could not set fee recipient for validator %s: %w", pubkey.Hex(), err)
+	}
+	return nil
+}
+
+// Remove a validator's fee recipient override so the validator client uses its default
+func (c *Client) DeleteFeeRecipient(pubkey types.ValidatorPubkey) error {
+	if err := c.request(http.MethodDelete, getFeeRecipientPath(pubkey), nil, nil); err != nil {
+		return fmt.Errorf("Could not delete fee recipient for validator %s: %w", pubkey.Hex(), err)
+	}
+	return nil
+}
+
+// Get the fee recipient endpoint for a validator
+func getFeeRecipientPath(pubkey types.ValidatorPubkey) string {
+	return fmt.Sprintf(RequestFeeRecipientPath, hexutil.AddPrefix(pubkey.Hex()))
+}
+
+// Make a request to the Keymanager API and decode the response into the provided object
+func (c *Client) request(method string, requestPath string, requestBody interface{}, responseObject interface{}) error {
+
+	// Get request body
+	var requestBodyReader io.Reader
+	if requestBody != nil {
+		requestBodyBytes, err := json.Marshal(requestBody)
+		if err != nil {
+			return err
+		}
+		requestBodyReader = bytes.NewReader(requestBodyBytes)
+	}
+
+	// Create request
+	request, err := http.NewRequest(method, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), requestBodyReader)
+	if err != nil {
+		return err
+	}
+	if requestBody != nil {
+		request.Header.Set("Content-Type", RequestContentType)
+	}
+	if c.token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	// Send request
+	response, err := c.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	// Get response
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	// Check the status
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return ErrNotSupported
+	default:
+		var errResponse errorResponse
+		if json.Unmarshal(body, &errResponse) == nil && errResponse.Message != "" {
+			return fmt.Errorf("HTTP status %d: %s", response.StatusCode, errResponse.Message)
+		}
+		return fmt.Errorf("HTTP status %d; response body: '%s'", response.StatusCode, string(body))
+	}
+
+	// Decode the response
+	if responseObject != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, responseObject); err != nil {
+			return fmt.Errorf("Could not decode response: %w", err)
+		}
+	}
+	return nil
+
+}
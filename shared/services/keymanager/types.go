@@ -0,0 +1,113 @@
+package keymanager
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Statuses reported for each key by the import and delete endpoints
+const (
+	StatusImported  string = "imported"
+	StatusDuplicate string = "duplicate"
+	StatusDeleted   string = "deleted"
+	StatusNotActive string = "not_active"
+	StatusNotFound  string = "not_found"
+	StatusError     string = "error"
+)
+
+// A keystore loaded by the validator client
+type Keystore struct {
+	Pubkey         types.ValidatorPubkey
+	DerivationPath string
+	Readonly       bool
+}
+
+// A remote signer key loaded by the validator client
+type RemoteKey struct {
+	Pubkey   types.ValidatorPubkey
+	Url      string
+	Readonly bool
+}
+
+// The result of importing or deleting a single key
+type KeyStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Request types
+type importKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+type deleteKeysRequest struct {
+	Pubkeys []pubkey `json:"pubkeys"`
+}
+type setFeeRecipientRequest struct {
+	EthAddress common.Address `json:"ethaddress"`
+}
+type remoteKey struct {
+	Pubkey   pubkey `json:"pubkey"`
+	Url      string `json:"url,omitempty"`
+	Readonly bool   `json:"readonly,omitempty"`
+}
+type importRemoteKeysRequest struct {
+	RemoteKeys []remoteKey `json:"remote_keys"`
+}
+
+// Response types
+type listKeystoresResponse struct {
+	Data []struct {
+		Pubkey         pubkey `json:"validating_pubkey"`
+		DerivationPath string `json:"derivation_path"`
+		Readonly       bool   `json:"readonly"`
+	} `json:"data"`
+}
+type listRemoteKeysResponse struct {
+	Data []remoteKey `json:"data"`
+}
+type keyStatusesResponse struct {
+	Data               []KeyStatus `json:"data"`
+	SlashingProtection string      `json:"slashing_protection,omitempty"`
+}
+type feeRecipientResponse struct {
+	Data struct {
+		Pubkey     pubkey         `json:"pubkey"`
+		EthAddress common.Address `json:"ethaddress"`
+	} `json:"data"`
+}
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+// A validator pubkey encoded as 0x-prefixed hex, as the Keymanager API requires
+type pubkey types.ValidatorPubkey
+
+func (p pubkey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexutil.AddPrefix(types.ValidatorPubkey(p).Hex()))
+}
+func (p *pubkey) UnmarshalJSON(data []byte) error {
+	var dataStr string
+	if err := json.Unmarshal(data, &dataStr); err != nil {
+		return err
+	}
+	value, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(dataStr))
+	if err == nil {
+		*p = pubkey(value)
+	}
+	return err
+}
+
+// Convert validator pubkeys to their API encoding
+func toPubkeys(pubkeys []types.ValidatorPubkey) []pubkey {
+	converted := make([]pubkey, len(pubkeys))
+	for i, key := range pubkeys {
+		converted[i] = pubkey(key)
+	}
+	return converted
+}
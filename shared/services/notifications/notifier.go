@@ -0,0 +1,202 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// A notification about an event on the node
+type Notification struct {
+	// The daemon that raised the notification
+	Source string `json:"source"`
+
+	// Identifies the condition the notification is about, so repeats can be suppressed
+	Key string `json:"key"`
+
+	Severity cfgtypes.NotificationSeverity `json:"severity"`
+	Title    string                        `json:"title"`
+	Message  string                        `json:"message"`
+	Time     time.Time                     `json:"time"`
+}
+
+// A destination that notifications can be sent to
+type Sink interface {
+	// The name of the sink, used for logging
+	Name() string
+
+	// Send a notification
+	Send(notification Notification) error
+}
+
+// Sends notifications to the sinks set up in the config
+type Notifier struct {
+	source         string
+	log            log.ColorLogger
+	sinks          []Sink
+	minSeverity    cfgtypes.NotificationSeverity
+	repeatInterval time.Duration
+	lastSent       map[string]time.Time
+	lock           sync.Mutex
+}
+
+// Create a notifier for a daemon; it does nothing if notifications are disabled
+func NewNotifier(cfg *config.RocketPoolConfig, source string, logger log.ColorLogger) *Notifier {
+
+	notifier := &Notifier{
+		source:   source,
+		log:      logger,
+		lastSent: map[string]time.Time{},
+	}
+	if cfg.EnableNotifications.Value != true {
+		return notifier
+	}
+
+	notificationsCfg := cfg.Notifications
+	notifier.minSeverity = notificationsCfg.MinimumSeverity.Value.(cfgtypes.NotificationSeverity)
+	notifier.repeatInterval = time.Duration(notificationsCfg.RepeatInterval.Value.(uint64)) * time.Hour
+	notifier.sinks = getSinks(notificationsCfg)
+	if len(notifier.sinks) == 0 {
+		logger.Println("WARNING: notifications are enabled, but no notification services have been configured.")
+	}
+	return notifier
+
+}
+
+// Check if any notification sinks are set up
+func (n *Notifier) IsEnabled() bool {
+	return len(n.sinks) > 0
+}
+
+// Send a notification to every sink, unless it's below the minimum severity or was already sent within the repeat interval
+func (n *Notifier) Notify(severity cfgtypes.NotificationSeverity, key string, title string, message string) {
+
+	if !n.IsEnabled() || getSeverityLevel(severity) < getSeverityLevel(n.minSeverity) {
+		return
+	}
+
+	// Check if this was already sent recently
+	n.lock.Lock()
+	lastSent, exists := n.lastSent[key]
+	if exists && time.Since(lastSent) < n.repeatInterval {
+		n.lock.Unlock()
+		return
+	}
+	n.lock.Unlock()
+
+	notification := Notification{
+		Source:   n.source,
+		Key:      key,
+		Severity: severity,
+		Title:    title,
+		Message:  message,
+		Time:     time.Now().UTC(),
+	}
+	sent := false
+	for _, sink := range n.sinks {
+		if err := sink.Send(notification); err != nil {
+			n.log.Printlnf("WARNING: couldn't send notification '%s' to %s: %s", title, sink.Name(), err.Error())
+		} else {
+			sent = true
+		}
+	}
+
+	// Only hold off on repeats once someone has actually been told
+	if sent {
+		n.lock.Lock()
+		n.lastSent[key] = time.Now()
+		n.lock.Unlock()
+	}
+
+}
+
+// Mark a condition as resolved, so the next notification about it is sent right away
+func (n *Notifier) Clear(key string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	delete(n.lastSent, key)
+}
+
+// Get a handler that notifies about a client manager failing over to its fallback client
+func (n *Notifier) GetFailoverHandler(key string) services.FailoverHandler {
+	return func(primaryFailed bool, fallbackReady bool, message string) {
+		if !primaryFailed {
+			n.Clear(key + "-fallback")
+			n.Clear(key + "-down")
+			return
+		}
+
+		// Send in the background so client calls aren't held up
+		if fallbackReady {
+			go n.Notify(cfgtypes.NotificationSeverity_Warning, key+"-fallback", "Using fallback client", message+"; switched to the fallback client.")
+		} else {
+			go n.Notify(cfgtypes.NotificationSeverity_Critical, key+"-down", "No clients available", message+"; no fallback client is ready.")
+		}
+	}
+}
+
+// Get the sinks that have been configured
+func getSinks(cfg *config.NotificationsConfig) []Sink {
+
+	sinks := []Sink{}
+	if url := cfg.WebhookUrl.Value.(string); url != "" {
+		sinks = append(sinks, &webhookSink{url: url})
+	}
+	if url := cfg.DiscordWebhookUrl.Value.(string); url != "" {
+		sinks = append(sinks, &discordSink{url: url})
+	}
+	if url := cfg.SlackWebhookUrl.Value.(string); url != "" {
+		sinks = append(sinks, &slackSink{url: url})
+	}
+	if token, chatID := cfg.TelegramBotToken.Value.(string), cfg.TelegramChatID.Value.(string); token != "" && chatID != "" {
+		sinks = append(sinks, &telegramSink{token: token, chatID: chatID})
+	}
+	if url := cfg.NtfyUrl.Value.(string); url != "" {
+		sinks = append(sinks, &ntfySink{url: url, token: cfg.NtfyToken.Value.(string)})
+	}
+	if url, token := cfg.GotifyUrl.Value.(string), cfg.GotifyToken.Value.(string); url != "" && token != "" {
+		sinks = append(sinks, &gotifySink{url: strings.TrimSuffix(url, "/"), token: token})
+	}
+	if host, to := cfg.SmtpHost.Value.(string), cfg.SmtpTo.Value.(string); host != "" && to != "" {
+		sinks = append(sinks, &smtpSink{
+			address:  fmt.Sprintf("%s:%d", host, cfg.SmtpPort.Value.(uint16)),
+			host:     host,
+			username: cfg.SmtpUsername.Value.(string),
+			password: cfg.SmtpPassword.Value.(string),
+			from:     cfg.SmtpFrom.Value.(string),
+			to:       splitAddresses(to),
+		})
+	}
+	return sinks
+
+}
+
+// Get the relative importance of a severity
+func getSeverityLevel(severity cfgtypes.NotificationSeverity) int {
+	switch severity {
+	case cfgtypes.NotificationSeverity_Critical:
+		return 2
+	case cfgtypes.NotificationSeverity_Warning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Split a comma-separated list of email addresses
+func splitAddresses(list string) []string {
+	addresses := []string{}
+	for _, address := range strings.Split(list, ",") {
+		address = strings.TrimSpace(address)
+		if address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
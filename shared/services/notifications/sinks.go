@@ -0,0 +1,258 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Settings
+const SendTimeout = 15 * time.Second
+
+var httpClient = &http.Client{Timeout: SendTimeout}
+
+// Sends notifications as JSON to a generic webhook
+type webhookSink struct {
+	url string
+}
+
+func (s *webhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *webhookSink) Send(notification Notification) error {
+	return postJson(s.url, notification)
+}
+
+// Sends notifications to a Discord channel webhook
+type discordSink struct {
+	url string
+}
+
+func (s *discordSink) Name() string {
+	return "Discord"
+}
+
+func (s *discordSink) Send(notification Notification) error {
+	return postJson(s.url, map[string]string{
+		"username": "Rocket Pool",
+		"content":  fmt.Sprintf("%s **%s**\n%s", getSeverityPrefix(notification.Severity), notification.Title, notification.Message),
+	})
+}
+
+// Sends notifications to a Slack incoming webhook
+type slackSink struct {
+	url string
+}
+
+func (s *slackSink) Name() string {
+	return "Slack"
+}
+
+func (s *slackSink) Send(notification Notification) error {
+	return postJson(s.url, map[string]string{
+		"text": fmt.Sprintf("%s *%s*\n%s", getSeverityPrefix(notification.Severity), notification.Title, notification.Message),
+	})
+}
+
+// Sends notifications to a Telegram chat through a bot
+type telegramSink struct {
+	token  string
+	chatID string
+}
+
+func (s *telegramSink) Name() string {
+	return "Telegram"
+}
+
+func (s *telegramSink) Send(notification Notification) error {
+	return postJson(fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", s.token), map[string]string{
+		"chat_id": s.chatID,
+		"text":    fmt.Sprintf("%s %s\n%s", getSeverityPrefix(notification.Severity), notification.Title, notification.Message),
+	})
+}
+
+// Publishes notifications to an ntfy topic
+type ntfySink struct {
+	url   string
+	token string
+}
+
+func (s *ntfySink) Name() string {
+	return "ntfy"
+}
+
+func (s *ntfySink) Send(notification Notification) error {
+	priority := "default"
+	switch notification.Severity {
+	case cfgtypes.NotificationSeverity_Warning:
+		priority = "high"
+	case cfgtypes.NotificationSeverity_Critical:
+		priority = "urgent"
+	}
+	request, err := http.NewRequest(http.MethodPost, s.url, strings.NewReader(notification.Message))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Title", notification.Title)
+	request.Header.Set("Priority", priority)
+	request.Header.Set("Tags", string(notification.Severity))
+	if s.token != "" {
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	return send(request)
+}
+
+// Sends notifications to a Gotify server
+type gotifySink struct {
+	url   string
+	token string
+}
+
+func (s *gotifySink) Name() string {
+	return "Gotify"
+}
+
+func (s *gotifySink) Send(notification Notification) error {
+	priority := 2
+	switch notification.Severity {
+	case cfgtypes.NotificationSeverity_Warning:
+		priority = 5
+	case cfgtypes.NotificationSeverity_Critical:
+		priority = 8
+	}
+	return postJson(fmt.Sprintf("%s/message?token=%s", s.url, s.token), map[string]interface{}{
+		"title":    notification.Title,
+		"message":  notification.Message,
+		"priority": priority,
+	})
+}
+
+// Sends notifications by email
+type smtpSink struct {
+	address  string
+	host     string
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+func (s *smtpSink) Name() string {
+	return "email"
+}
+
+func (s *smtpSink) Send(notification Notification) error {
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+	body := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: [Rocket Pool %s] %s\r\nDate: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		s.from, strings.Join(s.to, ", "), notification.Severity, notification.Title, notification.Time.Format(time.RFC1123Z), notification.Message)
+	return sendMail(s.address, s.host, auth, s.from, s.to, []byte(body))
+}
+
+// Send an email like smtp.SendMail does, but give up on the whole exchange after SendTimeout
+func sendMail(address string, host string, auth smtp.Auth, from string, to []string, body []byte) error {
+	conn, err := net.DialTimeout("tcp", address, SendTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(SendTimeout)); err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("the SMTP server doesn't support authentication")
+		}
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(body); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// Get a marker for a severity in chat messages
+func getSeverityPrefix(severity cfgtypes.NotificationSeverity) string {
+	switch severity {
+	case cfgtypes.NotificationSeverity_Critical:
+		return "[CRITICAL]"
+	case cfgtypes.NotificationSeverity_Warning:
+		return "[WARNING]"
+	default:
+		return "[INFO]"
+	}
+}
+
+// Send a JSON POST request
+func postJson(url string, body interface{}) error {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error serializing notification: %w", err)
+	}
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return send(request)
+}
+
+// Send a request, checking the response status
+func send(request *http.Request) error {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		// Don't include the URL, since it may contain a token
+		if urlErr, ok := err.(*url.Error); ok {
+			return urlErr.Err
+		}
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("request failed with status %s: %s", response.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package offline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// A call to a contract method.
+// It includes the method's ABI, so the offline machine can decode the calldata it signs without access to the network.
+type ContractCall struct {
+	Contract string `json:"contract"`
+	Method   string `json:"method"`
+	Abi      string `json:"abi"`
+}
+
+// A decoded argument of a contract call
+type CallArgument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// The JSON ABI of a method and its arguments
+type abiMethod struct {
+	Type            string        `json:"type"`
+	Name            string        `json:"name"`
+	Inputs          []abiArgument `json:"inputs"`
+	Outputs         []abiArgument `json:"outputs"`
+	StateMutability string        `json:"stateMutability"`
+}
+type abiArgument struct {
+	Name       string        `json:"name"`
+	Type       string        `json:"type"`
+	Components []abiArgument `json:"components,omitempty"`
+}
+
+// Describe the contract method that calldata calls
+func NewContractCall(contractName string, contractAbi *abi.ABI, data []byte) (*ContractCall, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("The calldata is too short to call a contract method")
+	}
+	method, err := contractAbi.MethodById(data[:4])
+	if err != nil {
+		return nil, fmt.Errorf("Could not find the %s method being called: %w", contractName, err)
+	}
+
+	// Serialize the method's ABI
+	methodAbi := abiMethod{
+		Type:            "function",
+		Name:            method.RawName,
+		Inputs:          getAbiArguments(method.Inputs),
+		Outputs:         getAbiArguments(method.Outputs),
+		StateMutability: method.StateMutability,
+	}
+	abiBytes, err := json.Marshal([]abiMethod{methodAbi})
+	if err != nil {
+		return nil, fmt.Errorf("Could not serialize the ABI of %s.%s: %w", contractName, method.RawName, err)
+	}
+
+	return &ContractCall{
+		Contract: contractName,
+		Method:   method.Sig,
+		Abi:      string(abiBytes),
+	}, nil
+}
+
+// Decode the arguments of calldata for the method
+func (c *ContractCall) Decode(data []byte) ([]CallArgument, error) {
+	method, err := c.getMethod()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return nil, fmt.Errorf("The calldata does not call %s", c.Method)
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode the arguments of %s: %w", c.Method, err)
+	}
+	args := make([]CallArgument, len(values))
+	for i, value := range values {
+		args[i] = CallArgument{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: formatValue(reflect.ValueOf(value)),
+		}
+	}
+	return args, nil
+}
+
+// Build the calldata for a call to the method with the provided arguments
+func (c *ContractCall) Pack(args ...interface{}) ([]byte, error) {
+	method, err := c.getMethod()
+	if err != nil {
+		return nil, err
+	}
+	packedArgs, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode the arguments of %s: %w", c.Method, err)
+	}
+	return append(append([]byte{}, method.ID...), packedArgs...), nil
+}
+
+// Parse the method's ABI
+func (c *ContractCall) getMethod() (*abi.Method, error) {
+	parsedAbi, err := abi.JSON(strings.NewReader(c.Abi))
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse the ABI of %s: %w", c.Method, err)
+	}
+	for _, method := range parsedAbi.Methods {
+		if method.Sig == c.Method {
+			return &method, nil
+		}
+	}
+	return nil, fmt.Errorf("The ABI does not include %s", c.Method)
+}
+
+// Get the JSON ABI of a method's arguments
+func getAbiArguments(arguments abi.Arguments) []abiArgument {
+	result := make([]abiArgument, len(arguments))
+	for i, argument := range arguments {
+		result[i] = getAbiArgument(argument.Name, argument.Type)
+	}
+	return result
+}
+
+// Get the JSON ABI of an argument; tuples and arrays of them are described by their components
+func getAbiArgument(name string, argType abi.Type) abiArgument {
+	suffix := ""
+	elem := argType
+	for elem.T == abi.SliceTy || elem.T == abi.ArrayTy {
+		if elem.T == abi.SliceTy {
+			suffix = "[]" + suffix
+		} else {
+			suffix = fmt.Sprintf("[%d]", elem.Size) + suffix
+		}
+		elem = *elem.Elem
+	}
+	if elem.T != abi.TupleTy {
+		return abiArgument{Name: name, Type: argType.String()}
+	}
+
+	components := make([]abiArgument, len(elem.TupleElems))
+	for i, component := range elem.TupleElems {
+		components[i] = getAbiArgument(elem.TupleRawNames[i], *component)
+	}
+	return abiArgument{
+		Name:       name,
+		Type:       "tuple" + suffix,
+		Components: components,
+	}
+}
+
+// Format a decoded argument for display
+func formatValue(value reflect.Value) string {
+	switch v := value.Interface().(type) {
+	case common.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	switch value.Kind() {
+	case reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bytes), value)
+			return hexutil.Encode(bytes)
+		}
+		fallthrough
+	case reflect.Slice:
+		elements := make([]string, value.Len())
+		for i := range elements {
+			elements[i] = formatValue(value.Index(i))
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	case reflect.Struct:
+		fields := make([]string, value.NumField())
+		for i := range fields {
+			fields[i] = formatValue(value.Field(i))
+		}
+		return "(" + strings.Join(fields, ", ") + ")"
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
@@ -0,0 +1,328 @@
+package offline
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Settings
+const (
+	BundleVersion uint64 = 1
+	PayloadPrefix string = "rpoffline:"
+	FileMode             = 0600
+
+	// The contract method that deposits call
+	DepositContractName string = "rocketNodeDeposit"
+	DepositMethod       string = "deposit(uint256,bytes,bytes,bytes32,uint256,address)"
+)
+
+// A transaction for the node account that hasn't been signed yet
+type UnsignedTransaction struct {
+	Description    string          `json:"description"`
+	Nonce          uint64          `json:"nonce"`
+	To             *common.Address `json:"to"`
+	Value          *big.Int        `json:"value"`
+	Data           hexutil.Bytes   `json:"data"`
+	GasLimit       uint64          `json:"gasLimit"`
+	MaxFee         *big.Int        `json:"maxFee"`
+	MaxPriorityFee *big.Int        `json:"maxPriorityFee"`
+	Call           *ContractCall   `json:"call,omitempty"`
+}
+
+// A minipool deposit for the node account.
+// The validator key doesn't exist until the offline machine creates it, so the deposit's calldata is built when it's signed.
+type UnsignedDeposit struct {
+	UnsignedTransaction
+	MinNodeFee            float64        `json:"minNodeFee"`
+	Salt                  *big.Int       `json:"salt"`
+	MinipoolAddress       common.Address `json:"minipoolAddress"`
+	WithdrawalCredentials common.Hash    `json:"withdrawalCredentials"`
+	GenesisForkVersion    hexutil.Bytes  `json:"genesisForkVersion"`
+}
+
+// A voluntary exit for one of the node's minipool validators
+type UnsignedExit struct {
+	MinipoolAddress common.Address          `json:"minipoolAddress"`
+	Pubkey          rptypes.ValidatorPubkey `json:"pubkey"`
+	ValidatorIndex  uint64                  `json:"validatorIndex"`
+	Epoch           uint64                  `json:"epoch"`
+	SignatureDomain hexutil.Bytes           `json:"signatureDomain"`
+}
+
+// The unsigned transactions and exits built by the online machine for the offline machine to sign
+type UnsignedBundle struct {
+	Version      uint64                 `json:"version"`
+	ChainID      uint64                 `json:"chainId"`
+	NodeAddress  common.Address         `json:"nodeAddress"`
+	Transactions []*UnsignedTransaction `json:"transactions"`
+	Deposits     []*UnsignedDeposit     `json:"deposits"`
+	Exits        []*UnsignedExit        `json:"exits"`
+}
+
+// A transaction signed by the offline machine
+type SignedTransaction struct {
+	Description string        `json:"description"`
+	Nonce       uint64        `json:"nonce"`
+	Hash        common.Hash   `json:"hash"`
+	Raw         hexutil.Bytes `json:"raw"`
+
+	// For deposits, the new minipool and its validator key as an EIP-2335 keystore so the online machine can validate with it
+	MinipoolAddress   *common.Address `json:"minipoolAddress,omitempty"`
+	ValidatorKeystore json.RawMessage `json:"validatorKeystore,omitempty"`
+}
+
+// The signed transactions and exits returned by the offline machine for the online machine to broadcast
+type SignedBundle struct {
+	Version      uint64                      `json:"version"`
+	ChainID      uint64                      `json:"chainId"`
+	NodeAddress  common.Address              `json:"nodeAddress"`
+	Transactions []*SignedTransaction        `json:"transactions"`
+	Exits        []validator.ExitBundleEntry `json:"exits"`
+}
+
+// Check the contract a transaction calls against the contracts whose addresses are known without network access.
+// Returns false if the contract name comes only from the bundle and couldn't be verified.
+func (t *UnsignedTransaction) CheckContract(knownContracts map[common.Address]string) (bool, error) {
+	if t.Call == nil || t.To == nil {
+		return true, nil
+	}
+	if name, exists := knownContracts[*t.To]; exists {
+		if name != t.Call.Contract {
+			return false, fmt.Errorf("Transaction '%s' claims to call %s, but %s is the %s contract.", t.Description, t.Call.Contract, t.To.Hex(), name)
+		}
+		return true, nil
+	}
+	for address, name := range knownContracts {
+		if name == t.Call.Contract {
+			return false, fmt.Errorf("Transaction '%s' claims to call %s, but it calls %s instead of %s.", t.Description, t.Call.Contract, t.To.Hex(), address.Hex())
+		}
+	}
+	return false, nil
+}
+
+// Check that a deposit's withdrawal credentials point at its minipool, that it's for the expected network, and that it calls the deposit method.
+// The salt doesn't need to be checked; the deposit contract rejects the deposit unless the salt creates the deposit's minipool.
+func (d *UnsignedDeposit) Check(genesisForkVersion []byte) error {
+	expectedCredentials := GetMinipoolWithdrawalCredentials(d.MinipoolAddress)
+	if d.WithdrawalCredentials != expectedCredentials {
+		return fmt.Errorf("Deposit '%s' has withdrawal credentials %s, but minipool %s must use %s.", d.Description, d.WithdrawalCredentials.Hex(), d.MinipoolAddress.Hex(), expectedCredentials.Hex())
+	}
+	if len(genesisForkVersion) > 0 && !bytes.Equal(d.GenesisForkVersion, genesisForkVersion) {
+		return fmt.Errorf("Deposit '%s' is for genesis fork version %s, but this node's network uses %s.", d.Description, d.GenesisForkVersion.String(), hexutil.Encode(genesisForkVersion))
+	}
+	if d.Call == nil || d.Call.Contract != DepositContractName || d.Call.Method != DepositMethod {
+		return fmt.Errorf("Deposit '%s' does not call %s.%s.", d.Description, DepositContractName, DepositMethod)
+	}
+	if d.Salt == nil || d.Salt.Sign() < 0 {
+		return fmt.Errorf("Deposit '%s' does not have a valid salt.", d.Description)
+	}
+	return nil
+}
+
+// Get the withdrawal credentials of a minipool's validator: the 0x01 prefix, 11 zero bytes, then the minipool's address
+func GetMinipoolWithdrawalCredentials(minipoolAddress common.Address) common.Hash {
+	var credentials common.Hash
+	credentials[0] = 0x01
+	copy(credentials[12:], minipoolAddress.Bytes())
+	return credentials
+}
+
+// Get the Rocket Pool contracts whose addresses are in the config, so they can be checked without network access
+func GetKnownContracts(cfg *config.RocketPoolConfig) map[common.Address]string {
+	return map[common.Address]string{
+		common.HexToAddress(cfg.Smartnode.GetRplTokenAddress()): "rocketTokenRPL",
+		cfg.Smartnode.GetRethAddress():                          "rocketTokenRETH",
+	}
+}
+
+// Create an unsigned transaction from one built for the node account
+func NewUnsignedTransaction(description string, tx *types.Transaction) *UnsignedTransaction {
+	return &UnsignedTransaction{
+		Description:    description,
+		Nonce:          tx.Nonce(),
+		To:             tx.To(),
+		Value:          tx.Value(),
+		Data:           tx.Data(),
+		GasLimit:       tx.Gas(),
+		MaxFee:         tx.GasFeeCap(),
+		MaxPriorityFee: tx.GasTipCap(),
+	}
+}
+
+// Get the transaction to sign
+func (t *UnsignedTransaction) GetTransaction(chainID uint64) *types.Transaction {
+	value := t.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   new(big.Int).SetUint64(chainID),
+		Nonce:     t.Nonce,
+		GasTipCap: t.MaxPriorityFee,
+		GasFeeCap: t.MaxFee,
+		Gas:       t.GasLimit,
+		To:        t.To,
+		Value:     value,
+		Data:      t.Data,
+	})
+}
+
+// Create a new, empty bundle of unsigned transactions
+func NewUnsignedBundle(chainID uint64, nodeAddress common.Address) *UnsignedBundle {
+	return &UnsignedBundle{
+		Version:      BundleVersion,
+		ChainID:      chainID,
+		NodeAddress:  nodeAddress,
+		Transactions: []*UnsignedTransaction{},
+		Deposits:     []*UnsignedDeposit{},
+		Exits:        []*UnsignedExit{},
+	}
+}
+
+// Load a bundle of unsigned transactions from a file or a payload
+func LoadUnsignedBundle(source string) (*UnsignedBundle, error) {
+	bundle := new(UnsignedBundle)
+	if err := load(source, bundle); err != nil {
+		return nil, err
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("Unsupported offline bundle version %d.", bundle.Version)
+	}
+	return bundle, nil
+}
+
+// Get the nonce for the next transaction added to the bundle, or 0 if it's empty
+func (b *UnsignedBundle) GetNextNonce() uint64 {
+	var nonce uint64
+	for _, tx := range b.Transactions {
+		if tx.Nonce+1 > nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+	for _, deposit := range b.Deposits {
+		if deposit.Nonce+1 > nonce {
+			nonce = deposit.Nonce + 1
+		}
+	}
+	return nonce
+}
+
+// Check that the bundle is for the provided network and node
+func (b *UnsignedBundle) Check(chainID uint64, nodeAddress common.Address) error {
+	if b.ChainID != chainID {
+		return fmt.Errorf("The offline bundle is for chain %d, but this node is on chain %d.", b.ChainID, chainID)
+	}
+	if b.NodeAddress != nodeAddress {
+		return fmt.Errorf("The offline bundle is for node %s, but this node is %s.", b.NodeAddress.Hex(), nodeAddress.Hex())
+	}
+	return nil
+}
+
+// Save the bundle to a file
+func (b *UnsignedBundle) Save(path string) error {
+	return save(path, b)
+}
+
+// Load a bundle of signed transactions from a file or a payload
+func LoadSignedBundle(source string) (*SignedBundle, error) {
+	bundle := new(SignedBundle)
+	if err := load(source, bundle); err != nil {
+		return nil, err
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("Unsupported offline bundle version %d.", bundle.Version)
+	}
+	return bundle, nil
+}
+
+// Sort the bundle's transactions into the order they must be broadcast in
+func (b *SignedBundle) SortTransactions() {
+	sort.SliceStable(b.Transactions, func(i, j int) bool {
+		return b.Transactions[i].Nonce < b.Transactions[j].Nonce
+	})
+}
+
+// Save the bundle to a file
+func (b *SignedBundle) Save(path string) error {
+	return save(path, b)
+}
+
+// Encode a bundle as a single line of text, e.g. to transfer it as a QR code
+func EncodePayload(bundle interface{}) (string, error) {
+	bundleBytes, err := json.Marshal(bundle)
+	if err != nil {
+		return "", fmt.Errorf("Could not serialize offline bundle: %w", err)
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(bundleBytes); err != nil {
+		return "", fmt.Errorf("Could not compress offline bundle: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("Could not compress offline bundle: %w", err)
+	}
+	return PayloadPrefix + base64.RawURLEncoding.EncodeToString(compressed.Bytes()), nil
+}
+
+// Load a bundle from a file, or from a payload created by EncodePayload
+func load(source string, bundle interface{}) error {
+	var bundleBytes []byte
+	if strings.HasPrefix(source, PayloadPrefix) {
+		compressed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(source, PayloadPrefix))
+		if err != nil {
+			return fmt.Errorf("Invalid offline bundle payload: %w", err)
+		}
+		reader, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return fmt.Errorf("Invalid offline bundle payload: %w", err)
+		}
+		bundleBytes, err = ioutil.ReadAll(reader)
+		if err != nil {
+			return fmt.Errorf("Invalid offline bundle payload: %w", err)
+		}
+	} else {
+		var err error
+		bundleBytes, err = ioutil.ReadFile(source)
+		if err != nil {
+			return fmt.Errorf("Could not read offline bundle %s: %w", source, err)
+		}
+	}
+	if err := json.Unmarshal(bundleBytes, bundle); err != nil {
+		return fmt.Errorf("Could not deserialize offline bundle: %w", err)
+	}
+	return nil
+}
+
+// Save a bundle to a file
+func save(path string, bundle interface{}) error {
+	bundleBytes, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Could not serialize offline bundle: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bundleBytes, FileMode); err != nil {
+		return fmt.Errorf("Could not write offline bundle %s: %w", path, err)
+	}
+	return nil
+}
+
+// Check if a file exists, so a bundle can be added to instead of replaced
+func Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,209 @@
+package passwords
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// Config
+const agentTimeout = 5 * time.Second
+
+// Commands understood by the password agent
+const (
+	agentCommandGet    string = "get"
+	agentCommandUnlock string = "unlock"
+	agentCommandLock   string = "lock"
+)
+
+// Returned when the password is held by a locked agent
+var ErrLocked = errors.New("The node wallet is locked. Please run 'rocketpool wallet unlock' and try again.")
+
+// A request sent to the password agent
+type agentRequest struct {
+	Command  string `json:"command"`
+	Password string `json:"password,omitempty"`
+}
+
+// A response from the password agent
+type agentResponse struct {
+	Locked   bool   `json:"locked"`
+	Password string `json:"password,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Holds the node password in memory and serves it to the other Smartnode processes over a unix socket.
+// It starts locked and stays locked until the password is provided with `rocketpool wallet unlock`.
+type Agent struct {
+	socketPath string
+	listener   net.Listener
+	password   string
+	lock       sync.Mutex
+}
+
+// Create new password agent
+func NewAgent(socketPath string) *Agent {
+	return &Agent{
+		socketPath: socketPath,
+	}
+}
+
+// Start listening for requests
+func (a *Agent) Start() error {
+
+	// Remove a stale socket from a previous run
+	if err := os.Remove(a.socketPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Could not remove old password agent socket: %w", err)
+	}
+
+	// Listen on the socket and restrict it to the owner
+	listener, err := net.Listen("unix", a.socketPath)
+	if err != nil {
+		return fmt.Errorf("Could not start password agent: %w", err)
+	}
+	if err := os.Chmod(a.socketPath, FileMode); err != nil {
+		listener.Close()
+		return fmt.Errorf("Could not set password agent socket permissions: %w", err)
+	}
+	a.listener = listener
+
+	// Serve requests
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go a.handle(conn)
+		}
+	}()
+	return nil
+
+}
+
+// Stop listening and discard the password
+func (a *Agent) Close() error {
+	a.lock.Lock()
+	a.password = ""
+	a.lock.Unlock()
+	if a.listener == nil {
+		return nil
+	}
+	return a.listener.Close()
+}
+
+// Handle a single request
+func (a *Agent) handle(conn net.Conn) {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(agentTimeout))
+
+	var request agentRequest
+	if err := json.NewDecoder(conn).Decode(&request); err != nil {
+		return
+	}
+
+	a.lock.Lock()
+	response := agentResponse{}
+	switch request.Command {
+	case agentCommandGet:
+		response.Password = a.password
+	case agentCommandUnlock:
+		if len(request.Password) < MinPasswordLength {
+			response.Error = fmt.Sprintf("Password must be at least %d characters long", MinPasswordLength)
+		} else {
+			a.password = request.Password
+		}
+	case agentCommandLock:
+		a.password = ""
+	default:
+		response.Error = fmt.Sprintf("Unknown command '%s'", request.Command)
+	}
+	response.Locked = (a.password == "")
+	a.lock.Unlock()
+
+	_ = json.NewEncoder(conn).Encode(response)
+}
+
+// Gets the password from the password agent run by the node daemon
+type AgentStore struct {
+	socketPath string
+}
+
+// Create new password agent store
+func NewAgentStore(socketPath string) *AgentStore {
+	return &AgentStore{
+		socketPath: socketPath,
+	}
+}
+
+// Send a request to the agent
+func (s *AgentStore) request(request agentRequest) (*agentResponse, error) {
+	conn, err := net.DialTimeout("unix", s.socketPath, agentTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to the password agent; is the node daemon running? %w", err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(agentTimeout))
+
+	if err := json.NewEncoder(conn).Encode(request); err != nil {
+		return nil, fmt.Errorf("Could not send request to the password agent: %w", err)
+	}
+	var response agentResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return nil, fmt.Errorf("Could not read response from the password agent: %w", err)
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	return &response, nil
+}
+
+// Check if the password has been set
+func (s *AgentStore) IsPasswordSet() bool {
+	response, err := s.request(agentRequest{Command: agentCommandGet})
+	return err == nil && !response.Locked
+}
+
+// Get the password
+func (s *AgentStore) GetPassword() (string, error) {
+	response, err := s.request(agentRequest{Command: agentCommandGet})
+	if err != nil {
+		return "", err
+	}
+	if response.Locked {
+		return "", ErrLocked
+	}
+	return response.Password, nil
+}
+
+// Set the password; for a new wallet this simply unlocks the agent with it
+func (s *AgentStore) SetPassword(password string) error {
+	return s.Unlock(password)
+}
+
+// Delete the password; nothing is persisted, so this just locks the agent if it's running
+func (s *AgentStore) DeletePassword() error {
+	_ = s.Lock()
+	return nil
+}
+
+// Check if the agent is locked
+func (s *AgentStore) IsLocked() bool {
+	return !s.IsPasswordSet()
+}
+
+// Unlock the agent with the given password
+func (s *AgentStore) Unlock(password string) error {
+	_, err := s.request(agentRequest{Command: agentCommandUnlock, Password: password})
+	return err
+}
+
+// Lock the agent, discarding the password from its memory
+func (s *AgentStore) Lock() error {
+	_, err := s.request(agentRequest{Command: agentCommandLock})
+	return err
+}
@@ -0,0 +1,105 @@
+package passwords
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Reads the password from an environment variable set by an orchestrator
+type EnvStore struct {
+	name string
+}
+
+// Create new environment variable password store
+func NewEnvStore(name string) *EnvStore {
+	return &EnvStore{
+		name: name,
+	}
+}
+
+// Check if the password has been set
+func (s *EnvStore) IsPasswordSet() bool {
+	return os.Getenv(s.name) != ""
+}
+
+// Get the password
+func (s *EnvStore) GetPassword() (string, error) {
+	password := os.Getenv(s.name)
+	if password == "" {
+		return "", fmt.Errorf("The %s environment variable is not set", s.name)
+	}
+	return password, nil
+}
+
+// Set the password
+func (s *EnvStore) SetPassword(password string) error {
+	return fmt.Errorf("The node password is provided by the %s environment variable; please set it there instead", s.name)
+}
+
+// Delete the password
+func (s *EnvStore) DeletePassword() error {
+	// Nothing is stored, so there is nothing to delete
+	return nil
+}
+
+// Reads the password once from a file descriptor passed in by an orchestrator
+type FdStore struct {
+	fd       uintptr
+	password string
+	err      error
+	once     sync.Once
+}
+
+// Create new file descriptor password store
+func NewFdStore(fd uintptr) *FdStore {
+	return &FdStore{
+		fd: fd,
+	}
+}
+
+// Read the password from the file descriptor; it can only be read once, so it's kept in memory
+func (s *FdStore) read() {
+	s.once.Do(func() {
+		file := os.NewFile(s.fd, "password")
+		if file == nil {
+			s.err = fmt.Errorf("File descriptor %d is not valid", s.fd)
+			return
+		}
+		defer file.Close()
+		bytes, err := ioutil.ReadAll(file)
+		if err != nil {
+			s.err = fmt.Errorf("Could not read password from file descriptor %d: %w", s.fd, err)
+			return
+		}
+		s.password = strings.TrimRight(string(bytes), "\r\n")
+		if s.password == "" {
+			s.err = fmt.Errorf("File descriptor %d did not contain a password", s.fd)
+		}
+	})
+}
+
+// Check if the password has been set
+func (s *FdStore) IsPasswordSet() bool {
+	s.read()
+	return s.err == nil
+}
+
+// Get the password
+func (s *FdStore) GetPassword() (string, error) {
+	s.read()
+	return s.password, s.err
+}
+
+// Set the password
+func (s *FdStore) SetPassword(password string) error {
+	return fmt.Errorf("The node password is provided by file descriptor %d; please pass it there instead", s.fd)
+}
+
+// Delete the password
+func (s *FdStore) DeletePassword() error {
+	// Nothing is stored, so there is nothing to delete
+	return nil
+}
@@ -0,0 +1,69 @@
+package passwords
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// Stores the password in a plain file on disk
+type FileStore struct {
+	passwordPath string
+}
+
+// Create new file password store
+func NewFileStore(passwordPath string) *FileStore {
+	return &FileStore{
+		passwordPath: passwordPath,
+	}
+}
+
+// Check if the password has been set
+func (s *FileStore) IsPasswordSet() bool {
+	_, err := ioutil.ReadFile(s.passwordPath)
+	return (err == nil)
+}
+
+// Get the password
+func (s *FileStore) GetPassword() (string, error) {
+
+	// Read from disk
+	password, err := ioutil.ReadFile(s.passwordPath)
+	if err != nil {
+		return "", fmt.Errorf("Could not read password from disk: %w", err)
+	}
+
+	// Return
+	return string(password), nil
+
+}
+
+// Set the password
+func (s *FileStore) SetPassword(password string) error {
+
+	// Write to disk
+	if err := ioutil.WriteFile(s.passwordPath, []byte(password), FileMode); err != nil {
+		return fmt.Errorf("Could not write password to disk: %w", err)
+	}
+
+	// Return
+	return nil
+
+}
+
+// Delete the password
+func (s *FileStore) DeletePassword() error {
+
+	// Check if it exists
+	_, err := os.Stat(s.passwordPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error checking password file path: %w", err)
+	}
+
+	// Delete it
+	err = os.Remove(s.passwordPath)
+	return err
+
+}
@@ -0,0 +1,78 @@
+package passwords
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// The kernel key type used to hold the password
+const keyringKeyType string = "user"
+
+// Stores the password in the Linux kernel's user keyring
+type KeyringStore struct {
+	description string
+}
+
+// Create new kernel keyring password store
+func NewKeyringStore(name string) *KeyringStore {
+	return &KeyringStore{
+		description: "rocketpool:" + name,
+	}
+}
+
+// Get the ID of the password key
+func (s *KeyringStore) find() (int, error) {
+	return unix.KeyctlSearch(unix.KEY_SPEC_USER_KEYRING, keyringKeyType, s.description, 0)
+}
+
+// Check if the password has been set
+func (s *KeyringStore) IsPasswordSet() bool {
+	_, err := s.find()
+	return (err == nil)
+}
+
+// Get the password
+func (s *KeyringStore) GetPassword() (string, error) {
+
+	// Find the key
+	id, err := s.find()
+	if err != nil {
+		return "", fmt.Errorf("Could not find %s in the kernel keyring: %w", s.description, err)
+	}
+
+	// Read it
+	length, err := unix.KeyctlBuffer(unix.KEYCTL_READ, id, nil, 0)
+	if err != nil {
+		return "", fmt.Errorf("Could not read password from the kernel keyring: %w", err)
+	}
+	buffer := make([]byte, length)
+	length, err = unix.KeyctlBuffer(unix.KEYCTL_READ, id, buffer, 0)
+	if err != nil {
+		return "", fmt.Errorf("Could not read password from the kernel keyring: %w", err)
+	}
+
+	// Return
+	return string(buffer[:length]), nil
+
+}
+
+// Set the password
+func (s *KeyringStore) SetPassword(password string) error {
+	if _, err := unix.AddKey(keyringKeyType, s.description, []byte(password), unix.KEY_SPEC_USER_KEYRING); err != nil {
+		return fmt.Errorf("Could not add password to the kernel keyring: %w", err)
+	}
+	return nil
+}
+
+// Delete the password
+func (s *KeyringStore) DeletePassword() error {
+	id, err := s.find()
+	if err != nil {
+		return nil
+	}
+	if _, err := unix.KeyctlInt(unix.KEYCTL_UNLINK, id, unix.KEY_SPEC_USER_KEYRING, 0, 0); err != nil {
+		return fmt.Errorf("Could not remove password from the kernel keyring: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+//go:build !linux
+// +build !linux
+
+package passwords
+
+import "errors"
+
+var errKeyringUnsupported = errors.New("The kernel keyring password backend is only supported on Linux")
+
+// Stores the password in the Linux kernel's user keyring
+type KeyringStore struct{}
+
+// Create new kernel keyring password store
+func NewKeyringStore(name string) *KeyringStore {
+	return &KeyringStore{}
+}
+
+// Check if the password has been set
+func (s *KeyringStore) IsPasswordSet() bool {
+	return false
+}
+
+// Get the password
+func (s *KeyringStore) GetPassword() (string, error) {
+	return "", errKeyringUnsupported
+}
+
+// Set the password
+func (s *KeyringStore) SetPassword(password string) error {
+	return errKeyringUnsupported
+}
+
+// Delete the password
+func (s *KeyringStore) DeletePassword() error {
+	return errKeyringUnsupported
+}
@@ -3,8 +3,6 @@ package passwords
 import (
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"os"
 )
 
 // Config
@@ -13,36 +11,58 @@ const (
 	FileMode          = 0600
 )
 
+// A source of the node password
+type Store interface {
+	IsPasswordSet() bool
+	GetPassword() (string, error)
+	SetPassword(password string) error
+	DeletePassword() error
+}
+
+// A password store that starts locked and must be unlocked at runtime
+type Unlocker interface {
+	IsLocked() bool
+	Unlock(password string) error
+	Lock() error
+}
+
 // Password manager
 type PasswordManager struct {
-	passwordPath string
+	store Store
 }
 
-// Create new password manager
+// Create new password manager backed by a file on disk
 func NewPasswordManager(passwordPath string) *PasswordManager {
+	return NewPasswordManagerWithStore(NewFileStore(passwordPath))
+}
+
+// Create new password manager backed by the given store
+func NewPasswordManagerWithStore(store Store) *PasswordManager {
 	return &PasswordManager{
-		passwordPath: passwordPath,
+		store: store,
 	}
 }
 
 // Check if the password has been set
 func (pm *PasswordManager) IsPasswordSet() bool {
-	_, err := ioutil.ReadFile(pm.passwordPath)
-	return (err == nil)
+	return pm.store.IsPasswordSet()
 }
 
-// Get the password
-func (pm *PasswordManager) GetPassword() (string, error) {
-
-	// Read from disk
-	password, err := ioutil.ReadFile(pm.passwordPath)
-	if err != nil {
-		return "", fmt.Errorf("Could not read password from disk: %w", err)
-	}
+// Check if the password is held by a store that is currently locked
+func (pm *PasswordManager) IsLocked() bool {
+	unlocker, ok := pm.store.(Unlocker)
+	return ok && unlocker.IsLocked()
+}
 
-	// Return
-	return string(password), nil
+// Check if the password store can be unlocked at runtime
+func (pm *PasswordManager) CanUnlock() bool {
+	_, ok := pm.store.(Unlocker)
+	return ok
+}
 
+// Get the password
+func (pm *PasswordManager) GetPassword() (string, error) {
+	return pm.store.GetPassword()
 }
 
 // Set the password
@@ -58,29 +78,30 @@ func (pm *PasswordManager) SetPassword(password string) error {
 		return fmt.Errorf("Password must be at least %d characters long", MinPasswordLength)
 	}
 
-	// Write to disk
-	if err := ioutil.WriteFile(pm.passwordPath, []byte(password), FileMode); err != nil {
-		return fmt.Errorf("Could not write password to disk: %w", err)
-	}
-
-	// Return
-	return nil
+	// Store it
+	return pm.store.SetPassword(password)
 
 }
 
-// Delete the password
-func (pm *PasswordManager) DeletePassword() error {
-
-	// Check if it exists
-	_, err := os.Stat(pm.passwordPath)
-	if os.IsNotExist(err) {
-		return nil
-	} else if err != nil {
-		return fmt.Errorf("error checking password file path: %w", err)
+// Unlock the password store with the given password
+func (pm *PasswordManager) Unlock(password string) error {
+	unlocker, ok := pm.store.(Unlocker)
+	if !ok {
+		return errors.New("The node password backend does not support unlocking")
 	}
+	return unlocker.Unlock(password)
+}
 
-	// Delete it
-	err = os.Remove(pm.passwordPath)
-	return err
+// Lock the password store, discarding the password from memory
+func (pm *PasswordManager) Lock() error {
+	unlocker, ok := pm.store.(Unlocker)
+	if !ok {
+		return errors.New("The node password backend does not support locking")
+	}
+	return unlocker.Lock()
+}
 
+// Delete the password
+func (pm *PasswordManager) DeletePassword() error {
+	return pm.store.DeletePassword()
 }
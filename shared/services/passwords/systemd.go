@@ -0,0 +1,63 @@
+package passwords
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// The environment variable systemd uses to expose the credentials folder to a service
+const credentialsDirectoryEnvVar string = "CREDENTIALS_DIRECTORY"
+
+// Reads the password from a credential that systemd decrypted for the service (see `systemd-creds`)
+type SystemdCredentialStore struct {
+	name string
+}
+
+// Create new systemd credential password store
+func NewSystemdCredentialStore(name string) *SystemdCredentialStore {
+	return &SystemdCredentialStore{
+		name: name,
+	}
+}
+
+// Get the path of the decrypted credential
+func (s *SystemdCredentialStore) getPath() (string, error) {
+	dir := os.Getenv(credentialsDirectoryEnvVar)
+	if dir == "" {
+		return "", fmt.Errorf("%s is not set; the Smartnode must be started by systemd with `LoadCredentialEncrypted=%s:...` to use this password backend", credentialsDirectoryEnvVar, s.name)
+	}
+	return filepath.Join(dir, s.name), nil
+}
+
+// Check if the password has been set
+func (s *SystemdCredentialStore) IsPasswordSet() bool {
+	_, err := s.GetPassword()
+	return (err == nil)
+}
+
+// Get the password
+func (s *SystemdCredentialStore) GetPassword() (string, error) {
+	path, err := s.getPath()
+	if err != nil {
+		return "", err
+	}
+	password, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Could not read the %s credential: %w", s.name, err)
+	}
+	return strings.TrimRight(string(password), "\r\n"), nil
+}
+
+// Set the password
+func (s *SystemdCredentialStore) SetPassword(password string) error {
+	return fmt.Errorf("The node password is provided by the %s systemd credential; please create it with `systemd-creds encrypt --name=%s` and load it into the service instead", s.name, s.name)
+}
+
+// Delete the password
+func (s *SystemdCredentialStore) DeletePassword() error {
+	return errors.New("The node password is provided by a systemd credential; please remove it from the service definition instead")
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The ABI used to decode errors from minipools, which aren't registered by address
+const minipoolContractName = "rocketMinipool"
+
+// Selectors of the errors built into Solidity
+var (
+	errorStringSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector       = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// Descriptions of the Solidity panic codes
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop from an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to an uninitialized function",
+}
+
+// Finds the name and ABI of a contract, so the custom errors it reverts with can be decoded
+type ContractResolver func(address common.Address) (string, *abi.ABI)
+
+// An error for a transaction that would revert if it was submitted
+type RevertError struct {
+	Revert api.TransactionRevert
+	Err    error
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("would revert: %s", e.Revert.Reason)
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.Err
+}
+
+// Get the reason a transaction would revert from the error returned by its gas estimate.
+// Errors that aren't reverts are returned so the caller can handle them.
+func GetTransactionRevert(err error) (*api.TransactionRevert, error) {
+	var revertErr *RevertError
+	if errors.As(err, &revertErr) {
+		return &revertErr.Revert, nil
+	}
+	return nil, err
+}
+
+// Simulate a transaction that failed its gas estimate with eth_call at the pending block.
+// If it would revert, the decoded reason is returned as a RevertError; otherwise the estimate error is returned as-is.
+func (p *ExecutionClientManager) preflight(ctx context.Context, call ethereum.CallMsg, estimateErr error) error {
+
+	if p.isDisconnected(estimateErr) {
+		return estimateErr
+	}
+
+	// Run the call
+	_, err := p.PendingCallContract(ctx, call)
+	if err == nil {
+		return estimateErr
+	}
+
+	// Get the revert data, if the client provided it
+	var data []byte
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if hexData, ok := dataErr.ErrorData().(string); ok {
+			data, _ = hexutil.Decode(hexData)
+		}
+	}
+	if len(data) == 0 && !strings.Contains(strings.ToLower(err.Error()), "revert") {
+		return estimateErr
+	}
+
+	// Decode it with the ABI of the contract being called
+	var contractName string
+	var contractAbi *abi.ABI
+	if call.To != nil && p.resolveContract != nil {
+		contractName, contractAbi = p.resolveContract(*call.To)
+	}
+	revert := api.TransactionRevert{
+		Contract: contractName,
+	}
+	if len(data) > 0 {
+		revert.Reason, revert.ErrorName = DecodeRevertData(data, contractAbi)
+		revert.Data = hexutil.Encode(data)
+	} else {
+		revert.Reason = getRevertMessage(err.Error())
+	}
+	return &RevertError{
+		Revert: revert,
+		Err:    estimateErr,
+	}
+
+}
+
+// Decode the data a contract reverted with into a reason and the name of the error.
+// Custom errors are decoded with the contract's ABI if it's provided.
+func DecodeRevertData(data []byte, contractAbi *abi.ABI) (string, string) {
+
+	if len(data) < 4 {
+		return "no reason given", ""
+	}
+	selector := data[:4]
+
+	// Revert strings
+	if bytes.Equal(selector, errorStringSelector) {
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return fmt.Sprintf("invalid revert string (%s)", hexutil.Encode(data)), "Error"
+		}
+		return reason, "Error"
+	}
+
+	// Panics
+	if bytes.Equal(selector, panicSelector) && len(data) == 36 {
+		code := new(big.Int).SetBytes(data[4:]).Uint64()
+		description, exists := panicReasons[code]
+		if !exists {
+			description = "unknown panic"
+		}
+		return fmt.Sprintf("%s (panic code 0x%x)", description, code), "Panic"
+	}
+
+	// Custom errors
+	if contractAbi != nil {
+		for name, errorAbi := range contractAbi.Errors {
+			if !bytes.Equal(selector, errorAbi.ID[:4]) {
+				continue
+			}
+			values, err := errorAbi.Inputs.Unpack(data[4:])
+			if err != nil {
+				return fmt.Sprintf("%s (could not decode its arguments: %s)", name, err.Error()), name
+			}
+			args := make([]string, len(values))
+			for i, value := range values {
+				args[i] = fmt.Sprint(value)
+			}
+			return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", ")), name
+		}
+	}
+
+	return fmt.Sprintf("unknown error %s", hexutil.Encode(data)), ""
+
+}
+
+// Get the reason from a revert error message, for clients that don't provide the revert data
+func getRevertMessage(message string) string {
+	index := strings.Index(strings.ToLower(message), "reverted:")
+	if index < 0 {
+		return "no reason given"
+	}
+	reason := strings.TrimSpace(message[index+len("reverted:"):])
+	if reason == "" {
+		return "no reason given"
+	}
+	return reason
+}
+
+// Create a resolver for the contracts registered with Rocket Pool and its minipools
+func newRocketPoolContractResolver(rp *rocketpool.RocketPool) ContractResolver {
+	return func(address common.Address) (string, *abi.ABI) {
+
+		// Get the name the contract is registered under
+		name, err := rp.RocketStorage.GetString(nil, crypto.Keccak256Hash([]byte("contract.name"), address.Bytes()))
+		if err != nil {
+			return "", nil
+		}
+		if name == "" {
+			isMinipool, err := rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("minipool.exists"), address.Bytes()))
+			if err != nil || !isMinipool {
+				return "", nil
+			}
+			name = minipoolContractName
+		}
+
+		// Get its ABI
+		contractAbi, err := rp.GetABI(name)
+		if err != nil {
+			return name, nil
+		}
+		return name, contractAbi
+
+	}
+}
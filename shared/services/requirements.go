@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
 	"sync"
 	"time"
 
@@ -37,12 +38,26 @@ func RequireNodePassword(c *cli.Context) error {
 		return err
 	}
 	if !nodePasswordSet {
+		nodePasswordLocked, err := getNodePasswordLocked(c)
+		if err != nil {
+			return err
+		}
+		if nodePasswordLocked {
+			return errors.New("The node wallet is locked. Please run 'rocketpool wallet unlock' (or 'rocketpool wallet init' if you have not created a wallet yet) and try again.")
+		}
 		return errors.New("The node password has not been set. Please run 'rocketpool wallet init' and try again.")
 	}
 	return nil
 }
 
 func RequireNodeWallet(c *cli.Context) error {
+	nodeWalletOffline, err := getNodeWalletOffline(c)
+	if err != nil {
+		return err
+	}
+	if nodeWalletOffline {
+		return nil
+	}
 	if err := RequireNodePassword(c); err != nil {
 		return err
 	}
@@ -168,13 +183,28 @@ func WaitNodePassword(c *cli.Context, verbose bool) error {
 			return nil
 		}
 		if verbose {
-			log.Printf("The node password has not been set, retrying in %s...\n", checkNodePasswordInterval.String())
+			nodePasswordLocked, err := getNodePasswordLocked(c)
+			if err != nil {
+				return err
+			}
+			if nodePasswordLocked {
+				log.Printf("The node wallet is locked, waiting for 'rocketpool wallet unlock'; retrying in %s...\n", checkNodePasswordInterval.String())
+			} else {
+				log.Printf("The node password has not been set, retrying in %s...\n", checkNodePasswordInterval.String())
+			}
 		}
 		time.Sleep(checkNodePasswordInterval)
 	}
 }
 
 func WaitNodeWallet(c *cli.Context, verbose bool) error {
+	nodeWalletOffline, err := getNodeWalletOffline(c)
+	if err != nil {
+		return err
+	}
+	if nodeWalletOffline {
+		return nil
+	}
 	if err := WaitNodePassword(c, verbose); err != nil {
 		return err
 	}
@@ -244,6 +274,22 @@ func WaitNodeRegistered(c *cli.Context, verbose bool) error {
 	}
 }
 
+// Check if the node password is set, without waiting for it
+func IsNodePasswordSet(c *cli.Context) (bool, error) {
+	return getNodePasswordSet(c)
+}
+
+// Check if the node wallet is initialized, without waiting for it.
+// This only checks the wallet files, so it doesn't build the wallet while its password is unavailable.
+func IsNodeWalletInitialized(c *cli.Context) (bool, error) {
+	return getNodeWalletFileExists(c)
+}
+
+// Check if the node wallet is watch-only, with its keys kept on an offline machine
+func IsNodeWalletOffline(c *cli.Context) (bool, error) {
+	return getNodeWalletOffline(c)
+}
+
 //
 // Helpers
 //
@@ -257,6 +303,15 @@ func getNodePasswordSet(c *cli.Context) (bool, error) {
 	return pm.IsPasswordSet(), nil
 }
 
+// Check if the node password is held by a locked password store
+func getNodePasswordLocked(c *cli.Context) (bool, error) {
+	pm, err := GetPasswordManager(c)
+	if err != nil {
+		return false, err
+	}
+	return pm.IsLocked(), nil
+}
+
 // Check if the node wallet is initialized
 func getNodeWalletInitialized(c *cli.Context) (bool, error) {
 	w, err := GetWallet(c)
@@ -266,6 +321,36 @@ func getNodeWalletInitialized(c *cli.Context) (bool, error) {
 	return w.GetInitialized()
 }
 
+// Check if the node wallet or a watch-only node address has been saved
+func getNodeWalletFileExists(c *cli.Context) (bool, error) {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return false, err
+	}
+	for _, path := range []string{cfg.Smartnode.GetWalletPath(), cfg.Smartnode.GetOfflineNodeAddressPath()} {
+		if _, err := os.Stat(os.ExpandEnv(path)); err == nil {
+			return true, nil
+		} else if !os.IsNotExist(err) {
+			return false, fmt.Errorf("Could not check %s: %w", path, err)
+		}
+	}
+	return false, nil
+}
+
+// Check if the node wallet is watch-only, with its keys kept on an offline machine.
+// This checks the files directly, because building the wallet fails while its password is locked or missing.
+func getNodeWalletOffline(c *cli.Context) (bool, error) {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(os.ExpandEnv(cfg.Smartnode.GetWalletPath())); err == nil {
+		return false, nil
+	}
+	_, err = os.Stat(os.ExpandEnv(cfg.Smartnode.GetOfflineNodeAddressPath()))
+	return (err == nil), nil
+}
+
 // Check if the RocketStorage contract is loaded
 func getRocketStorageLoaded(c *cli.Context) (bool, error) {
 	cfg, err := GetConfig(c)
@@ -102,6 +102,7 @@ type RewardsFile struct {
 	MerkleTree           *merkletree.MerkleTree    `json:"-"`
 	InvalidNetworkNodes  map[common.Address]uint64 `json:"-"`
 	elSnapshotHeader     *types.Header             `json:"-"`
+	ctx                  context.Context           `json:"-"`
 	log                  log.ColorLogger           `json:"-"`
 	logPrefix            string                    `json:"-"`
 	rp                   *rocketpool.RocketPool    `json:"-"`
@@ -154,9 +155,11 @@ func NewRewardsFile(log log.ColorLogger, logPrefix string, index uint64, startTi
 	}
 }
 
-func (r *RewardsFile) GenerateTree(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client) error {
+// Generate the rewards tree for the interval; generation stops early with the context's error if it's cancelled
+func (r *RewardsFile) GenerateTree(ctx context.Context, rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client) error {
 
 	// Provision some struct params
+	r.ctx = ctx
 	r.rp = rp
 	r.cfg = cfg
 	r.bc = bc
@@ -221,6 +224,7 @@ func (r *RewardsFile) GenerateTree(rp *rocketpool.RocketPool, cfg *config.Rocket
 // Quickly calculates an approximate of the staker's share of the smoothing pool balance without processing Beacon performance
 // Used for approximate returns in the rETH ratio update
 func (r *RewardsFile) ApproximateStakerShareOfSmoothingPool(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client) (*big.Int, error) {
+	r.ctx = context.Background()
 	r.rp = rp
 	r.cfg = cfg
 	r.bc = bc
@@ -386,6 +390,10 @@ func (r *RewardsFile) calculateRplRewards() error {
 	trueNodeEffectiveStakes := map[common.Address]*big.Int{}
 	intervalDurationBig := big.NewInt(int64(intervalDuration.Seconds()))
 	for _, address := range r.nodeAddresses {
+		if err := r.ctx.Err(); err != nil {
+			return err
+		}
+
 		// Get the node's effective stake
 		nodeStake, err := node.GetNodeEffectiveRPLStake(r.rp, address, r.opts)
 		if err != nil {
@@ -598,7 +606,7 @@ func (r *RewardsFile) calculateEthRewards(checkBeaconPerformance bool) error {
 	}
 	r.smoothingPoolAddress = *smoothingPoolContract.Address
 
-	r.smoothingPoolBalance, err = r.rp.Client.BalanceAt(context.Background(), *smoothingPoolContract.Address, r.elSnapshotHeader.Number)
+	r.smoothingPoolBalance, err = r.rp.Client.BalanceAt(r.ctx, *smoothingPoolContract.Address, r.elSnapshotHeader.Number)
 	if err != nil {
 		return fmt.Errorf("error getting smoothing pool balance: %w", err)
 	}
@@ -856,6 +864,9 @@ func (r *RewardsFile) processAttestationsForInterval() error {
 	epochsDone := 0
 	reportStartTime := time.Now()
 	for epoch := startEpoch; epoch < endEpoch+1; epoch++ {
+		if err := r.ctx.Err(); err != nil {
+			return err
+		}
 		if epochsDone == 100 {
 			timeTaken := time.Since(reportStartTime)
 			r.log.Printlnf("%s On Epoch %d of %d (%.2f%%)... (%s so far)", r.logPrefix, epoch, endEpoch, float64(epoch-startEpoch)/float64(endEpoch-startEpoch)*100.0, timeTaken)
@@ -1059,6 +1070,9 @@ func (r *RewardsFile) getSmoothingPoolNodeDetails() error {
 	nodeCount := uint64(len(r.nodeAddresses))
 	r.nodeDetails = make([]*NodeSmoothingDetails, nodeCount)
 	for batchStartIndex := uint64(0); batchStartIndex < nodeCount; batchStartIndex += SmoothingPoolDetailsBatchSize {
+		if err := r.ctx.Err(); err != nil {
+			return err
+		}
 
 		// Get batch start & end index
 		iterationStartIndex := batchStartIndex
@@ -1196,6 +1210,9 @@ func (r *RewardsFile) getStartBlocksForInterval(previousIntervalEvent rewards.Re
 	// Get the first block that isn't missing
 	var elBlockNumber uint64
 	for {
+		if err := r.ctx.Err(); err != nil {
+			return nil, err
+		}
 		beaconBlock, exists, err := r.bc.GetBeaconBlock(fmt.Sprint(r.ConsensusStartBlock))
 		if err != nil {
 			return nil, fmt.Errorf("error getting EL data for BC slot %d: %w", r.ConsensusStartBlock, err)
@@ -1213,14 +1230,14 @@ func (r *RewardsFile) getStartBlocksForInterval(previousIntervalEvent rewards.Re
 	if elBlockNumber == 0 {
 		// We are pre-merge, so get the first block after the one from the previous interval
 		r.ExecutionStartBlock = previousIntervalEvent.ExecutionBlock.Uint64() + 1
-		startElHeader, err = r.rp.Client.HeaderByNumber(context.Background(), big.NewInt(int64(r.ExecutionStartBlock)))
+		startElHeader, err = r.rp.Client.HeaderByNumber(r.ctx, big.NewInt(int64(r.ExecutionStartBlock)))
 		if err != nil {
 			return nil, fmt.Errorf("error getting EL start block %d: %w", r.ExecutionStartBlock, err)
 		}
 	} else {
 		// We are post-merge, so get the EL block corresponding to the BC block
 		r.ExecutionStartBlock = elBlockNumber
-		startElHeader, err = r.rp.Client.HeaderByNumber(context.Background(), big.NewInt(int64(elBlockNumber)))
+		startElHeader, err = r.rp.Client.HeaderByNumber(r.ctx, big.NewInt(int64(elBlockNumber)))
 		if err != nil {
 			return nil, fmt.Errorf("error getting EL header for block %d: %w", elBlockNumber, err)
 		}
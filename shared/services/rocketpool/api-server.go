@@ -0,0 +1,134 @@
+package rocketpool
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Settings
+const apiServerDialTimeout = 2 * time.Second
+const apiServerCallTimeout = 5 * time.Minute
+
+// Run an API call through the API server if it's running.
+// Returns false if the call never reached the server, in which case the API command should be run directly instead.
+func (c *Client) callApiServer(args string, otherArgs ...string) ([]byte, bool, error) {
+
+	// The socket can't be reached over SSH
+	if c.client != nil {
+		return nil, false, nil
+	}
+
+	// Get the socket path
+	socketPath, err := c.getApiSocketPath()
+	if err != nil {
+		return nil, false, nil
+	}
+	if _, err := os.Stat(socketPath); err != nil {
+		return nil, false, nil
+	}
+
+	// Build the request; the args are passed as-is, so they don't need to be escaped
+	request := api.ServerCallRequest{
+		Args:            append(strings.Fields(args), otherArgs...),
+		IgnoreSyncCheck: c.ignoreSyncCheck,
+		ForceFallbacks:  c.forceFallbacks,
+		MaxFee:          c.maxFee,
+		MaxPrioFee:      c.maxPrioFee,
+		GasLimit:        c.gasLimit,
+	}
+	if c.customNonce != nil {
+		request.Nonce = c.customNonce.String()
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, true, fmt.Errorf("error serializing API call: %w", err)
+	}
+	if c.debugPrint {
+		fmt.Printf("To API server (%s):\n", socketPath)
+		fmt.Println(string(body))
+	}
+
+	// Send it; calls that take longer than the timeout are given up on, since the server may be stuck
+	httpClient := &http.Client{
+		Timeout: apiServerCallTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				dialer := net.Dialer{Timeout: apiServerDialTimeout}
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+	response, err := httpClient.Post("http://api-server"+api.ServerCallPath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			// The server isn't running, or this user can't access it
+			if c.debugPrint {
+				fmt.Printf("Couldn't connect to the API server (%s), running the API command directly.\n", err.Error())
+			}
+			return nil, false, nil
+		}
+		// Waiting for a transaction is safe to repeat, so run it directly instead
+		if len(request.Args) > 0 && (request.Args[0] == "wait" || request.Args[0] == "t") {
+			if c.debugPrint {
+				fmt.Printf("The API server didn't finish waiting for the transaction (%s), waiting for it directly.\n", err.Error())
+			}
+			return nil, false, nil
+		}
+		// The call may have run, so it can't be retried
+		return nil, true, fmt.Errorf("error communicating with the API server: %w", err)
+	}
+	defer response.Body.Close()
+	output, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("error reading the API server response: %w", err)
+	}
+
+	// The server rejects calls it can't run before running them
+	if response.StatusCode != http.StatusOK {
+		if c.debugPrint {
+			fmt.Printf("The API server couldn't run the call (%s: %s), running the API command directly.\n", response.Status, strings.TrimSpace(string(output)))
+		}
+		return nil, false, nil
+	}
+
+	if c.debugPrint {
+		fmt.Println("API Out:")
+		fmt.Println(string(output))
+	}
+	return output, true, nil
+
+}
+
+// Get the path of the API server's socket on this machine
+func (c *Client) getApiSocketPath() (string, error) {
+	cfg, isNew, err := c.LoadConfig()
+	if err != nil {
+		return "", err
+	}
+	if isNew {
+		return "", errors.New("the Smartnode has not been configured yet")
+	}
+
+	// The data folder is mounted into the API container, so the host path is used in both modes
+	dataPath, err := homedir.Expand(os.ExpandEnv(cfg.Smartnode.DataPath.Value.(string)))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dataPath, config.ApiSocketFilename), nil
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
 	"math/big"
 	"net"
@@ -17,7 +16,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/a8m/envsubst"
 	"github.com/fatih/color"
 	"github.com/urfave/cli"
 	"golang.org/x/crypto/ssh"
@@ -30,6 +28,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
+	"gopkg.in/yaml.v2"
 )
 
 // Config
@@ -89,6 +88,7 @@ type Client struct {
 	gasLimit           uint64
 	customNonce        *big.Int
 	client             *ssh.Client
+	remoteHome         string
 	originalMaxFee     float64
 	originalMaxPrioFee float64
 	originalGasLimit   uint64
@@ -105,14 +105,17 @@ func NewClientFromCtx(c *cli.Context) (*Client, error) {
 		c.GlobalFloat64("maxPrioFee"),
 		c.GlobalUint64("gasLimit"),
 		c.GlobalString("nonce"),
-		c.GlobalBool("debug"))
+		c.GlobalBool("debug"),
+		SSHSettings{
+			Host:    c.GlobalString("host"),
+			User:    c.GlobalString("ssh-user"),
+			KeyPath: c.GlobalString("ssh-key"),
+		})
 }
 
 // Create new Rocket Pool client
-func NewClient(configPath string, daemonPath string, maxFee float64, maxPrioFee float64, gasLimit uint64, customNonce string, debug bool) (*Client, error) {
+func NewClient(configPath string, daemonPath string, maxFee float64, maxPrioFee float64, gasLimit uint64, customNonce string, debug bool, sshSettings SSHSettings) (*Client, error) {
 
-	// Initialize SSH client if configured for SSH
-	var sshClient *ssh.Client
 	var customNonceBigInt *big.Int = nil
 	var success bool
 	if customNonce != "" {
@@ -133,32 +136,45 @@ func NewClient(configPath string, daemonPath string, maxFee float64, maxPrioFee
 		originalMaxPrioFee: maxPrioFee,
 		originalGasLimit:   gasLimit,
 		customNonce:        customNonceBigInt,
-		client:             sshClient,
 		debugPrint:         debug,
 		forceFallbacks:     false,
 		ignoreSyncCheck:    false,
 	}
 
+	// Connect to the remote node if configured for SSH
+	if sshSettings.Host != "" {
+		if client.daemonPath != "" {
+			return nil, errors.New("remote management over SSH is not supported in Native Mode (with '--daemon-path' option specified)")
+		}
+		if err := client.connectSSH(sshSettings); err != nil {
+			return nil, err
+		}
+	}
+
 	return client, nil
 
 }
 
 // Close client remote connection
 func (c *Client) Close() {
-	if c.client != nil {
-		_ = c.client.Close()
-	}
+	// The SSH connection is shared by every client in this process and closes when it exits
+	c.client = nil
+}
+
+// Check if the client manages a remote Smartnode over SSH
+func (c *Client) IsRemote() bool {
+	return c.client != nil
 }
 
 // Load the config
 func (c *Client) LoadConfig() (*config.RocketPoolConfig, bool, error) {
 	settingsFilePath := filepath.Join(c.configPath, SettingsFile)
-	expandedPath, err := homedir.Expand(settingsFilePath)
+	expandedPath, err := c.expandPath(settingsFilePath)
 	if err != nil {
 		return nil, false, fmt.Errorf("error expanding settings file path: %w", err)
 	}
 
-	cfg, err := rp.LoadConfigFromFile(expandedPath)
+	cfg, err := c.loadConfigFromFile(expandedPath)
 	if err != nil {
 		return nil, false, err
 	}
@@ -174,40 +190,80 @@ func (c *Client) LoadConfig() (*config.RocketPoolConfig, bool, error) {
 // Load the backup config
 func (c *Client) LoadBackupConfig() (*config.RocketPoolConfig, error) {
 	settingsFilePath := filepath.Join(c.configPath, BackupSettingsFile)
-	expandedPath, err := homedir.Expand(settingsFilePath)
+	expandedPath, err := c.expandPath(settingsFilePath)
 	if err != nil {
 		return nil, fmt.Errorf("error expanding backup settings file path: %w", err)
 	}
 
-	return rp.LoadConfigFromFile(expandedPath)
+	return c.loadConfigFromFile(expandedPath)
+}
+
+// Load a config file from the machine running the Smartnode, or nil if it doesn't exist
+func (c *Client) loadConfigFromFile(path string) (*config.RocketPoolConfig, error) {
+	if c.client == nil {
+		return rp.LoadConfigFromFile(path)
+	}
+
+	exists, err := c.fileExists(path)
+	if err != nil {
+		return nil, fmt.Errorf("error checking for settings file [%s]: %w", path, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	configBytes, err := c.readFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Rocket Pool settings file at %s: %w", shellescape.Quote(path), err)
+	}
+	return config.LoadFromBytes(configBytes, filepath.Dir(path))
 }
 
 // Save the config
 func (c *Client) SaveConfig(cfg *config.RocketPoolConfig) error {
 	settingsFilePath := filepath.Join(c.configPath, SettingsFile)
-	expandedPath, err := homedir.Expand(settingsFilePath)
+	expandedPath, err := c.expandPath(settingsFilePath)
 	if err != nil {
 		return err
 	}
-	return rp.SaveConfig(cfg, expandedPath)
+	if c.client == nil {
+		return rp.SaveConfig(cfg, expandedPath)
+	}
+
+	configBytes, err := yaml.Marshal(cfg.Serialize())
+	if err != nil {
+		return fmt.Errorf("could not serialize settings file: %w", err)
+	}
+	if err := c.writeFile(expandedPath, configBytes, 0664); err != nil {
+		return fmt.Errorf("could not write Rocket Pool config to %s: %w", shellescape.Quote(expandedPath), err)
+	}
+	return nil
 }
 
 // Remove the upgrade flag file
 func (c *Client) RemoveUpgradeFlagFile() error {
-	expandedPath, err := homedir.Expand(c.configPath)
+	expandedPath, err := c.expandPath(c.configPath)
 	if err != nil {
 		return err
 	}
-	return rp.RemoveUpgradeFlagFile(expandedPath)
+	if c.client == nil {
+		return rp.RemoveUpgradeFlagFile(expandedPath)
+	}
+	if err := c.removeAll(filepath.Join(expandedPath, rp.UpgradeFlagFile)); err != nil {
+		return fmt.Errorf("error removing upgrade flag file: %w", err)
+	}
+	return nil
 }
 
 // Returns whether or not this is the first run of the configurator since a previous installation
 func (c *Client) IsFirstRun() (bool, error) {
-	expandedPath, err := homedir.Expand(c.configPath)
+	expandedPath, err := c.expandPath(c.configPath)
 	if err != nil {
 		return false, fmt.Errorf("error expanding settings file path: %w", err)
 	}
-	return rp.IsFirstRun(expandedPath), nil
+	if c.client == nil {
+		return rp.IsFirstRun(expandedPath), nil
+	}
+	return c.fileExists(filepath.Join(expandedPath, rp.UpgradeFlagFile))
 }
 
 // Load the legacy config if one exists
@@ -242,12 +298,12 @@ func (c *Client) LoadLegacyConfigFromBackup() (*config.RocketPoolConfig, error)
 
 // Load the Prometheus template, do an environment variable substitution, and save it
 func (c *Client) UpdatePrometheusConfiguration(settings map[string]string) error {
-	prometheusTemplatePath, err := homedir.Expand(fmt.Sprintf("%s/%s", c.configPath, PrometheusConfigTemplate))
+	prometheusTemplatePath, err := c.expandPath(fmt.Sprintf("%s/%s", c.configPath, PrometheusConfigTemplate))
 	if err != nil {
 		return fmt.Errorf("Error expanding Prometheus template path: %w", err)
 	}
 
-	prometheusConfigPath, err := homedir.Expand(fmt.Sprintf("%s/%s", c.configPath, PrometheusFile))
+	prometheusConfigPath, err := c.expandPath(fmt.Sprintf("%s/%s", c.configPath, PrometheusFile))
 	if err != nil {
 		return fmt.Errorf("Error expanding Prometheus config file path: %w", err)
 	}
@@ -260,7 +316,7 @@ func (c *Client) UpdatePrometheusConfiguration(settings map[string]string) error
 	}
 
 	// Read and substitute the template
-	contents, err := envsubst.ReadFile(prometheusTemplatePath)
+	contents, err := c.readTemplate(prometheusTemplatePath)
 	if err != nil {
 		return fmt.Errorf("Error reading and substituting Prometheus configuration template: %w", err)
 	}
@@ -271,13 +327,15 @@ func (c *Client) UpdatePrometheusConfiguration(settings map[string]string) error
 	}
 
 	// Write the actual Prometheus config file
-	err = ioutil.WriteFile(prometheusConfigPath, contents, 0664)
+	err = c.writeFile(prometheusConfigPath, contents, 0664)
 	if err != nil {
 		return fmt.Errorf("Could not write Prometheus config file to %s: %w", shellescape.Quote(prometheusConfigPath), err)
 	}
-	err = os.Chmod(prometheusConfigPath, 0664)
-	if err != nil {
-		return fmt.Errorf("Could not set Prometheus config file permissions: %w", shellescape.Quote(prometheusConfigPath), err)
+	if c.client == nil {
+		err = os.Chmod(prometheusConfigPath, 0664)
+		if err != nil {
+			return fmt.Errorf("Could not set Prometheus config file permissions: %w", shellescape.Quote(prometheusConfigPath), err)
+		}
 	}
 
 	return nil
@@ -620,6 +678,11 @@ func (c *Client) StartService(composeFiles []string) error {
 		return fmt.Errorf("error starting API container: %w", err)
 	}
 
+	// Run the API server in it; API commands run directly without it, so this isn't fatal
+	if err := c.startApiServer(); err != nil {
+		fmt.Printf("%sWARNING: Couldn't start the API server, API commands will be run without it: %s%s\n", colorYellow, err.Error(), colorReset)
+	}
+
 	// Start all of the containers
 	cmd, err = c.compose(composeFiles, "up -d --remove-orphans")
 	if err != nil {
@@ -628,6 +691,18 @@ func (c *Client) StartService(composeFiles []string) error {
 	return c.printOutput(cmd)
 }
 
+// Start the API server in the API container in the background.
+// If it's already running, the new one exits without replacing it.
+func (c *Client) startApiServer() error {
+	containerName, err := c.getAPIContainerName()
+	if err != nil {
+		return err
+	}
+	cmd := fmt.Sprintf("docker exec -d %s %s api-server", shellescape.Quote(containerName), shellescape.Quote(APIBinPath))
+	_, err = c.readOutput(cmd)
+	return err
+}
+
 // Pause the Rocket Pool service
 func (c *Client) PauseService(composeFiles []string) error {
 	cmd, err := c.compose(composeFiles, "stop")
@@ -668,6 +743,33 @@ func (c *Client) PrintServiceLogs(composeFiles []string, tail string, serviceNam
 	return c.printOutput(cmd)
 }
 
+// Get the last lines of a Rocket Pool service's logs
+func (c *Client) GetServiceLogs(serviceName string, tail uint64) ([]string, error) {
+
+	// Cancel if running in non-docker mode
+	if c.daemonPath != "" {
+		return nil, errors.New("command unavailable in Native Mode (with '--daemon-path' option specified)")
+	}
+
+	// Get the service's container name
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Smartnode.ProjectName.Value == "" {
+		return nil, errors.New("Rocket Pool docker project name not set")
+	}
+	containerName := fmt.Sprintf("%s_%s", cfg.Smartnode.ProjectName.Value.(string), serviceName)
+
+	// Read the logs, which the services write to stderr
+	output, err := c.readOutput(fmt.Sprintf("docker logs --tail %d %s 2>&1", tail, shellescape.Quote(containerName)))
+	if err != nil {
+		return nil, fmt.Errorf("Could not get %s logs: %w", serviceName, err)
+	}
+	return strings.Split(strings.TrimRight(string(output), "\n"), "\n"), nil
+
+}
+
 // Print the Rocket Pool service stats
 func (c *Client) PrintServiceStats(composeFiles []string) error {
 
@@ -1147,7 +1249,7 @@ func (c *Client) compose(composeFiles []string, args string) (string, error) {
 	}
 
 	// Get the expanded config path
-	expandedConfigPath, err := homedir.Expand(c.configPath)
+	expandedConfigPath, err := c.expandPath(c.configPath)
 	if err != nil {
 		return "", err
 	}
@@ -1176,7 +1278,12 @@ func (c *Client) compose(composeFiles []string, args string) (string, error) {
 
 	// Get the external IP address
 	var externalIP string
-	ip, err := getExternalIP()
+	var ip net.IP
+	if c.client == nil {
+		ip, err = getExternalIP()
+	} else {
+		ip, err = c.getRemoteExternalIP()
+	}
 	if err != nil {
 		fmt.Println("Warning: couldn't get external IP address; if you're using Nimbus or Besu, it may have trouble finding peers:")
 		fmt.Println(err.Error())
@@ -1220,22 +1327,28 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 	// Check for the folders
 	runtimeFolder := filepath.Join(rocketpoolDir, runtimeDir)
 	templatesFolder := filepath.Join(rocketpoolDir, templatesDir)
-	_, err := os.Stat(templatesFolder)
-	if os.IsNotExist(err) {
+	exists, err := c.fileExists(templatesFolder)
+	if err != nil {
+		return []string{}, fmt.Errorf("error checking for templates folder [%s]: %w", templatesFolder, err)
+	}
+	if !exists {
 		return []string{}, fmt.Errorf("templates folder [%s] does not exist", templatesFolder)
 	}
 	overrideFolder := filepath.Join(rocketpoolDir, overrideDir)
-	_, err = os.Stat(overrideFolder)
-	if os.IsNotExist(err) {
+	exists, err = c.fileExists(overrideFolder)
+	if err != nil {
+		return []string{}, fmt.Errorf("error checking for override folder [%s]: %w", overrideFolder, err)
+	}
+	if !exists {
 		return []string{}, fmt.Errorf("override folder [%s] does not exist", overrideFolder)
 	}
 
 	// Clear out the runtime folder and remake it
-	err = os.RemoveAll(runtimeFolder)
+	err = c.removeAll(runtimeFolder)
 	if err != nil {
 		return []string{}, fmt.Errorf("error deleting runtime folder [%s]: %w", runtimeFolder, err)
 	}
-	err = os.Mkdir(runtimeFolder, 0775)
+	err = c.mkdirAll(runtimeFolder, 0775)
 	if err != nil {
 		return []string{}, fmt.Errorf("error creating runtime folder [%s]: %w", runtimeFolder, err)
 	}
@@ -1257,12 +1370,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 	deployedContainers := []string{}
 
 	// API
-	contents, err := envsubst.ReadFile(filepath.Join(templatesFolder, config.ApiContainerName+templateSuffix))
+	contents, err := c.readTemplate(filepath.Join(templatesFolder, config.ApiContainerName+templateSuffix))
 	if err != nil {
 		return []string{}, fmt.Errorf("error reading and substituting API container template: %w", err)
 	}
 	apiComposePath := filepath.Join(runtimeFolder, config.ApiContainerName+composeFileSuffix)
-	err = ioutil.WriteFile(apiComposePath, contents, 0664)
+	err = c.writeFile(apiComposePath, contents, 0664)
 	if err != nil {
 		return []string{}, fmt.Errorf("could not write API container file to %s: %w", apiComposePath, err)
 	}
@@ -1270,12 +1383,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 	deployedContainers = append(deployedContainers, filepath.Join(overrideFolder, config.ApiContainerName+composeFileSuffix))
 
 	// Node
-	contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.NodeContainerName+templateSuffix))
+	contents, err = c.readTemplate(filepath.Join(templatesFolder, config.NodeContainerName+templateSuffix))
 	if err != nil {
 		return []string{}, fmt.Errorf("error reading and substituting node container template: %w", err)
 	}
 	nodeComposePath := filepath.Join(runtimeFolder, config.NodeContainerName+composeFileSuffix)
-	err = ioutil.WriteFile(nodeComposePath, contents, 0664)
+	err = c.writeFile(nodeComposePath, contents, 0664)
 	if err != nil {
 		return []string{}, fmt.Errorf("could not write node container file to %s: %w", nodeComposePath, err)
 	}
@@ -1283,12 +1396,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 	deployedContainers = append(deployedContainers, filepath.Join(overrideFolder, config.NodeContainerName+composeFileSuffix))
 
 	// Watchtower
-	contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.WatchtowerContainerName+templateSuffix))
+	contents, err = c.readTemplate(filepath.Join(templatesFolder, config.WatchtowerContainerName+templateSuffix))
 	if err != nil {
 		return []string{}, fmt.Errorf("error reading and substituting watchtower container template: %w", err)
 	}
 	watchtowerComposePath := filepath.Join(runtimeFolder, config.WatchtowerContainerName+composeFileSuffix)
-	err = ioutil.WriteFile(watchtowerComposePath, contents, 0664)
+	err = c.writeFile(watchtowerComposePath, contents, 0664)
 	if err != nil {
 		return []string{}, fmt.Errorf("could not write watchtower container file to %s: %w", watchtowerComposePath, err)
 	}
@@ -1296,12 +1409,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 	deployedContainers = append(deployedContainers, filepath.Join(overrideFolder, config.WatchtowerContainerName+composeFileSuffix))
 
 	// Validator
-	contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.ValidatorContainerName+templateSuffix))
+	contents, err = c.readTemplate(filepath.Join(templatesFolder, config.ValidatorContainerName+templateSuffix))
 	if err != nil {
 		return []string{}, fmt.Errorf("error reading and substituting validator container template: %w", err)
 	}
 	validatorComposePath := filepath.Join(runtimeFolder, config.ValidatorContainerName+composeFileSuffix)
-	err = ioutil.WriteFile(validatorComposePath, contents, 0664)
+	err = c.writeFile(validatorComposePath, contents, 0664)
 	if err != nil {
 		return []string{}, fmt.Errorf("could not write validator container file to %s: %w", validatorComposePath, err)
 	}
@@ -1310,12 +1423,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 
 	// Check the EC mode to see if it needs to be deployed
 	if cfg.ExecutionClientMode.Value.(cfgtypes.Mode) == cfgtypes.Mode_Local {
-		contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.Eth1ContainerName+templateSuffix))
+		contents, err = c.readTemplate(filepath.Join(templatesFolder, config.Eth1ContainerName+templateSuffix))
 		if err != nil {
 			return []string{}, fmt.Errorf("error reading and substituting execution client container template: %w", err)
 		}
 		eth1ComposePath := filepath.Join(runtimeFolder, config.Eth1ContainerName+composeFileSuffix)
-		err = ioutil.WriteFile(eth1ComposePath, contents, 0664)
+		err = c.writeFile(eth1ComposePath, contents, 0664)
 		if err != nil {
 			return []string{}, fmt.Errorf("could not write execution client container file to %s: %w", eth1ComposePath, err)
 		}
@@ -1325,12 +1438,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 
 	// Check the Consensus mode
 	if cfg.ConsensusClientMode.Value.(cfgtypes.Mode) == cfgtypes.Mode_Local {
-		contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.Eth2ContainerName+templateSuffix))
+		contents, err = c.readTemplate(filepath.Join(templatesFolder, config.Eth2ContainerName+templateSuffix))
 		if err != nil {
 			return []string{}, fmt.Errorf("error reading and substituting consensus client container template: %w", err)
 		}
 		eth2ComposePath := filepath.Join(runtimeFolder, config.Eth2ContainerName+composeFileSuffix)
-		err = ioutil.WriteFile(eth2ComposePath, contents, 0664)
+		err = c.writeFile(eth2ComposePath, contents, 0664)
 		if err != nil {
 			return []string{}, fmt.Errorf("could not write consensus client container file to %s: %w", eth2ComposePath, err)
 		}
@@ -1341,12 +1454,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 	// Check the metrics containers
 	if cfg.EnableMetrics.Value == true {
 		// Grafana
-		contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.GrafanaContainerName+templateSuffix))
+		contents, err = c.readTemplate(filepath.Join(templatesFolder, config.GrafanaContainerName+templateSuffix))
 		if err != nil {
 			return []string{}, fmt.Errorf("error reading and substituting Grafana container template: %w", err)
 		}
 		grafanaComposePath := filepath.Join(runtimeFolder, config.GrafanaContainerName+composeFileSuffix)
-		err = ioutil.WriteFile(grafanaComposePath, contents, 0664)
+		err = c.writeFile(grafanaComposePath, contents, 0664)
 		if err != nil {
 			return []string{}, fmt.Errorf("could not write Grafana container file to %s: %w", grafanaComposePath, err)
 		}
@@ -1354,12 +1467,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 		deployedContainers = append(deployedContainers, filepath.Join(overrideFolder, config.GrafanaContainerName+composeFileSuffix))
 
 		// Node exporter
-		contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.ExporterContainerName+templateSuffix))
+		contents, err = c.readTemplate(filepath.Join(templatesFolder, config.ExporterContainerName+templateSuffix))
 		if err != nil {
 			return []string{}, fmt.Errorf("error reading and substituting Node Exporter container template: %w", err)
 		}
 		exporterComposePath := filepath.Join(runtimeFolder, config.ExporterContainerName+composeFileSuffix)
-		err = ioutil.WriteFile(exporterComposePath, contents, 0664)
+		err = c.writeFile(exporterComposePath, contents, 0664)
 		if err != nil {
 			return []string{}, fmt.Errorf("could not write Node Exporter container file to %s: %w", exporterComposePath, err)
 		}
@@ -1367,12 +1480,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 		deployedContainers = append(deployedContainers, filepath.Join(overrideFolder, config.ExporterContainerName+composeFileSuffix))
 
 		// Prometheus
-		contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.PrometheusContainerName+templateSuffix))
+		contents, err = c.readTemplate(filepath.Join(templatesFolder, config.PrometheusContainerName+templateSuffix))
 		if err != nil {
 			return []string{}, fmt.Errorf("error reading and substituting Prometheus container template: %w", err)
 		}
 		prometheusComposePath := filepath.Join(runtimeFolder, config.PrometheusContainerName+composeFileSuffix)
-		err = ioutil.WriteFile(prometheusComposePath, contents, 0664)
+		err = c.writeFile(prometheusComposePath, contents, 0664)
 		if err != nil {
 			return []string{}, fmt.Errorf("could not write Prometheus container file to %s: %w", prometheusComposePath, err)
 		}
@@ -1382,12 +1495,12 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 
 	// Check MEV-Boost
 	if cfg.EnableMevBoost.Value == true && cfg.MevBoost.Mode.Value.(cfgtypes.Mode) == cfgtypes.Mode_Local {
-		contents, err = envsubst.ReadFile(filepath.Join(templatesFolder, config.MevBoostContainerName+templateSuffix))
+		contents, err = c.readTemplate(filepath.Join(templatesFolder, config.MevBoostContainerName+templateSuffix))
 		if err != nil {
 			return []string{}, fmt.Errorf("error reading and substituting MEV-Boost container template: %w", err)
 		}
 		mevBoostComposePath := filepath.Join(runtimeFolder, config.MevBoostContainerName+composeFileSuffix)
-		err = ioutil.WriteFile(mevBoostComposePath, contents, 0664)
+		err = c.writeFile(mevBoostComposePath, contents, 0664)
 		if err != nil {
 			return []string{}, fmt.Errorf("could not write MEV-Boost container file to %s: %w", mevBoostComposePath, err)
 		}
@@ -1396,24 +1509,24 @@ func (c *Client) deployTemplates(cfg *config.RocketPoolConfig, rocketpoolDir str
 	}
 
 	// Create the custom keys dir
-	customKeyDir, err := homedir.Expand(filepath.Join(cfg.Smartnode.DataPath.Value.(string), "custom-keys"))
+	customKeyDir, err := c.expandPath(filepath.Join(cfg.Smartnode.DataPath.Value.(string), "custom-keys"))
 	if err != nil {
 		fmt.Printf("%sWARNING: Couldn't expand the custom validator key directory (%s). You will not be able to recover any minipool keys you created outside of the Smartnode until you create the folder manually.%s\n", colorYellow, err.Error(), colorReset)
 		return deployedContainers, nil
 	}
-	err = os.MkdirAll(customKeyDir, 0775)
+	err = c.mkdirAll(customKeyDir, 0775)
 	if err != nil {
 		fmt.Printf("%sWARNING: Couldn't create the custom validator key directory (%s). You will not be able to recover any minipool keys you created outside of the Smartnode until you create the folder [%s] manually.%s\n", colorYellow, err.Error(), customKeyDir, colorReset)
 	}
 
 	// Create the rewards file dir
-	rewardsFilePath, err := homedir.Expand(cfg.Smartnode.GetRewardsTreePath(0, false))
+	rewardsFilePath, err := c.expandPath(cfg.Smartnode.GetRewardsTreePath(0, false))
 	if err != nil {
 		fmt.Printf("%sWARNING: Couldn't expand the rewards tree file directory (%s). You will not be able to view or claim your rewards until you create the folder manually.%s\n", colorYellow, err.Error(), colorReset)
 		return deployedContainers, nil
 	}
 	rewardsFileDir := filepath.Dir(rewardsFilePath)
-	err = os.MkdirAll(rewardsFileDir, 0775)
+	err = c.mkdirAll(rewardsFileDir, 0775)
 	if err != nil {
 		fmt.Printf("%sWARNING: Couldn't create the rewards tree file directory (%s). You will not be able to view or claim your rewards until you create the folder [%s] manually.%s\n", colorYellow, err.Error(), rewardsFileDir, colorReset)
 	}
@@ -1432,17 +1545,17 @@ func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir strin
 		overrideFolder := filepath.Join(rocketpoolDir, overrideDir, "addons", "gww")
 
 		// Make the addon folder
-		err := os.MkdirAll(runtimeFolder, 0775)
+		err := c.mkdirAll(runtimeFolder, 0775)
 		if err != nil {
 			return []string{}, fmt.Errorf("error creating addon runtime folder (%s): %w", runtimeFolder, err)
 		}
 
-		contents, err := envsubst.ReadFile(filepath.Join(templatesFolder, graffiti_wall_writer.GraffitiWallWriterContainerName+templateSuffix))
+		contents, err := c.readTemplate(filepath.Join(templatesFolder, graffiti_wall_writer.GraffitiWallWriterContainerName+templateSuffix))
 		if err != nil {
 			return []string{}, fmt.Errorf("error reading and substituting GWW addon container template: %w", err)
 		}
 		composePath := filepath.Join(runtimeFolder, graffiti_wall_writer.GraffitiWallWriterContainerName+composeFileSuffix)
-		err = ioutil.WriteFile(composePath, contents, 0664)
+		err = c.writeFile(composePath, contents, 0664)
 		if err != nil {
 			return []string{}, fmt.Errorf("could not write GWW addon container file to %s: %w", composePath, err)
 		}
@@ -1456,6 +1569,13 @@ func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir strin
 
 // Call the Rocket Pool API
 func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
+	// Use the API server if it's running
+	output, handled, err := c.callApiServer(args, otherArgs...)
+	if handled {
+		c.resetGasSettings()
+		return output, err
+	}
+
 	// Sanitize and parse the args
 	ignoreSyncCheckFlag, forceFallbackECFlag, args := c.getApiCallArgs(args, otherArgs...)
 
@@ -1567,11 +1687,16 @@ func (c *Client) runApiCall(cmd string) ([]byte, error) {
 	}
 
 	// Reset the gas settings after the call
+	c.resetGasSettings()
+
+	return output, err
+}
+
+// Reset the gas settings to the ones the client was created with
+func (c *Client) resetGasSettings() {
 	c.maxFee = c.originalMaxFee
 	c.maxPrioFee = c.originalMaxPrioFee
 	c.gasLimit = c.originalGasLimit
-
-	return output, err
 }
 
 // Get the API container name
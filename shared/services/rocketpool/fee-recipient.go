@@ -1,13 +1,28 @@
 package rocketpool
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"gopkg.in/yaml.v2"
+
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Keys of the Lighthouse validator definitions used for fee recipients
+const (
+	lighthousePubkeyKey       = "voting_public_key"
+	lighthouseFeeRecipientKey = "suggested_fee_recipient"
 )
 
 // Config
@@ -71,3 +86,215 @@ func getFeeRecipientFileContents(feeRecipient common.Address, cfg *config.Rocket
 	// Native mode
 	return fmt.Sprintf("%s=%s", config.FeeRecipientEnvVar, feeRecipient.Hex())
 }
+
+// Proposer configuration file used by Teku (--validators-proposer-config) and Prysm (--proposer-settings-file)
+type proposerConfig struct {
+	ProposerConfig map[string]proposerConfigEntry `json:"proposer_config"`
+	DefaultConfig  proposerConfigEntry            `json:"default_config"`
+}
+type proposerConfigEntry struct {
+	FeeRecipient string `json:"fee_recipient"`
+}
+
+// Checks if the per-validator fee recipient files match the given default fee recipient and per-validator overrides.
+func CheckProposerConfigFiles(defaultFeeRecipient common.Address, overrides map[types.ValidatorPubkey]common.Address, cfg *config.RocketPoolConfig) (bool, error) {
+
+	// Check the proposer config file
+	expectedBytes, err := getProposerConfigFileContents(defaultFeeRecipient, overrides)
+	if err != nil {
+		return false, err
+	}
+	existingBytes, err := ioutil.ReadFile(cfg.Smartnode.GetProposerConfigFilePath())
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("error reading proposer config file: %w", err)
+	}
+	if !bytes.Equal(existingBytes, expectedBytes) {
+		return false, nil
+	}
+
+	// Check the Nimbus per-key files
+	nimbusFiles, err := getNimbusFeeRecipientFiles(cfg)
+	if err != nil {
+		return false, err
+	}
+	for pubkey, path := range nimbusFiles {
+		existingBytes, err := ioutil.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return false, fmt.Errorf("error reading Nimbus fee recipient file for %s: %w", pubkey.Hex(), err)
+		}
+		feeRecipient, exists := overrides[pubkey]
+		if exists != (err == nil) || (exists && string(existingBytes) != feeRecipient.Hex()) {
+			return false, nil
+		}
+	}
+
+	// Check the Lighthouse validator definitions
+	definitions, err := loadLighthouseValidatorDefinitions(cfg)
+	if err != nil {
+		return false, err
+	}
+	if setLighthouseFeeRecipients(definitions, overrides) {
+		return false, nil
+	}
+	return true, nil
+
+}
+
+// Writes the per-validator fee recipient files for the given default fee recipient and per-validator overrides.
+// The VC should be restarted to pick up the new files.
+func UpdateProposerConfigFiles(defaultFeeRecipient common.Address, overrides map[types.ValidatorPubkey]common.Address, cfg *config.RocketPoolConfig) error {
+
+	// Write the proposer config file
+	contents, err := getProposerConfigFileContents(defaultFeeRecipient, overrides)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(cfg.Smartnode.GetProposerConfigFilePath(), contents, FileMode); err != nil {
+		return fmt.Errorf("error writing proposer config file: %w", err)
+	}
+
+	// Write or remove the Nimbus per-key files
+	nimbusFiles, err := getNimbusFeeRecipientFiles(cfg)
+	if err != nil {
+		return err
+	}
+	for pubkey, path := range nimbusFiles {
+		if feeRecipient, exists := overrides[pubkey]; exists {
+			if err := ioutil.WriteFile(path, []byte(feeRecipient.Hex()), FileMode); err != nil {
+				return fmt.Errorf("error writing Nimbus fee recipient file for %s: %w", pubkey.Hex(), err)
+			}
+		} else if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error removing Nimbus fee recipient file for %s: %w", pubkey.Hex(), err)
+		}
+	}
+
+	// Update the Lighthouse validator definitions
+	definitions, err := loadLighthouseValidatorDefinitions(cfg)
+	if err != nil {
+		return err
+	}
+	if setLighthouseFeeRecipients(definitions, overrides) {
+		contents, err := yaml.Marshal(definitions)
+		if err != nil {
+			return fmt.Errorf("error serializing Lighthouse validator definitions: %w", err)
+		}
+		if err := ioutil.WriteFile(getLighthouseValidatorDefinitionsPath(cfg), contents, lighthouse.FileMode); err != nil {
+			return fmt.Errorf("error writing Lighthouse validator definitions: %w", err)
+		}
+	}
+	return nil
+
+}
+
+// Gets the expected contents of the proposer config file
+func getProposerConfigFileContents(defaultFeeRecipient common.Address, overrides map[types.ValidatorPubkey]common.Address) ([]byte, error) {
+	proposerConfig := proposerConfig{
+		ProposerConfig: map[string]proposerConfigEntry{},
+		DefaultConfig: proposerConfigEntry{
+			FeeRecipient: defaultFeeRecipient.Hex(),
+		},
+	}
+	for pubkey, feeRecipient := range overrides {
+		proposerConfig.ProposerConfig[hexutil.AddPrefix(pubkey.Hex())] = proposerConfigEntry{
+			FeeRecipient: feeRecipient.Hex(),
+		}
+	}
+	contents, err := json.MarshalIndent(proposerConfig, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error serializing proposer config: %w", err)
+	}
+	return contents, nil
+}
+
+// Gets the path of the Nimbus fee recipient file for each validator loaded in the Nimbus keystore
+func getNimbusFeeRecipientFiles(cfg *config.RocketPoolConfig) (map[types.ValidatorPubkey]string, error) {
+	files := map[types.ValidatorPubkey]string{}
+	validatorsDir := filepath.Join(cfg.Smartnode.GetValidatorKeychainPath(), nimbus.KeystoreDir, nimbus.ValidatorsDir)
+	entries, err := ioutil.ReadDir(validatorsDir)
+	if os.IsNotExist(err) {
+		return files, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error enumerating Nimbus validators: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(entry.Name()))
+		if err != nil {
+			continue
+		}
+		files[pubkey] = filepath.Join(validatorsDir, entry.Name(), nimbus.FeeRecipientFileName)
+	}
+	return files, nil
+}
+
+// Gets the path of the validator definitions file Lighthouse creates for its keystore
+func getLighthouseValidatorDefinitionsPath(cfg *config.RocketPoolConfig) string {
+	return filepath.Join(cfg.Smartnode.GetValidatorKeychainPath(), lighthouse.KeystoreDir, lighthouse.ValidatorsDir, lighthouse.ValidatorDefinitionsFileName)
+}
+
+// Loads the Lighthouse validator definitions, keeping the fields the Smartnode doesn't manage intact
+func loadLighthouseValidatorDefinitions(cfg *config.RocketPoolConfig) ([]yaml.MapSlice, error) {
+	contents, err := ioutil.ReadFile(getLighthouseValidatorDefinitionsPath(cfg))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading Lighthouse validator definitions: %w", err)
+	}
+	definitions := []yaml.MapSlice{}
+	if err := yaml.Unmarshal(contents, &definitions); err != nil {
+		return nil, fmt.Errorf("error deserializing Lighthouse validator definitions: %w", err)
+	}
+	return definitions, nil
+}
+
+// Sets the suggested fee recipient of each Lighthouse validator definition to its override, removing it for the others.
+// Returns true if any definition was changed.
+func setLighthouseFeeRecipients(definitions []yaml.MapSlice, overrides map[types.ValidatorPubkey]common.Address) bool {
+	changed := false
+	for i, definition := range definitions {
+
+		// Get the validator's pubkey and current fee recipient
+		var pubkey types.ValidatorPubkey
+		hasPubkey := false
+		feeRecipientIndex := -1
+		for j, item := range definition {
+			switch item.Key {
+			case lighthousePubkeyKey:
+				pubkeyString, _ := item.Value.(string)
+				parsedPubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(pubkeyString))
+				if err == nil {
+					pubkey = parsedPubkey
+					hasPubkey = true
+				}
+			case lighthouseFeeRecipientKey:
+				feeRecipientIndex = j
+			}
+		}
+		if !hasPubkey {
+			continue
+		}
+
+		// Update it
+		feeRecipient, exists := overrides[pubkey]
+		switch {
+		case exists && feeRecipientIndex == -1:
+			definitions[i] = append(definition, yaml.MapItem{Key: lighthouseFeeRecipientKey, Value: feeRecipient.Hex()})
+			changed = true
+		case exists:
+			currentString, _ := definition[feeRecipientIndex].Value.(string)
+			if !common.IsHexAddress(currentString) || common.HexToAddress(currentString) != feeRecipient {
+				definition[feeRecipientIndex].Value = feeRecipient.Hex()
+				changed = true
+			}
+		case feeRecipientIndex != -1:
+			definitions[i] = append(definition[:feeRecipientIndex:feeRecipientIndex], definition[feeRecipientIndex+1:]...)
+			changed = true
+		}
+
+	}
+	return changed
+}
@@ -6,7 +6,9 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
 
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -56,6 +58,38 @@ func (c *Client) MinipoolStatus() (api.MinipoolStatusResponse, error) {
 	return response, nil
 }
 
+// Get the recent performance of the node's validators
+func (c *Client) MinipoolPerformance(windowEpochs uint64) (api.MinipoolPerformanceResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool performance %d", windowEpochs))
+	if err != nil {
+		return api.MinipoolPerformanceResponse{}, fmt.Errorf("Could not get minipool performance: %w", err)
+	}
+	var response api.MinipoolPerformanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolPerformanceResponse{}, fmt.Errorf("Could not decode minipool performance response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolPerformanceResponse{}, fmt.Errorf("Could not get minipool performance: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Pre-sign a voluntary exit for a minipool; an epoch of 0 uses the current epoch
+func (c *Client) SignMinipoolExit(address common.Address, epoch uint64) (api.SignMinipoolExitResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool sign-exit %s %d", address.Hex(), epoch))
+	if err != nil {
+		return api.SignMinipoolExitResponse{}, fmt.Errorf("Could not sign minipool exit: %w", err)
+	}
+	var response api.SignMinipoolExitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SignMinipoolExitResponse{}, fmt.Errorf("Could not decode sign minipool exit response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SignMinipoolExitResponse{}, fmt.Errorf("Could not sign minipool exit: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether a minipool is eligible for a refund
 func (c *Client) CanRefundMinipool(address common.Address) (api.CanRefundMinipoolResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-refund %s", address.Hex()))
@@ -359,3 +393,50 @@ func (c *Client) GetVanityArtifacts(depositAmount *big.Int, nodeAddress string)
 	}
 	return response, nil
 }
+
+// Queue a refund of ETH from a minipool
+func (c *Client) QueueRefundMinipool(address common.Address, deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	return c.queueTransaction(deferral, fmt.Sprintf("Refund ETH from minipool %s", address.Hex()), "minipool", "refund", address.Hex())
+}
+
+// Queue the closing of a minipool
+func (c *Client) QueueCloseMinipool(address common.Address, deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	return c.queueTransaction(deferral, fmt.Sprintf("Close minipool %s", address.Hex()), "minipool", "close", address.Hex())
+}
+
+// Queue an upgrade of a minipool delegate
+func (c *Client) QueueDelegateUpgradeMinipool(address common.Address, deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	return c.queueTransaction(deferral, fmt.Sprintf("Upgrade the delegate of minipool %s", address.Hex()), "minipool", "delegate-upgrade", address.Hex())
+}
+
+// Build a voluntary exit for a minipool to sign on an offline machine
+func (c *Client) BuildOfflineMinipoolExit(address common.Address, epoch uint64) (api.BuildOfflineMinipoolExitResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool build-offline-exit %s %d", address.Hex(), epoch))
+	if err != nil {
+		return api.BuildOfflineMinipoolExitResponse{}, fmt.Errorf("Could not build offline minipool exit: %w", err)
+	}
+	var response api.BuildOfflineMinipoolExitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BuildOfflineMinipoolExitResponse{}, fmt.Errorf("Could not decode build offline minipool exit response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BuildOfflineMinipoolExitResponse{}, fmt.Errorf("Could not build offline minipool exit: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Broadcast a voluntary exit that was signed on an offline machine
+func (c *Client) BroadcastMinipoolExit(validatorIndex uint64, epoch uint64, signature types.ValidatorSignature) (api.BroadcastMinipoolExitResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool broadcast-exit %d %d %s", validatorIndex, epoch, signature.Hex()))
+	if err != nil {
+		return api.BroadcastMinipoolExitResponse{}, fmt.Errorf("Could not broadcast minipool exit: %w", err)
+	}
+	var response api.BroadcastMinipoolExitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastMinipoolExitResponse{}, fmt.Errorf("Could not decode broadcast minipool exit response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastMinipoolExitResponse{}, fmt.Errorf("Could not broadcast minipool exit: %s", response.Error)
+	}
+	return response, nil
+}
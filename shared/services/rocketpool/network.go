@@ -128,3 +128,19 @@ func (c *Client) GetActiveDAOProposals() (api.NetworkDAOProposalsResponse, error
 	}
 	return response, nil
 }
+
+// Get gas fee suggestions from the fee history of the Execution client
+func (c *Client) FeeSuggestions() (api.NetworkFeeSuggestionsResponse, error) {
+	responseBytes, err := c.callAPI("network fee-suggestions")
+	if err != nil {
+		return api.NetworkFeeSuggestionsResponse{}, fmt.Errorf("Could not get fee suggestions: %w", err)
+	}
+	var response api.NetworkFeeSuggestionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkFeeSuggestionsResponse{}, fmt.Errorf("Could not decode fee suggestions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NetworkFeeSuggestionsResponse{}, fmt.Errorf("Could not get fee suggestions: %s", response.Error)
+	}
+	return response, nil
+}
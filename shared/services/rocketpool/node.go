@@ -8,7 +8,11 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
 
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -525,6 +529,22 @@ func (c *Client) NodeSync() (api.NodeSyncProgressResponse, error) {
 	return response, nil
 }
 
+// Get the upcoming duties of the node's validators
+func (c *Client) NodeDuties() (api.NodeDutiesResponse, error) {
+	responseBytes, err := c.callAPI("node duties")
+	if err != nil {
+		return api.NodeDutiesResponse{}, fmt.Errorf("Could not get node duties: %w", err)
+	}
+	var response api.NodeDutiesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeDutiesResponse{}, fmt.Errorf("Could not decode node duties response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeDutiesResponse{}, fmt.Errorf("Could not get node duties: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether the node has RPL rewards available to claim
 func (c *Client) CanNodeClaimRpl() (api.CanNodeClaimRplResponse, error) {
 	responseBytes, err := c.callAPI("node can-claim-rpl-rewards")
@@ -845,6 +865,54 @@ func (c *Client) NodeGetSmoothingPoolRegistrationStatus() (api.GetSmoothingPoolR
 	return response, nil
 }
 
+// Get the effective fee recipient of each of the node's validators
+func (c *Client) NodeFeeRecipientStatus() (api.NodeFeeRecipientStatusResponse, error) {
+	responseBytes, err := c.callAPI("node fee-recipient-status")
+	if err != nil {
+		return api.NodeFeeRecipientStatusResponse{}, fmt.Errorf("Could not get fee recipient status: %w", err)
+	}
+	var response api.NodeFeeRecipientStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeFeeRecipientStatusResponse{}, fmt.Errorf("Could not decode fee recipient status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeFeeRecipientStatusResponse{}, fmt.Errorf("Could not get fee recipient status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Set the fee recipient of a solo validator
+func (c *Client) SetCustomFeeRecipient(pubkey types.ValidatorPubkey, feeRecipient common.Address) (api.SetCustomFeeRecipientResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node set-custom-fee-recipient %s %s", pubkey.Hex(), feeRecipient.Hex()))
+	if err != nil {
+		return api.SetCustomFeeRecipientResponse{}, fmt.Errorf("Could not set custom fee recipient: %w", err)
+	}
+	var response api.SetCustomFeeRecipientResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SetCustomFeeRecipientResponse{}, fmt.Errorf("Could not decode set custom fee recipient response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SetCustomFeeRecipientResponse{}, fmt.Errorf("Could not set custom fee recipient: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Remove the custom fee recipient of a solo validator
+func (c *Client) ClearCustomFeeRecipient(pubkey types.ValidatorPubkey) (api.ClearCustomFeeRecipientResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node clear-custom-fee-recipient %s", pubkey.Hex()))
+	if err != nil {
+		return api.ClearCustomFeeRecipientResponse{}, fmt.Errorf("Could not clear custom fee recipient: %w", err)
+	}
+	var response api.ClearCustomFeeRecipientResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ClearCustomFeeRecipientResponse{}, fmt.Errorf("Could not decode clear custom fee recipient response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ClearCustomFeeRecipientResponse{}, fmt.Errorf("Could not clear custom fee recipient: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check if the node's Smoothing Pool status can be changed
 func (c *Client) CanNodeSetSmoothingPoolStatus(status bool) (api.CanSetSmoothingPoolRegistrationStatusResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node can-set-smoothing-pool-status %t", status))
@@ -893,3 +961,251 @@ func (c *Client) SignMessage(message string) (api.NodeSignResponse, error) {
 	}
 	return response, nil
 }
+
+// Get the transactions sent by the node account
+func (c *Client) NodeTransactions() (api.NodeTransactionsResponse, error) {
+	responseBytes, err := c.callAPI("node tx-list")
+	if err != nil {
+		return api.NodeTransactionsResponse{}, fmt.Errorf("Could not get node transactions: %w", err)
+	}
+	var response api.NodeTransactionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeTransactionsResponse{}, fmt.Errorf("Could not decode node transactions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeTransactionsResponse{}, fmt.Errorf("Could not get node transactions: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a pending transaction can be sped up
+func (c *Client) CanSpeedUpNodeTransaction(hash common.Hash) (api.CanSpeedUpNodeTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node can-speed-up-tx %s", hash.Hex()))
+	if err != nil {
+		return api.CanSpeedUpNodeTransactionResponse{}, fmt.Errorf("Could not get can speed up transaction status: %w", err)
+	}
+	var response api.CanSpeedUpNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSpeedUpNodeTransactionResponse{}, fmt.Errorf("Could not decode can speed up transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSpeedUpNodeTransactionResponse{}, fmt.Errorf("Could not get can speed up transaction status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Speed up a pending transaction by replacing it with one that has higher fees
+func (c *Client) SpeedUpNodeTransaction(hash common.Hash) (api.SpeedUpNodeTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node speed-up-tx %s", hash.Hex()))
+	if err != nil {
+		return api.SpeedUpNodeTransactionResponse{}, fmt.Errorf("Could not speed up transaction: %w", err)
+	}
+	var response api.SpeedUpNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SpeedUpNodeTransactionResponse{}, fmt.Errorf("Could not decode speed up transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SpeedUpNodeTransactionResponse{}, fmt.Errorf("Could not speed up transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a pending transaction can be cancelled
+func (c *Client) CanCancelNodeTransaction(hash common.Hash) (api.CanCancelNodeTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node can-cancel-tx %s", hash.Hex()))
+	if err != nil {
+		return api.CanCancelNodeTransactionResponse{}, fmt.Errorf("Could not get can cancel transaction status: %w", err)
+	}
+	var response api.CanCancelNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanCancelNodeTransactionResponse{}, fmt.Errorf("Could not decode can cancel transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanCancelNodeTransactionResponse{}, fmt.Errorf("Could not get can cancel transaction status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Cancel a pending transaction by replacing it with an empty transfer
+func (c *Client) CancelNodeTransaction(hash common.Hash) (api.CancelNodeTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node cancel-tx %s", hash.Hex()))
+	if err != nil {
+		return api.CancelNodeTransactionResponse{}, fmt.Errorf("Could not cancel transaction: %w", err)
+	}
+	var response api.CancelNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CancelNodeTransactionResponse{}, fmt.Errorf("Could not decode cancel transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CancelNodeTransactionResponse{}, fmt.Errorf("Could not cancel transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Queue a transaction for the node daemon to send once gas is low enough
+func (c *Client) queueTransaction(deferral txmanager.Deferral, description string, args ...string) (api.QueueNodeTransactionResponse, error) {
+	var deadline int64
+	if !deferral.Deadline.IsZero() {
+		deadline = deferral.Deadline.Unix()
+	}
+	responseBytes, err := c.callAPI(fmt.Sprintf("node queue-tx %s %d %d", strconv.FormatFloat(deferral.GasThreshold, 'f', -1, 64), deadline, deferral.Expiry.Unix()), append([]string{description}, args...)...)
+	if err != nil {
+		return api.QueueNodeTransactionResponse{}, fmt.Errorf("Could not queue transaction: %w", err)
+	}
+	var response api.QueueNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.QueueNodeTransactionResponse{}, fmt.Errorf("Could not decode queue transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.QueueNodeTransactionResponse{}, fmt.Errorf("Could not queue transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the transactions queued for the node daemon to send
+func (c *Client) NodeTransactionQueue() (api.NodeTransactionQueueResponse, error) {
+	responseBytes, err := c.callAPI("node tx-queue")
+	if err != nil {
+		return api.NodeTransactionQueueResponse{}, fmt.Errorf("Could not get transaction queue: %w", err)
+	}
+	var response api.NodeTransactionQueueResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeTransactionQueueResponse{}, fmt.Errorf("Could not decode transaction queue response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeTransactionQueueResponse{}, fmt.Errorf("Could not get transaction queue: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Remove a transaction from the queue before it's sent
+func (c *Client) CancelQueuedNodeTransaction(id uint64) (api.CancelQueuedNodeTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node cancel-queued-tx %d", id))
+	if err != nil {
+		return api.CancelQueuedNodeTransactionResponse{}, fmt.Errorf("Could not cancel queued transaction: %w", err)
+	}
+	var response api.CancelQueuedNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CancelQueuedNodeTransactionResponse{}, fmt.Errorf("Could not decode cancel queued transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CancelQueuedNodeTransactionResponse{}, fmt.Errorf("Could not cancel queued transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Queue an RPL stake against the node
+func (c *Client) QueueNodeStakeRpl(amountWei *big.Int, deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	description := fmt.Sprintf("Stake %.6f RPL", eth.WeiToEth(amountWei))
+	return c.queueTransaction(deferral, description, "node", "stake-rpl", amountWei.String())
+}
+
+// Queue a withdrawal of RPL staked against the node
+func (c *Client) QueueNodeWithdrawRpl(amountWei *big.Int, deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	description := fmt.Sprintf("Withdraw %.6f staked RPL", eth.WeiToEth(amountWei))
+	return c.queueTransaction(deferral, description, "node", "withdraw-rpl", amountWei.String())
+}
+
+// Queue a distribution of the ETH in the node's fee distributor
+func (c *Client) QueueDistribute(deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	return c.queueTransaction(deferral, "Distribute the fee distributor balance", "node", "distribute")
+}
+
+// Queue a claim of the rewards for the given reward intervals
+func (c *Client) QueueNodeClaimRewards(indices []uint64, deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	indexStrings := []string{}
+	for _, index := range indices {
+		indexStrings = append(indexStrings, fmt.Sprint(index))
+	}
+	description := fmt.Sprintf("Claim rewards for intervals %s", strings.Join(indexStrings, ", "))
+	return c.queueTransaction(deferral, description, "node", "claim-rewards", strings.Join(indexStrings, ","))
+}
+
+// Queue a claim of the rewards for the given reward intervals that restakes RPL automatically
+func (c *Client) QueueNodeClaimAndStakeRewards(indices []uint64, stakeAmountWei *big.Int, deferral txmanager.Deferral) (api.QueueNodeTransactionResponse, error) {
+	indexStrings := []string{}
+	for _, index := range indices {
+		indexStrings = append(indexStrings, fmt.Sprint(index))
+	}
+	description := fmt.Sprintf("Claim rewards for intervals %s and restake %.6f RPL", strings.Join(indexStrings, ", "), eth.WeiToEth(stakeAmountWei))
+	return c.queueTransaction(deferral, description, "node", "claim-and-stake-rewards", strings.Join(indexStrings, ","), stakeAmountWei.String())
+}
+
+// Build a transaction for the node account to sign on an offline machine, using at least the provided nonce
+func (c *Client) buildOfflineTransaction(nonce uint64, description string, args ...string) (api.BuildOfflineTransactionResponse, error) {
+	responseBytes, err := c.callAPI("node build-offline-tx", append([]string{description, fmt.Sprint(nonce)}, args...)...)
+	if err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build offline transaction: %w", err)
+	}
+	var response api.BuildOfflineTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not decode build offline transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BuildOfflineTransactionResponse{}, fmt.Errorf("Could not build offline transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Build a withdrawal address change for offline signing
+func (c *Client) BuildOfflineSetNodeWithdrawalAddress(withdrawalAddress common.Address, confirm bool, nonce uint64) (api.BuildOfflineTransactionResponse, error) {
+	description := fmt.Sprintf("Set the withdrawal address to %s", withdrawalAddress.Hex())
+	return c.buildOfflineTransaction(nonce, description, "node", "set-withdrawal-address", withdrawalAddress.Hex(), fmt.Sprint(confirm))
+}
+
+// Build a confirmation of the node's pending withdrawal address for offline signing
+func (c *Client) BuildOfflineConfirmNodeWithdrawalAddress(nonce uint64) (api.BuildOfflineTransactionResponse, error) {
+	return c.buildOfflineTransaction(nonce, "Confirm the pending withdrawal address", "node", "confirm-withdrawal-address")
+}
+
+// Build a claim of the rewards for the given reward intervals for offline signing
+func (c *Client) BuildOfflineNodeClaimRewards(indices []uint64, nonce uint64) (api.BuildOfflineTransactionResponse, error) {
+	indexStrings := []string{}
+	for _, index := range indices {
+		indexStrings = append(indexStrings, fmt.Sprint(index))
+	}
+	description := fmt.Sprintf("Claim rewards for intervals %s", strings.Join(indexStrings, ", "))
+	return c.buildOfflineTransaction(nonce, description, "node", "claim-rewards", strings.Join(indexStrings, ","))
+}
+
+// Build a claim of the rewards for the given reward intervals that restakes RPL automatically for offline signing
+func (c *Client) BuildOfflineNodeClaimAndStakeRewards(indices []uint64, stakeAmountWei *big.Int, nonce uint64) (api.BuildOfflineTransactionResponse, error) {
+	indexStrings := []string{}
+	for _, index := range indices {
+		indexStrings = append(indexStrings, fmt.Sprint(index))
+	}
+	description := fmt.Sprintf("Claim rewards for intervals %s and restake %.6f RPL", strings.Join(indexStrings, ", "), eth.WeiToEth(stakeAmountWei))
+	return c.buildOfflineTransaction(nonce, description, "node", "claim-and-stake-rewards", strings.Join(indexStrings, ","), stakeAmountWei.String())
+}
+
+// Build a deposit for offline signing
+func (c *Client) BuildOfflineNodeDeposit(amountWei *big.Int, minFee float64, salt *big.Int, nonce uint64) (api.BuildOfflineDepositResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node build-offline-deposit %s %f %s %d", amountWei.String(), minFee, salt.String(), nonce))
+	if err != nil {
+		return api.BuildOfflineDepositResponse{}, fmt.Errorf("Could not build offline deposit: %w", err)
+	}
+	var response api.BuildOfflineDepositResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BuildOfflineDepositResponse{}, fmt.Errorf("Could not decode build offline deposit response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BuildOfflineDepositResponse{}, fmt.Errorf("Could not build offline deposit: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Broadcast a transaction that was signed by the node account on an offline machine
+func (c *Client) BroadcastNodeTransaction(rawTx []byte) (api.BroadcastNodeTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node broadcast-tx %s", hexutil.Encode(rawTx)))
+	if err != nil {
+		return api.BroadcastNodeTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %w", err)
+	}
+	var response api.BroadcastNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastNodeTransactionResponse{}, fmt.Errorf("Could not decode broadcast transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastNodeTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %s", response.Error)
+	}
+	return response, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -39,3 +40,19 @@ func (c *Client) GetClientStatus() (api.ClientStatusResponse, error) {
 	}
 	return response, nil
 }
+
+// Checks that the node address recorded in a backup archive is registered on chain
+func (c *Client) CheckBackupNode(nodeAddress common.Address) (api.CheckBackupNodeResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("service check-backup-node %s", nodeAddress.Hex()))
+	if err != nil {
+		return api.CheckBackupNodeResponse{}, fmt.Errorf("Could not check backup node: %w", err)
+	}
+	var response api.CheckBackupNodeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CheckBackupNodeResponse{}, fmt.Errorf("Could not decode check backup node response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CheckBackupNodeResponse{}, fmt.Errorf("Could not check backup node: %s", response.Error)
+	}
+	return response, nil
+}
@@ -0,0 +1,343 @@
+package rocketpool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/user"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a8m/envsubst"
+	"github.com/alessio/shellescape"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"golang.org/x/term"
+)
+
+// Settings
+const (
+	DefaultSSHPort        = "22"
+	DefaultKnownHostsPath = "~/.ssh/known_hosts"
+	sshDialTimeout        = 15 * time.Second
+)
+
+// The SSH connection is shared by every client in this process, so each invocation only connects once
+var (
+	sshConnection     *ssh.Client
+	sshRemoteHome     string
+	sshSettingsInUse  SSHSettings
+	sshConnectionLock sync.Mutex
+)
+
+// Settings for managing a remote node over SSH
+type SSHSettings struct {
+	// The remote host, as host, host:port or user@host:port
+	Host string
+
+	// The user to log in as; defaults to the local user
+	User string
+
+	// The private key to log in with; if empty, the SSH agent is used
+	KeyPath string
+
+	// The known_hosts file used to verify the remote host's key
+	KnownHostsPath string
+}
+
+// Connect to a remote node over SSH; the connection is reused for every command the client runs
+func (c *Client) connectSSH(settings SSHSettings) error {
+
+	sshConnectionLock.Lock()
+	defer sshConnectionLock.Unlock()
+
+	// Reuse the existing connection
+	if sshConnection != nil {
+		if settings != sshSettingsInUse {
+			return errors.New("already connected to a different remote host")
+		}
+		c.client = sshConnection
+		c.remoteHome = sshRemoteHome
+		return nil
+	}
+
+	// Parse the host
+	address := settings.Host
+	username := settings.User
+	if at := strings.LastIndex(address, "@"); at >= 0 {
+		if username == "" {
+			username = address[:at]
+		}
+		address = address[at+1:]
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, DefaultSSHPort)
+	}
+	if username == "" {
+		currentUser, err := user.Current()
+		if err != nil {
+			return fmt.Errorf("error getting the current user: %w", err)
+		}
+		username = currentUser.Username
+	}
+
+	// Get the host key verifier
+	knownHostsPath := settings.KnownHostsPath
+	if knownHostsPath == "" {
+		knownHostsPath = DefaultKnownHostsPath
+	}
+	knownHostsPath, err := homedir.Expand(knownHostsPath)
+	if err != nil {
+		return fmt.Errorf("error expanding known hosts path: %w", err)
+	}
+	knownHostsCallback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return fmt.Errorf("error loading known hosts from %s: %w", knownHostsPath, err)
+	}
+
+	// The handshake doesn't wrap the verification error, so it's kept here to explain it
+	var keyErr *knownhosts.KeyError
+	hostKeyCallback := func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		err := knownHostsCallback(hostname, remote, key)
+		errors.As(err, &keyErr)
+		return err
+	}
+
+	// Get the authentication method
+	authMethod, err := getSSHAuthMethod(settings.KeyPath)
+	if err != nil {
+		return err
+	}
+
+	// Connect
+	client, err := ssh.Dial("tcp", address, &ssh.ClientConfig{
+		User:            username,
+		Auth:            []ssh.AuthMethod{authMethod},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshDialTimeout,
+	})
+	if err != nil {
+		if keyErr != nil {
+			if len(keyErr.Want) == 0 {
+				return fmt.Errorf("the host key for %s is not in %s. Connect to it with `ssh` once to verify and save its key, then try again", address, knownHostsPath)
+			}
+			return fmt.Errorf("WARNING: the host key for %s does not match the one in %s. Someone may be intercepting the connection, or the host key has changed", address, knownHostsPath)
+		}
+		return fmt.Errorf("error connecting to %s: %w", address, err)
+	}
+
+	// Get the remote home directory so paths can be expanded
+	session, err := client.NewSession()
+	if err != nil {
+		client.Close()
+		return fmt.Errorf("error creating SSH session: %w", err)
+	}
+	defer session.Close()
+	home, err := session.Output(`printf %s "$HOME"`)
+	if err != nil {
+		client.Close()
+		return fmt.Errorf("error getting the remote home directory: %w", err)
+	}
+
+	sshConnection = client
+	sshRemoteHome = string(home)
+	sshSettingsInUse = settings
+	c.client = sshConnection
+	c.remoteHome = sshRemoteHome
+	return nil
+
+}
+
+// Get the method to log in with, using either a private key or the SSH agent
+func getSSHAuthMethod(keyPath string) (ssh.AuthMethod, error) {
+
+	// Use the SSH agent if no key is provided
+	if keyPath == "" {
+		socket := os.Getenv("SSH_AUTH_SOCK")
+		if socket == "" {
+			return nil, errors.New("no SSH key was provided and no SSH agent is running; use --ssh-key to specify a private key")
+		}
+		conn, err := net.Dial("unix", socket)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to the SSH agent: %w", err)
+		}
+		return ssh.PublicKeysCallback(agent.NewClient(conn).Signers), nil
+	}
+
+	// Load the key, prompting for its passphrase if it's encrypted
+	expandedPath, err := homedir.Expand(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding SSH key path: %w", err)
+	}
+	keyBytes, err := ioutil.ReadFile(expandedPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading SSH key: %w", err)
+	}
+	signer, err := ssh.ParsePrivateKey(keyBytes)
+	if err != nil {
+		var passphraseErr *ssh.PassphraseMissingError
+		if !errors.As(err, &passphraseErr) {
+			return nil, fmt.Errorf("error parsing SSH key: %w", err)
+		}
+		fmt.Printf("Please enter the passphrase for %s:\n", keyPath)
+		passphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Println("")
+		if err != nil {
+			return nil, fmt.Errorf("error reading SSH key passphrase: %w", err)
+		}
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(keyBytes, passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting SSH key: %w", err)
+		}
+	}
+	return ssh.PublicKeys(signer), nil
+
+}
+
+// Run a command on the remote host and get its output
+func (c *Client) runRemote(cmdText string, stdin []byte) ([]byte, error) {
+	session, err := c.client.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("error creating SSH session: %w", err)
+	}
+	defer session.Close()
+	if stdin != nil {
+		session.Stdin = bytes.NewReader(stdin)
+	}
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+	output, err := session.Output(cmdText)
+	if err != nil {
+		if stderr.Len() > 0 {
+			return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return nil, err
+	}
+	return output, nil
+}
+
+// Expand a path on the machine running the Smartnode
+func (c *Client) expandPath(path string) (string, error) {
+	if c.client == nil {
+		return homedir.Expand(path)
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		path = c.remoteHome + path[1:]
+	}
+	return strings.ReplaceAll(path, "$HOME", c.remoteHome), nil
+}
+
+// Check if a file exists on the machine running the Smartnode
+func (c *Client) fileExists(path string) (bool, error) {
+	if c.client == nil {
+		_, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	_, err := c.runRemote(fmt.Sprintf("test -e %s", shellescape.Quote(path)), nil)
+	if err != nil {
+		var exitErr *ssh.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitStatus() == 1 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Read a file on the machine running the Smartnode
+func (c *Client) readFile(path string) ([]byte, error) {
+	if c.client == nil {
+		return ioutil.ReadFile(path)
+	}
+	return c.runRemote(fmt.Sprintf("cat %s", shellescape.Quote(path)), nil)
+}
+
+// Read a template on the machine running the Smartnode and substitute the environment variables in it
+func (c *Client) readTemplate(path string) ([]byte, error) {
+	if c.client == nil {
+		return envsubst.ReadFile(path)
+	}
+	contents, err := c.readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return envsubst.Bytes(contents)
+}
+
+// List the files in a folder on the machine running the Smartnode
+func (c *Client) readDir(path string) ([]string, error) {
+	if c.client == nil {
+		infos, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		for _, info := range infos {
+			if !info.IsDir() {
+				names = append(names, info.Name())
+			}
+		}
+		return names, nil
+	}
+	output, err := c.runRemote(fmt.Sprintf("find %s -mindepth 1 -maxdepth 1 -type f -printf '%%f\\n'", shellescape.Quote(path)), nil)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, name := range strings.Split(string(output), "\n") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Write a file on the machine running the Smartnode
+func (c *Client) writeFile(path string, contents []byte, mode os.FileMode) error {
+	if c.client == nil {
+		return ioutil.WriteFile(path, contents, mode)
+	}
+	quotedPath := shellescape.Quote(path)
+	_, err := c.runRemote(fmt.Sprintf("cat > %s && chmod %o %s", quotedPath, mode, quotedPath), contents)
+	return err
+}
+
+// Create a folder and its parents on the machine running the Smartnode
+func (c *Client) mkdirAll(path string, mode os.FileMode) error {
+	if c.client == nil {
+		return os.MkdirAll(path, mode)
+	}
+	_, err := c.runRemote(fmt.Sprintf("mkdir -p -m %o %s", mode, shellescape.Quote(path)), nil)
+	return err
+}
+
+// Remove a file or folder and its contents on the machine running the Smartnode
+func (c *Client) removeAll(path string) error {
+	if c.client == nil {
+		return os.RemoveAll(path)
+	}
+	_, err := c.runRemote(fmt.Sprintf("rm -rf %s", shellescape.Quote(path)), nil)
+	return err
+}
+
+// Get the external IP address of the remote host
+func (c *Client) getRemoteExternalIP() (net.IP, error) {
+	output, err := c.runRemote("curl -s --max-time 10 https://api.ipify.org || wget -q -T 10 -O - https://api.ipify.org", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the remote external IP address: %w", err)
+	}
+	ip := net.ParseIP(strings.TrimSpace(string(output)))
+	if ip == nil {
+		return nil, fmt.Errorf("invalid remote external IP address [%s]", strings.TrimSpace(string(output)))
+	}
+	return ip, nil
+}
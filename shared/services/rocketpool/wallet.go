@@ -3,8 +3,11 @@ package rocketpool
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/offline"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -40,6 +43,42 @@ func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 	return response, nil
 }
 
+// Import an externally created validator keystore
+func (c *Client) ImportValidatorKey(keystoreJson string, password string, restartValidator bool) (api.ImportValidatorKeyResponse, error) {
+	args := "wallet import-validator-key"
+	if !restartValidator {
+		args += " --no-restart"
+	}
+	responseBytes, err := c.callAPI(args, keystoreJson, password)
+	if err != nil {
+		return api.ImportValidatorKeyResponse{}, fmt.Errorf("Could not import validator key: %w", err)
+	}
+	var response api.ImportValidatorKeyResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ImportValidatorKeyResponse{}, fmt.Errorf("Could not decode import validator key response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ImportValidatorKeyResponse{}, fmt.Errorf("Could not import validator key: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Unlock wallet
+func (c *Client) UnlockWallet(password string) (api.UnlockWalletResponse, error) {
+	responseBytes, err := c.callAPI("wallet unlock", password)
+	if err != nil {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not unlock wallet: %w", err)
+	}
+	var response api.UnlockWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not decode unlock wallet response: %w", err)
+	}
+	if response.Error != "" {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not unlock wallet: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Initialize wallet
 func (c *Client) InitWallet(derivationPath string) (api.InitWalletResponse, error) {
 	responseBytes, err := c.callAPI("wallet init --derivation-path", derivationPath)
@@ -82,11 +121,17 @@ func (c *Client) RecoverWallet(mnemonic string, skipValidatorKeyRecovery bool, d
 }
 
 // Search and recover wallet
-func (c *Client) SearchAndRecoverWallet(mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (api.SearchAndRecoverWalletResponse, error) {
+func (c *Client) SearchAndRecoverWallet(mnemonic string, address common.Address, skipValidatorKeyRecovery bool, startIndex uint, endIndex uint) (api.SearchAndRecoverWalletResponse, error) {
 	command := "wallet search-and-recover "
 	if skipValidatorKeyRecovery {
 		command += "--skip-validator-key-recovery "
 	}
+	if startIndex != 0 {
+		command += fmt.Sprintf("--start-index %d ", startIndex)
+	}
+	if endIndex != 0 {
+		command += fmt.Sprintf("--end-index %d ", endIndex)
+	}
 
 	responseBytes, err := c.callAPI(command, mnemonic, address.Hex())
 	if err != nil {
@@ -128,11 +173,17 @@ func (c *Client) TestRecoverWallet(mnemonic string, skipValidatorKeyRecovery boo
 }
 
 // Search and recover wallet
-func (c *Client) TestSearchAndRecoverWallet(mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (api.SearchAndRecoverWalletResponse, error) {
+func (c *Client) TestSearchAndRecoverWallet(mnemonic string, address common.Address, skipValidatorKeyRecovery bool, startIndex uint, endIndex uint) (api.SearchAndRecoverWalletResponse, error) {
 	command := "wallet test-search-and-recover "
 	if skipValidatorKeyRecovery {
 		command += "--skip-validator-key-recovery "
 	}
+	if startIndex != 0 {
+		command += fmt.Sprintf("--start-index %d ", startIndex)
+	}
+	if endIndex != 0 {
+		command += fmt.Sprintf("--end-index %d ", endIndex)
+	}
 
 	responseBytes, err := c.callAPI(command, mnemonic, address.Hex())
 	if err != nil {
@@ -195,3 +246,91 @@ func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
 	}
 	return response, nil
 }
+
+// Make the node wallet watch-only, for a node account whose wallet is kept on an offline machine
+func (c *Client) SetOfflineNodeAddress(address common.Address) (api.SetOfflineNodeAddressResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("wallet set-offline-address %s", address.Hex()))
+	if err != nil {
+		return api.SetOfflineNodeAddressResponse{}, fmt.Errorf("Could not set offline node address: %w", err)
+	}
+	var response api.SetOfflineNodeAddressResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SetOfflineNodeAddressResponse{}, fmt.Errorf("Could not decode set offline node address response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SetOfflineNodeAddressResponse{}, fmt.Errorf("Could not set offline node address: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Sign a bundle of transactions and exits built for offline signing
+func (c *Client) SignOfflineBundle(bundle *offline.UnsignedBundle, keystorePassword string) (api.SignOfflineBundleResponse, error) {
+	bundleBytes, err := json.Marshal(bundle)
+	if err != nil {
+		return api.SignOfflineBundleResponse{}, fmt.Errorf("Could not encode offline bundle: %w", err)
+	}
+	responseBytes, err := c.callAPI("wallet sign-offline", string(bundleBytes), keystorePassword)
+	if err != nil {
+		return api.SignOfflineBundleResponse{}, fmt.Errorf("Could not sign offline bundle: %w", err)
+	}
+	var response api.SignOfflineBundleResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SignOfflineBundleResponse{}, fmt.Errorf("Could not decode sign offline bundle response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SignOfflineBundleResponse{}, fmt.Errorf("Could not sign offline bundle: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the custom validator keystores in the data folder on the machine running the Smartnode, keyed by file name
+func (c *Client) GetCustomKeystores(cfg *config.RocketPoolConfig) (map[string][]byte, error) {
+	dataPath, err := c.expandPath(cfg.Smartnode.DataPath.Value.(string))
+	if err != nil {
+		return nil, fmt.Errorf("error expanding data directory: %w", err)
+	}
+	customKeyDir := filepath.Join(dataPath, "custom-keys")
+	exists, err := c.fileExists(customKeyDir)
+	if err != nil {
+		return nil, fmt.Errorf("error checking for the custom keystore folder: %w", err)
+	}
+	keystores := map[string][]byte{}
+	if !exists {
+		return keystores, nil
+	}
+
+	names, err := c.readDir(customKeyDir)
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating custom keystores: %w", err)
+	}
+	for _, name := range names {
+		bytes, err := c.readFile(filepath.Join(customKeyDir, name))
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom keystore %s: %w", name, err)
+		}
+		keystores[name] = bytes
+	}
+	return keystores, nil
+}
+
+// Save the custom validator keystore passwords in the data folder on the machine running the Smartnode, returning the file's path
+func (c *Client) SaveCustomKeyPasswords(cfg *config.RocketPoolConfig, contents []byte) (string, error) {
+	dataPath, err := c.expandPath(cfg.Smartnode.DataPath.Value.(string))
+	if err != nil {
+		return "", fmt.Errorf("error expanding data directory: %w", err)
+	}
+	passwordFile := filepath.Join(dataPath, "custom-key-passwords")
+	if err := c.writeFile(passwordFile, contents, 0600); err != nil {
+		return "", fmt.Errorf("error writing keystore passwords file: %w", err)
+	}
+	return passwordFile, nil
+}
+
+// Delete a custom validator keystore passwords file saved with SaveCustomKeyPasswords
+func (c *Client) DeleteCustomKeyPasswords(passwordFile string) error {
+	exists, err := c.fileExists(passwordFile)
+	if err != nil || !exists {
+		return err
+	}
+	return c.removeAll(passwordFile)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strconv"
 	"sync"
 
 	"github.com/docker/docker/client"
@@ -16,11 +17,13 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/contracts"
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -36,6 +39,7 @@ const (
 var (
 	cfg                *config.RocketPoolConfig
 	passwordManager    *passwords.PasswordManager
+	passwordManagerErr error
 	nodeWallet         *wallet.Wallet
 	ecManager          *ExecutionClientManager
 	bcManager          *BeaconClientManager
@@ -45,10 +49,11 @@ var (
 	snapshotDelegation *contracts.SnapshotDelegation
 	beaconClient       beacon.Client
 	docker             *client.Client
+	transactionManager *txmanager.Manager
 
 	initCfg                sync.Once
 	initPasswordManager    sync.Once
-	initNodeWallet         sync.Once
+	nodeWalletLock         sync.Mutex
 	initECManager          sync.Once
 	initBCManager          sync.Once
 	initRocketPool         sync.Once
@@ -57,6 +62,7 @@ var (
 	initSnapshotDelegation sync.Once
 	initBeaconClient       sync.Once
 	initDocker             sync.Once
+	initTransactionManager sync.Once
 )
 
 //
@@ -72,7 +78,7 @@ func GetPasswordManager(c *cli.Context) (*passwords.PasswordManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	return getPasswordManager(cfg), nil
+	return getPasswordManager(cfg)
 }
 
 func GetWallet(c *cli.Context) (*wallet.Wallet, error) {
@@ -80,10 +86,32 @@ func GetWallet(c *cli.Context) (*wallet.Wallet, error) {
 	if err != nil {
 		return nil, err
 	}
-	pm := getPasswordManager(cfg)
+	pm, err := getPasswordManager(cfg)
+	if err != nil {
+		return nil, err
+	}
 	return getWallet(c, cfg, pm)
 }
 
+func GetTransactionManager(c *cli.Context) (*txmanager.Manager, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	pm, err := getPasswordManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := getWallet(c, cfg, pm); err != nil {
+		return nil, err
+	}
+	ec, err := getEthClient(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return getTransactionManager(cfg, ec), nil
+}
+
 func GetEthClient(c *cli.Context) (*ExecutionClientManager, error) {
 	cfg, err := getConfig(c)
 	if err != nil {
@@ -173,39 +201,61 @@ func getConfig(c *cli.Context) (*config.RocketPoolConfig, error) {
 	return cfg, err
 }
 
-func getPasswordManager(cfg *config.RocketPoolConfig) *passwords.PasswordManager {
+func getPasswordManager(cfg *config.RocketPoolConfig) (*passwords.PasswordManager, error) {
 	initPasswordManager.Do(func() {
-		passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
+		var err error
+		defer func() {
+			passwordManagerErr = err
+		}()
+		if err = cfg.Smartnode.CheckPasswordBackend(); err != nil {
+			return
+		}
+		var store passwords.Store
+		source := cfg.Smartnode.GetPasswordSource()
+		switch cfg.Smartnode.GetPasswordBackend() {
+		case cfgtypes.PasswordBackend_SystemdCreds:
+			store = passwords.NewSystemdCredentialStore(source)
+		case cfgtypes.PasswordBackend_Keyring:
+			store = passwords.NewKeyringStore(source)
+		case cfgtypes.PasswordBackend_Env:
+			store = passwords.NewEnvStore(source)
+		case cfgtypes.PasswordBackend_Fd:
+			var fd uint64
+			fd, err = strconv.ParseUint(source, 10, 32)
+			if err != nil {
+				err = fmt.Errorf("Invalid password file descriptor '%s': %w", source, err)
+				return
+			}
+			store = passwords.NewFdStore(uintptr(fd))
+		case cfgtypes.PasswordBackend_Interactive:
+			store = passwords.NewAgentStore(os.ExpandEnv(cfg.Smartnode.GetPasswordAgentSocketPath()))
+		default:
+			store = passwords.NewFileStore(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
+		}
+		passwordManager = passwords.NewPasswordManagerWithStore(store)
 	})
-	return passwordManager
+	return passwordManager, passwordManagerErr
 }
 
+// The wallet is only cached once it loads, so one that fails while its password is locked or missing loads again after it's unlocked
 func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.PasswordManager) (*wallet.Wallet, error) {
-	var err error
-	initNodeWallet.Do(func() {
-		var maxFee *big.Int
-		maxFeeFloat := c.GlobalFloat64("maxFee")
-		if maxFeeFloat == 0 {
-			maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
-		}
-		if maxFeeFloat != 0 {
-			maxFee = eth.GweiToWei(maxFeeFloat)
-		}
-
-		var maxPriorityFee *big.Int
-		maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
-		if maxPriorityFeeFloat == 0 {
-			maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
-		}
-		if maxPriorityFeeFloat != 0 {
-			maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
-		}
+	nodeWalletLock.Lock()
+	defer nodeWalletLock.Unlock()
+	if nodeWallet != nil {
+		return nodeWallet, nil
+	}
+	w, err := func() (*wallet.Wallet, error) {
+		maxFee, maxPriorityFee := getGasSettings(cfg, c.GlobalFloat64("maxFee"), c.GlobalFloat64("maxPrioFee"))
 
 		chainId := cfg.Smartnode.GetChainID()
 
-		nodeWallet, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm)
+		nodeWallet, err := wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm)
 		if err != nil {
-			return
+			return nil, err
+		}
+		err = nodeWallet.LoadOfflineAddress(os.ExpandEnv(cfg.Smartnode.GetOfflineNodeAddressPath()))
+		if err != nil {
+			return nil, err
 		}
 
 		// Keystores
@@ -217,8 +267,51 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 		nodeWallet.AddKeystore("nimbus", nimbusKeystore)
 		nodeWallet.AddKeystore("prysm", prysmKeystore)
 		nodeWallet.AddKeystore("teku", tekuKeystore)
-	})
-	return nodeWallet, err
+
+		// Record the node account's transactions so processes sending them at the same time don't use the same nonces
+		ec, err := getEthClient(c, cfg)
+		if err != nil {
+			return nil, err
+		}
+		txManager := getTransactionManager(cfg, ec)
+		nodeWallet.SetTransactorHook(txManager.Track)
+		txManager.SetTransactorSource(nodeWallet.GetNodeAccountTransactor)
+		return nodeWallet, nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+	nodeWallet = w
+	return nodeWallet, nil
+}
+
+// Get the max fee and priority fee to use, falling back to the config settings
+func getGasSettings(cfg *config.RocketPoolConfig, maxFeeFloat float64, maxPriorityFeeFloat float64) (*big.Int, *big.Int) {
+	var maxFee *big.Int
+	if maxFeeFloat == 0 {
+		maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
+	}
+	if maxFeeFloat != 0 {
+		maxFee = eth.GweiToWei(maxFeeFloat)
+	}
+
+	var maxPriorityFee *big.Int
+	if maxPriorityFeeFloat == 0 {
+		maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
+	}
+	if maxPriorityFeeFloat != 0 {
+		maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
+	}
+
+	return maxFee, maxPriorityFee
+}
+
+// Get the most to pay when replacing stuck automatic transactions; the manual max fee takes priority if it's set
+func GetAutoReplaceMaxFee(cfg *config.RocketPoolConfig) *big.Int {
+	if maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64); maxFeeGwei > 0 {
+		return eth.GweiToWei(maxFeeGwei)
+	}
+	return eth.GweiToWei(cfg.Smartnode.AutoReplaceMaxFee.Value.(float64))
 }
 
 func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClientManager, error) {
@@ -239,14 +332,26 @@ func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClien
 	return ecManager, err
 }
 
-func getRocketPool(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*rocketpool.RocketPool, error) {
+func getRocketPool(cfg *config.RocketPoolConfig, client *ExecutionClientManager) (*rocketpool.RocketPool, error) {
 	var err error
 	initRocketPool.Do(func() {
 		rocketPool, err = rocketpool.NewRocketPool(client, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+		if err == nil {
+			// Decode the errors of transactions that would revert with the Rocket Pool contract ABIs
+			client.SetContractResolver(newRocketPoolContractResolver(rocketPool))
+		}
 	})
 	return rocketPool, err
 }
 
+func getTransactionManager(cfg *config.RocketPoolConfig, client *ExecutionClientManager) *txmanager.Manager {
+	initTransactionManager.Do(func() {
+		transactionManager = txmanager.NewManager(os.ExpandEnv(cfg.Smartnode.GetTransactionJournalPath()), client, big.NewInt(int64(cfg.Smartnode.GetChainID())))
+		client.SetTransactionSentHandler(transactionManager.HandleSent)
+	})
+	return transactionManager
+}
+
 func getOneInchOracle(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*contracts.OneInchOracle, error) {
 	var err error
 	initOneInchOracle.Do(func() {
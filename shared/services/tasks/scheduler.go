@@ -0,0 +1,230 @@
+package tasks
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// A task and the time it's next due to run
+type scheduledTask struct {
+	task     Task
+	settings Settings
+	nextRun  time.Time
+
+	// Run history, guarded by the scheduler's lock
+	runningSince  time.Time
+	lastRun       time.Time
+	lastSuccess   time.Time
+	lastError     string
+	lastErrorTime time.Time
+}
+
+// The run history and schedule of a task
+type TaskStatus struct {
+	Name          string     `json:"name"`
+	Running       bool       `json:"running"`
+	RunningSince  *time.Time `json:"runningSince,omitempty"`
+	LastRun       *time.Time `json:"lastRun,omitempty"`
+	LastSuccess   *time.Time `json:"lastSuccess,omitempty"`
+	LastError     string     `json:"lastError,omitempty"`
+	LastErrorTime *time.Time `json:"lastErrorTime,omitempty"`
+	NextRun       *time.Time `json:"nextRun,omitempty"`
+}
+
+// Runs a daemon's tasks one at a time until its context is cancelled
+type Scheduler struct {
+	ctx          context.Context
+	log          log.ColorLogger
+	errorLog     log.ColorLogger
+	cooldown     time.Duration
+	precondition func() error
+	tasks        []*scheduledTask
+	background   sync.WaitGroup
+	lock         sync.Mutex
+}
+
+// Create a scheduler; the context controls when the daemon shuts down
+func NewScheduler(ctx context.Context, logger log.ColorLogger, errorLogger log.ColorLogger, cooldown time.Duration) *Scheduler {
+	return &Scheduler{
+		ctx:      ctx,
+		log:      logger,
+		errorLog: errorLogger,
+		cooldown: cooldown,
+	}
+}
+
+// Set a check that must pass before any tasks are run (e.g. that the clients are synced)
+func (s *Scheduler) SetPrecondition(precondition func() error) {
+	s.precondition = precondition
+}
+
+// Add a task to the scheduler; tasks that are due at the same time run in the order they were added
+func (s *Scheduler) Add(task Task) {
+	settings := task.Settings()
+	if !settings.Enabled {
+		s.log.Printlnf("Task '%s' is disabled.", settings.Name)
+		return
+	}
+	s.tasks = append(s.tasks, &scheduledTask{
+		task:     task,
+		settings: settings,
+	})
+}
+
+// Run work in the background; the scheduler waits for it to finish before shutting down
+func (s *Scheduler) Go(work func(ctx context.Context)) {
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		work(s.ctx)
+	}()
+}
+
+// Run the tasks until the context is cancelled, then wait for in-flight work to finish
+func (s *Scheduler) Run() {
+
+	for s.ctx.Err() == nil {
+		// Get the tasks that are due
+		now := time.Now()
+		due := []*scheduledTask{}
+		for _, task := range s.tasks {
+			if !task.nextRun.After(now) {
+				due = append(due, task)
+			}
+		}
+
+		if len(due) > 0 {
+			if s.precondition != nil {
+				if err := s.precondition(); err != nil {
+					s.errorLog.Println(err)
+					for _, task := range due {
+						s.finishRun(task, err)
+					}
+					due = nil
+				}
+			}
+
+			// Run the due tasks
+			for i, task := range due {
+				if i > 0 && !s.sleep(s.cooldown) {
+					break
+				}
+				s.runTask(task)
+			}
+		}
+
+		// Wait for the next task to be due
+		next := time.Time{}
+		for _, task := range s.tasks {
+			if next.IsZero() || task.nextRun.Before(next) {
+				next = task.nextRun
+			}
+		}
+		if next.IsZero() {
+			// Nothing is enabled, so just wait for shutdown
+			<-s.ctx.Done()
+			break
+		}
+		s.sleep(time.Until(next))
+	}
+
+	// Wait for background work
+	s.log.Println("Waiting for background work to finish...")
+	s.background.Wait()
+	s.log.Println("All tasks have stopped.")
+
+}
+
+// Run a single task and schedule its next run
+func (s *Scheduler) runTask(task *scheduledTask) {
+
+	ctx := s.ctx
+	if task.settings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(s.ctx, task.settings.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	s.lock.Lock()
+	task.runningSince = start
+	task.lastRun = start
+	s.lock.Unlock()
+
+	err := task.task.Run(ctx)
+	if err != nil {
+		s.errorLog.Println(err)
+	}
+	if task.settings.Timeout > 0 && time.Since(start) > task.settings.Timeout {
+		s.errorLog.Printlnf("WARNING: task '%s' took %s, which is longer than its timeout of %s.", task.settings.Name, time.Since(start).Round(time.Second), task.settings.Timeout)
+	}
+	s.finishRun(task, err)
+
+}
+
+// Record the result of a task run and schedule its next run
+func (s *Scheduler) finishRun(task *scheduledTask, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	task.runningSince = time.Time{}
+	if err != nil {
+		task.lastError = err.Error()
+		task.lastErrorTime = now
+	} else {
+		task.lastSuccess = now
+	}
+
+	delay := task.settings.Interval
+	if task.settings.Jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(task.settings.Jitter)))
+	}
+	task.nextRun = now.Add(delay)
+}
+
+// Get the run history and schedule of each enabled task
+func (s *Scheduler) Status() []TaskStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	optionalTime := func(t time.Time) *time.Time {
+		if t.IsZero() {
+			return nil
+		}
+		return &t
+	}
+	statuses := make([]TaskStatus, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		statuses = append(statuses, TaskStatus{
+			Name:          task.settings.Name,
+			Running:       !task.runningSince.IsZero(),
+			RunningSince:  optionalTime(task.runningSince),
+			LastRun:       optionalTime(task.lastRun),
+			LastSuccess:   optionalTime(task.lastSuccess),
+			LastError:     task.lastError,
+			LastErrorTime: optionalTime(task.lastErrorTime),
+			NextRun:       optionalTime(task.nextRun),
+		})
+	}
+	return statuses
+}
+
+// Sleep for the given duration, returning false if the context was cancelled first
+func (s *Scheduler) sleep(duration time.Duration) bool {
+	if duration <= 0 {
+		return s.ctx.Err() == nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-s.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Get a context that is cancelled when the process receives SIGINT or SIGTERM.
+// A second signal exits immediately, for when waiting on in-flight work isn't wanted.
+func NewShutdownContext(logger log.ColorLogger) (context.Context, context.CancelFunc) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		sig := <-signals
+		logger.Printlnf("Received %s, shutting down once in-flight tasks finish (send it again to exit immediately)...", sig)
+		cancel()
+		sig = <-signals
+		logger.Printlnf("Received %s again, exiting immediately.", sig)
+		os.Exit(1)
+	}()
+
+	return ctx, cancel
+
+}
+
+// Run a blocking wait (e.g. for the node to be registered), returning early if the context is cancelled first
+func WaitFor(ctx context.Context, wait func() error) error {
+	result := make(chan error, 1)
+	go func() {
+		result <- wait()
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-result:
+		return err
+	}
+}
@@ -0,0 +1,57 @@
+package tasks
+
+import (
+	"context"
+	"time"
+)
+
+// Scheduling settings for a daemon task
+type Settings struct {
+	// The name of the task, used for logging
+	Name string
+
+	// The time to wait between runs of the task
+	Interval time.Duration
+
+	// The maximum random delay added to each interval
+	Jitter time.Duration
+
+	// The deadline given to each run of the task; 0 for no deadline
+	Timeout time.Duration
+
+	// Whether the task should be run at all
+	Enabled bool
+}
+
+// A task that is run periodically by a daemon
+type Task interface {
+	// Get the scheduling settings for the task
+	Settings() Settings
+
+	// Run the task once; the context is cancelled when the daemon shuts down or the timeout passes
+	Run(ctx context.Context) error
+}
+
+// A task backed by a run function
+type funcTask struct {
+	settings Settings
+	run      func(ctx context.Context) error
+}
+
+// Create a task from its settings and run function
+func New(settings Settings, run func(ctx context.Context) error) Task {
+	return &funcTask{
+		settings: settings,
+		run:      run,
+	}
+}
+
+// Get the scheduling settings for the task
+func (t *funcTask) Settings() Settings {
+	return t.settings
+}
+
+// Run the task once
+func (t *funcTask) Run(ctx context.Context) error {
+	return t.run(ctx)
+}
@@ -0,0 +1,98 @@
+package txmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+// How long finished transactions are kept in the journal
+const JournalRetention = 30 * 24 * time.Hour
+
+// The journal of transactions sent by the node account.
+// It's shared by the API and the daemons, so access to it is serialized with a lock file.
+type journal struct {
+	path string
+}
+
+// Lock the journal for exclusive access, returning a function that unlocks it
+func (j *journal) lock() (func(), error) {
+	return lockFile(j.path, "transaction journal")
+}
+
+// Load the transactions in the journal; the journal must be locked
+func (j *journal) load() ([]*Transaction, error) {
+	bytes, err := ioutil.ReadFile(j.path)
+	if os.IsNotExist(err) {
+		return []*Transaction{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading transaction journal: %w", err)
+	}
+	transactions := []*Transaction{}
+	if err := json.Unmarshal(bytes, &transactions); err != nil {
+		return nil, fmt.Errorf("error deserializing transaction journal: %w", err)
+	}
+	return transactions, nil
+}
+
+// Save the transactions to the journal, dropping old ones that have finished; the journal must be locked
+func (j *journal) save(transactions []*Transaction) error {
+	kept := []*Transaction{}
+	for _, tx := range transactions {
+		if isFinished(transactions, tx) && time.Since(tx.SentTime) > JournalRetention {
+			continue
+		}
+		kept = append(kept, tx)
+	}
+	bytes, err := json.MarshalIndent(kept, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing transaction journal: %w", err)
+	}
+	return writeFile(j.path, bytes, "transaction journal")
+}
+
+// Check if a transaction and everything else sent with its nonce has finished
+func isFinished(transactions []*Transaction, tx *Transaction) bool {
+	for _, other := range transactions {
+		if other.From == tx.From && other.Nonce == tx.Nonce && other.Status == TransactionStatus_Pending {
+			return false
+		}
+	}
+	return true
+}
+
+// Lock a file shared with other processes for exclusive access, returning a function that unlocks it
+func lockFile(path string, name string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("error creating %s directory: %w", name, err)
+	}
+	file, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s lock: %w", name, err)
+	}
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error locking %s: %w", name, err)
+	}
+	return func() {
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		file.Close()
+	}, nil
+}
+
+// Write a file so it can't be left half-written
+func writeFile(path string, bytes []byte, name string) error {
+	tempPath := path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, bytes, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("error writing %s: %w", name, err)
+	}
+	return nil
+}
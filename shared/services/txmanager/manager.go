@@ -0,0 +1,735 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	// The percentage that both fees of a replacement transaction must be raised by for clients to accept it
+	ReplacementFeeIncrease int64 = 10
+
+	// How long a transaction that was signed but may not have reached the client keeps its nonce reserved
+	ReservationTimeout = time.Minute
+
+	// How long a daemon's transaction can be pending before it's replaced automatically, and how often that can happen
+	StuckTransactionTimeout = 5 * time.Minute
+	MaxAutoReplacements     = 5
+
+	WaitInterval          = 12 * time.Second
+	CancelGasLimit uint64 = 21000
+)
+
+// Keeps a journal of the transactions sent by the node account, so concurrent processes don't use the same nonces
+// and pending transactions can be followed, sped up or cancelled
+type Manager struct {
+	journal           journal
+	ec                rocketpool.ExecutionClient
+	chainID           *big.Int
+	source            string
+	getTransactor     func() (*bind.TransactOpts, error)
+	autoReplace       bool
+	autoReplaceMaxFee *big.Int
+}
+
+// Create a new transaction manager
+func NewManager(journalPath string, ec rocketpool.ExecutionClient, chainID *big.Int) *Manager {
+	return &Manager{
+		journal: journal{path: journalPath},
+		ec:      ec,
+		chainID: chainID,
+		source:  Source_Api,
+	}
+}
+
+// Set the process that transactions are recorded as being sent by
+func (m *Manager) SetSource(source string) {
+	m.source = source
+}
+
+// Set the function used to get a transactor for the node account when replacing transactions automatically
+func (m *Manager) SetTransactorSource(getTransactor func() (*bind.TransactOpts, error)) {
+	m.getTransactor = getTransactor
+}
+
+// Replace this process's transactions automatically when they get stuck, with a max fee of up to the provided limit (nil for no limit)
+func (m *Manager) EnableAutoReplace(maxFee *big.Int) {
+	m.autoReplace = true
+	m.autoReplaceMaxFee = maxFee
+}
+
+// Record the transactions signed with a transactor in the journal.
+// Unless the transactor has a nonce set, each one is given the next nonce that isn't held by another pending transaction.
+func (m *Manager) Track(opts *bind.TransactOpts) {
+	signer := opts.Signer
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return m.sign(address, tx, signer, opts.Nonce == nil)
+	}
+}
+
+// Record a transaction that was signed by an offline machine in the journal, before it's broadcast
+func (m *Manager) RecordSigned(tx *types.Transaction) error {
+
+	from, err := types.Sender(types.LatestSignerForChainID(m.chainID), tx)
+	if err != nil {
+		return fmt.Errorf("error getting transaction sender: %w", err)
+	}
+
+	unlock, err := m.journal.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	transactions, err := m.journal.load()
+	if err != nil {
+		return err
+	}
+	if findTransaction(transactions, tx.Hash()) != nil {
+		return nil
+	}
+
+	// Record it, along with the pending transaction it replaces
+	entry, err := newTransaction(tx, from, Source_Offline)
+	if err != nil {
+		return fmt.Errorf("error serializing transaction: %w", err)
+	}
+	for _, other := range transactions {
+		if other.From == from && other.Nonce == entry.Nonce && other.Status == TransactionStatus_Pending {
+			entry.Replaces = &other.Hash
+		}
+	}
+	transactions = append(transactions, entry)
+	return m.journal.save(transactions)
+
+}
+
+// Record the result of broadcasting a transaction; this is called by the execution client after sending one
+func (m *Manager) HandleSent(tx *types.Transaction, sendErr error) {
+
+	unlock, err := m.journal.lock()
+	if err != nil {
+		return
+	}
+	defer unlock()
+	transactions, err := m.journal.load()
+	if err != nil {
+		return
+	}
+
+	// Get the transaction
+	index := -1
+	for i, other := range transactions {
+		if other.Hash == tx.Hash() {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return
+	}
+	sent := transactions[index]
+
+	if sendErr != nil && !strings.Contains(sendErr.Error(), "already known") {
+		// Release the nonce of a transaction that never reached the client
+		if !sent.Broadcast {
+			transactions = append(transactions[:index], transactions[index+1:]...)
+			m.journal.save(transactions)
+		}
+		return
+	}
+
+	// Mark the transactions it replaces
+	sent.Broadcast = true
+	for _, other := range transactions {
+		if other != sent && other.From == sent.From && other.Nonce == sent.Nonce && other.Status == TransactionStatus_Pending {
+			other.Status = TransactionStatus_Replaced
+			other.ReplacedBy = &sent.Hash
+		}
+	}
+	m.journal.save(transactions)
+
+}
+
+// Get the transactions in the journal
+func (m *Manager) GetTransactions() ([]*Transaction, error) {
+	unlock, err := m.journal.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return m.journal.load()
+}
+
+// Update the status of the pending transactions in the journal, and rebroadcast any that the client has lost
+func (m *Manager) Update() error {
+
+	lostTxs, err := m.updateJournal()
+	if err != nil {
+		return err
+	}
+
+	// Rebroadcast outside of the lock, since the client reports the result back to the journal
+	for _, tx := range lostTxs {
+		if err := m.ec.SendTransaction(context.Background(), tx); err != nil && !strings.Contains(err.Error(), "already known") {
+			return fmt.Errorf("error rebroadcasting transaction %s: %w", tx.Hash().Hex(), err)
+		}
+	}
+	return nil
+
+}
+
+// Get a transaction from the journal, and check that it's pending so it can be replaced
+func (m *Manager) GetReplaceableTransaction(hash common.Hash) (*Transaction, error) {
+
+	if err := m.Update(); err != nil {
+		return nil, err
+	}
+	transactions, err := m.GetTransactions()
+	if err != nil {
+		return nil, err
+	}
+	tx := findTransaction(transactions, hash)
+	if tx == nil {
+		return nil, fmt.Errorf("Transaction %s isn't in the node's transaction journal", hash.Hex())
+	}
+
+	switch tx.Status {
+	case TransactionStatus_Pending:
+		return tx, nil
+	case TransactionStatus_Replaced:
+		return nil, fmt.Errorf("Transaction %s has already been replaced by %s", hash.Hex(), tx.ReplacedBy.Hex())
+	default:
+		return nil, fmt.Errorf("Transaction %s is no longer pending (it was %s)", hash.Hex(), tx.Status)
+	}
+
+}
+
+// Get the fees for a replacement of a pending transaction.
+// Fees that are provided are checked against the minimum increase; the others are based on the current network fees.
+func (m *Manager) GetReplacementFees(tx *Transaction, maxFee *big.Int, maxPriorityFee *big.Int) (*big.Int, *big.Int, error) {
+
+	minMaxFee := getMinReplacementFee(tx.MaxFee)
+	minMaxPriorityFee := getMinReplacementFee(tx.MaxPriorityFee)
+
+	// Priority fee
+	if maxPriorityFee == nil {
+		suggestedPriorityFee, err := m.ec.SuggestGasTipCap(context.Background())
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting suggested priority fee: %w", err)
+		}
+		maxPriorityFee = getMaxBigInt(minMaxPriorityFee, suggestedPriorityFee)
+	} else if maxPriorityFee.Cmp(minMaxPriorityFee) < 0 {
+		return nil, nil, fmt.Errorf("The priority fee must be at least %.6f Gwei to replace transaction %s", eth.WeiToGwei(minMaxPriorityFee), tx.Hash.Hex())
+	}
+
+	// Max fee
+	if maxFee == nil {
+		header, err := m.ec.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting latest block header: %w", err)
+		}
+		suggestedMaxFee := new(big.Int).Set(maxPriorityFee)
+		if header.BaseFee != nil {
+			suggestedMaxFee.Add(suggestedMaxFee, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+		}
+		maxFee = getMaxBigInt(minMaxFee, suggestedMaxFee)
+	} else if maxFee.Cmp(minMaxFee) < 0 {
+		return nil, nil, fmt.Errorf("The max fee must be at least %.6f Gwei to replace transaction %s", eth.WeiToGwei(minMaxFee), tx.Hash.Hex())
+	}
+
+	if maxPriorityFee.Cmp(maxFee) > 0 {
+		return nil, nil, fmt.Errorf("The priority fee (%.6f Gwei) can't be higher than the max fee (%.6f Gwei)", eth.WeiToGwei(maxPriorityFee), eth.WeiToGwei(maxFee))
+	}
+	return maxFee, maxPriorityFee, nil
+
+}
+
+// Speed up a pending transaction by sending a copy of it with higher fees, returning the hash of the replacement.
+// The transactor's fees are used if they're set, otherwise they're based on the current network fees.
+func (m *Manager) SpeedUp(hash common.Hash, opts *bind.TransactOpts) (common.Hash, error) {
+	tx, err := m.GetReplaceableTransaction(hash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	maxFee, maxPriorityFee, err := m.GetReplacementFees(tx, opts.GasFeeCap, opts.GasTipCap)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return m.speedUp(tx, opts, maxFee, maxPriorityFee)
+}
+
+// Cancel a pending transaction by replacing it with an empty transfer to the node account, returning the hash of the replacement.
+// The transactor's fees are used if they're set, otherwise they're based on the current network fees.
+func (m *Manager) Cancel(hash common.Hash, opts *bind.TransactOpts) (common.Hash, error) {
+	tx, err := m.GetReplaceableTransaction(hash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	maxFee, maxPriorityFee, err := m.GetReplacementFees(tx, opts.GasFeeCap, opts.GasTipCap)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return m.replace(tx, opts, &types.DynamicFeeTx{
+		GasTipCap: maxPriorityFee,
+		GasFeeCap: maxFee,
+		Gas:       CancelGasLimit,
+		To:        &tx.From,
+		Value:     big.NewInt(0),
+	})
+}
+
+// Replace this process's transactions that have been pending for too long, if auto-replacement is enabled
+func (m *Manager) ReplaceStuckTransactions(ctx context.Context, logger log.ColorLogger) error {
+
+	if !m.autoReplace {
+		return nil
+	}
+	if err := m.Update(); err != nil {
+		return err
+	}
+	transactions, err := m.GetTransactions()
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range transactions {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if tx.Status != TransactionStatus_Pending || tx.Source != m.source || !tx.Broadcast || time.Since(tx.SentTime) < StuckTransactionTimeout {
+			continue
+		}
+		if countReplacements(transactions, tx) >= MaxAutoReplacements {
+			continue
+		}
+		if err := m.replaceStuckTransaction(tx, logger); err != nil {
+			logger.Printlnf("Could not replace stuck transaction %s: %s", tx.Hash.Hex(), err.Error())
+		}
+	}
+	return nil
+
+}
+
+// Wait for a transaction to be mined, following any replacements of it.
+// If auto-replacement is enabled, this process's transactions are replaced while waiting if they get stuck.
+func (m *Manager) WaitForTransaction(hash common.Hash, logger *log.ColorLogger) (*types.Receipt, error) {
+	return m.WaitForTransactionContext(context.Background(), hash, logger)
+}
+
+// Wait for a transaction to be mined like WaitForTransaction, giving up when the context is cancelled
+func (m *Manager) WaitForTransactionContext(ctx context.Context, hash common.Hash, logger *log.ColorLogger) (*types.Receipt, error) {
+
+	// Transactions that weren't sent by the node account can only be waited on directly
+	transactions, err := m.GetTransactions()
+	if err != nil {
+		return nil, err
+	}
+	if findTransaction(transactions, hash) == nil {
+		return utils.WaitForTransaction(m.ec, hash)
+	}
+
+	for {
+
+		// Update the journal
+		if m.autoReplace && logger != nil {
+			err = m.ReplaceStuckTransactions(ctx, *logger)
+		} else {
+			err = m.Update()
+		}
+		if err != nil && logger != nil {
+			logger.Printlnf("Error updating transaction journal: %s", err.Error())
+		}
+		transactions, err := m.GetTransactions()
+		if err != nil {
+			return nil, err
+		}
+		tx := findTransaction(transactions, hash)
+		if tx == nil {
+			return nil, fmt.Errorf("Transaction %s was removed from the transaction journal", hash.Hex())
+		}
+
+		// Check if it or one of its replacements was mined
+		isPending := false
+		for _, other := range transactions {
+			if other.From != tx.From || other.Nonce != tx.Nonce {
+				continue
+			}
+			switch other.Status {
+			case TransactionStatus_Pending:
+				isPending = true
+			case TransactionStatus_Mined, TransactionStatus_Reverted:
+				receipt, err := m.ec.TransactionReceipt(context.Background(), other.Hash)
+				if err != nil {
+					return nil, fmt.Errorf("error getting receipt for transaction %s: %w", other.Hash.Hex(), err)
+				}
+				if other.Hash != hash && logger != nil {
+					logger.Printlnf("Transaction %s, which has the same nonce, was mined instead of %s.", other.Hash.Hex(), hash.Hex())
+				}
+				if receipt.Status == types.ReceiptStatusFailed {
+					return receipt, errors.New("Transaction failed with status 0")
+				}
+				return receipt, nil
+			}
+		}
+		if !isPending {
+			return nil, fmt.Errorf("Transaction %s was dropped without being mined", hash.Hex())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Stopped waiting for transaction %s: %w", hash.Hex(), ctx.Err())
+		case <-time.After(WaitInterval):
+		}
+
+	}
+
+}
+
+// Sign a transaction and record it in the journal
+func (m *Manager) sign(address common.Address, tx *types.Transaction, signer bind.SignerFn, assignNonce bool) (*types.Transaction, error) {
+
+	unlock, err := m.journal.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	transactions, err := m.journal.load()
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip the nonces held by transactions that may not have reached the client yet
+	if assignNonce {
+		nonce := getNextNonce(transactions, address, tx.Nonce())
+		if nonce != tx.Nonce() {
+			tx, err = setNonce(tx, nonce)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Sign it
+	signedTx, err := signer(address, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record it, along with the pending transaction it replaces
+	entry, err := newTransaction(signedTx, address, m.source)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing transaction: %w", err)
+	}
+	for _, other := range transactions {
+		if other.From == address && other.Nonce == entry.Nonce && other.Status == TransactionStatus_Pending {
+			entry.Replaces = &other.Hash
+		}
+	}
+	transactions = append(transactions, entry)
+	if err := m.journal.save(transactions); err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+
+}
+
+// Update the status of the pending transactions in the journal, returning the ones that need to be rebroadcast
+func (m *Manager) updateJournal() ([]*types.Transaction, error) {
+
+	unlock, err := m.journal.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	transactions, err := m.journal.load()
+	if err != nil {
+		return nil, err
+	}
+
+	chainNonces := map[common.Address]uint64{}
+	lostTxs := []*types.Transaction{}
+	for _, group := range getPendingGroups(transactions) {
+
+		// Check if any of the transactions with the nonce were mined
+		var minedTx *Transaction
+		for _, tx := range group {
+			receipt, err := m.ec.TransactionReceipt(context.Background(), tx.Hash)
+			if errors.Is(err, ethereum.NotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error getting receipt for transaction %s: %w", tx.Hash.Hex(), err)
+			}
+			minedTx = tx
+			minedTx.ReplacedBy = nil
+			minedTx.BlockNumber = receipt.BlockNumber.Uint64()
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				minedTx.Status = TransactionStatus_Mined
+			} else {
+				minedTx.Status = TransactionStatus_Reverted
+			}
+			break
+		}
+		if minedTx != nil {
+			for _, tx := range group {
+				if tx != minedTx && !tx.IsFinal() {
+					tx.Status = TransactionStatus_Replaced
+					tx.ReplacedBy = &minedTx.Hash
+				}
+			}
+			continue
+		}
+
+		// Check if the nonce was used by a transaction that isn't in the journal
+		from := group[0].From
+		chainNonce, exists := chainNonces[from]
+		if !exists {
+			chainNonce, err = m.ec.NonceAt(context.Background(), from, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error getting nonce of %s: %w", from.Hex(), err)
+			}
+			chainNonces[from] = chainNonce
+		}
+		for _, tx := range group {
+			if tx.Status != TransactionStatus_Pending {
+				continue
+			}
+			if group[0].Nonce < chainNonce || (!tx.Broadcast && time.Since(tx.SentTime) > ReservationTimeout) {
+				// The nonce was used elsewhere, or it was never sent and its nonce has been released
+				tx.Status = TransactionStatus_Dropped
+				continue
+			}
+
+			// Rebroadcast it if the client has lost it
+			if !tx.Broadcast {
+				continue
+			}
+			_, _, err := m.ec.TransactionByHash(context.Background(), tx.Hash)
+			if errors.Is(err, ethereum.NotFound) {
+				signedTx, err := tx.getSignedTransaction()
+				if err != nil {
+					return nil, fmt.Errorf("error decoding transaction %s: %w", tx.Hash.Hex(), err)
+				}
+				lostTxs = append(lostTxs, signedTx)
+			} else if err != nil {
+				return nil, fmt.Errorf("error getting transaction %s: %w", tx.Hash.Hex(), err)
+			}
+		}
+
+	}
+
+	if err := m.journal.save(transactions); err != nil {
+		return nil, err
+	}
+	return lostTxs, nil
+
+}
+
+// Replace a stuck transaction with one that has higher fees, keeping them within the auto-replacement limit
+func (m *Manager) replaceStuckTransaction(tx *Transaction, logger log.ColorLogger) error {
+
+	if m.getTransactor == nil {
+		return fmt.Errorf("no transactor is available")
+	}
+	maxFee, maxPriorityFee, err := m.GetReplacementFees(tx, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// Limit the fees
+	if m.autoReplaceMaxFee != nil && maxFee.Cmp(m.autoReplaceMaxFee) > 0 {
+		if getMinReplacementFee(tx.MaxFee).Cmp(m.autoReplaceMaxFee) > 0 {
+			return fmt.Errorf("its replacement would need a max fee higher than the limit of %.6f Gwei", eth.WeiToGwei(m.autoReplaceMaxFee))
+		}
+		maxFee = m.autoReplaceMaxFee
+		if maxPriorityFee.Cmp(maxFee) > 0 {
+			maxPriorityFee = maxFee
+		}
+		if maxPriorityFee.Cmp(getMinReplacementFee(tx.MaxPriorityFee)) < 0 {
+			return fmt.Errorf("its replacement would need a priority fee higher than the max fee limit of %.6f Gwei", eth.WeiToGwei(m.autoReplaceMaxFee))
+		}
+	}
+
+	// Replace it
+	opts, err := m.getTransactor()
+	if err != nil {
+		return err
+	}
+	hash, err := m.speedUp(tx, opts, maxFee, maxPriorityFee)
+	if err != nil {
+		return err
+	}
+	logger.Printlnf("Transaction %s was pending for more than %s, so it was replaced by %s with a max fee of %.6f Gwei and a priority fee of %.6f Gwei.",
+		tx.Hash.Hex(), StuckTransactionTimeout, hash.Hex(), eth.WeiToGwei(maxFee), eth.WeiToGwei(maxPriorityFee))
+	return nil
+
+}
+
+// Send a copy of a pending transaction with the provided fees
+func (m *Manager) speedUp(tx *Transaction, opts *bind.TransactOpts, maxFee *big.Int, maxPriorityFee *big.Int) (common.Hash, error) {
+	signedTx, err := tx.getSignedTransaction()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error decoding transaction %s: %w", tx.Hash.Hex(), err)
+	}
+	return m.replace(tx, opts, &types.DynamicFeeTx{
+		GasTipCap:  maxPriorityFee,
+		GasFeeCap:  maxFee,
+		Gas:        tx.GasLimit,
+		To:         signedTx.To(),
+		Value:      signedTx.Value(),
+		Data:       signedTx.Data(),
+		AccessList: signedTx.AccessList(),
+	})
+}
+
+// Sign and send a replacement for a pending transaction
+func (m *Manager) replace(tx *Transaction, opts *bind.TransactOpts, replacement *types.DynamicFeeTx) (common.Hash, error) {
+
+	if opts.From != tx.From {
+		return common.Hash{}, fmt.Errorf("Transaction %s wasn't sent by the node account", tx.Hash.Hex())
+	}
+
+	// Sign it with the same nonce
+	replacement.ChainID = m.chainID
+	replacement.Nonce = tx.Nonce
+	opts.Nonce = new(big.Int).SetUint64(tx.Nonce)
+	signedTx, err := opts.Signer(opts.From, types.NewTx(replacement))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error signing replacement transaction: %w", err)
+	}
+
+	// Send it
+	if err := m.ec.SendTransaction(context.Background(), signedTx); err != nil {
+		return common.Hash{}, fmt.Errorf("error sending replacement transaction: %w", err)
+	}
+	return signedTx.Hash(), nil
+
+}
+
+// Get the next nonce for an account that isn't held by a recently signed transaction
+func getNextNonce(transactions []*Transaction, address common.Address, pendingNonce uint64) uint64 {
+	reserved := map[uint64]bool{}
+	for _, tx := range transactions {
+		if tx.From == address && tx.Status == TransactionStatus_Pending && tx.Nonce >= pendingNonce && time.Since(tx.SentTime) < ReservationTimeout {
+			reserved[tx.Nonce] = true
+		}
+	}
+	nonce := pendingNonce
+	for reserved[nonce] {
+		nonce++
+	}
+	return nonce
+}
+
+// Get a copy of an unsigned transaction with a different nonce
+func setNonce(tx *types.Transaction, nonce uint64) (*types.Transaction, error) {
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      nonce,
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}), nil
+	case types.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: tx.GasPrice(),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+}
+
+// Get the groups of transactions that share an account and nonce and have a pending transaction
+func getPendingGroups(transactions []*Transaction) [][]*Transaction {
+	type groupKey struct {
+		from  common.Address
+		nonce uint64
+	}
+	groups := map[groupKey][]*Transaction{}
+	keys := []groupKey{}
+	for _, tx := range transactions {
+		key := groupKey{from: tx.From, nonce: tx.Nonce}
+		if _, exists := groups[key]; !exists {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], tx)
+	}
+
+	pendingGroups := [][]*Transaction{}
+	for _, key := range keys {
+		for _, tx := range groups[key] {
+			if tx.Status == TransactionStatus_Pending {
+				pendingGroups = append(pendingGroups, groups[key])
+				break
+			}
+		}
+	}
+	return pendingGroups
+}
+
+// Count how many times a transaction has been replaced to get to the provided one
+func countReplacements(transactions []*Transaction, tx *Transaction) int {
+	count := 0
+	for tx.Replaces != nil {
+		tx = findTransaction(transactions, *tx.Replaces)
+		if tx == nil {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// Find a transaction in the journal
+func findTransaction(transactions []*Transaction, hash common.Hash) *Transaction {
+	for _, tx := range transactions {
+		if tx.Hash == hash {
+			return tx
+		}
+	}
+	return nil
+}
+
+// Get the lowest fee that a replacement transaction can use
+func getMinReplacementFee(fee *big.Int) *big.Int {
+	if fee == nil {
+		return big.NewInt(0)
+	}
+	minFee := new(big.Int).Mul(fee, big.NewInt(100+ReplacementFeeIncrease))
+	minFee.Add(minFee, big.NewInt(99))
+	return minFee.Div(minFee, big.NewInt(100))
+}
+
+// Get the larger of two integers
+func getMaxBigInt(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,241 @@
+package txmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The state of a transaction in the queue
+type QueuedTransactionStatus string
+
+const (
+	QueuedTransactionStatus_Waiting   QueuedTransactionStatus = "waiting"
+	QueuedTransactionStatus_Sending   QueuedTransactionStatus = "sending"
+	QueuedTransactionStatus_Sent      QueuedTransactionStatus = "sent"
+	QueuedTransactionStatus_Failed    QueuedTransactionStatus = "failed"
+	QueuedTransactionStatus_Expired   QueuedTransactionStatus = "expired"
+	QueuedTransactionStatus_Cancelled QueuedTransactionStatus = "cancelled"
+)
+
+// How long a queued transaction waits by default before it expires
+const DefaultQueueExpiry = 7 * 24 * time.Hour
+
+// The conditions for sending a queued transaction
+type Deferral struct {
+	// Send the transaction once the base fee is below this (in gwei)
+	GasThreshold float64 `json:"gasThreshold"`
+
+	// Send the transaction at this time regardless of the base fee (zero for never)
+	Deadline time.Time `json:"deadline"`
+
+	// Give up on the transaction if it hasn't been sent by this time
+	Expiry time.Time `json:"expiry"`
+}
+
+// A transaction waiting for the node daemon to send it.
+// It's stored as the API command that creates it, so it's built against the chain state at the time it's sent.
+type QueuedTransaction struct {
+	Deferral
+	ID             uint64                  `json:"id"`
+	Description    string                  `json:"description"`
+	Args           []string                `json:"args"`
+	MaxFee         float64                 `json:"maxFee"`
+	MaxPriorityFee float64                 `json:"maxPriorityFee"`
+	GasLimit       uint64                  `json:"gasLimit"`
+	Status         QueuedTransactionStatus `json:"status"`
+	QueuedTime     time.Time               `json:"queuedTime"`
+	SentTime       time.Time               `json:"sentTime"`
+	TxHash         *common.Hash            `json:"txHash,omitempty"`
+	Error          string                  `json:"error,omitempty"`
+}
+
+// Check if the transaction is due to be sent at the provided base fee (in gwei)
+func (t *QueuedTransaction) IsDue(baseFeeGwei float64) bool {
+	if baseFeeGwei < t.GasThreshold {
+		return true
+	}
+	return !t.Deadline.IsZero() && time.Now().After(t.Deadline)
+}
+
+// A queue of transactions that are sent by the node daemon once the base fee is low enough.
+// It's shared by the API and the node daemon, so access to it is serialized with a lock file.
+type Queue struct {
+	path string
+}
+
+// Create a new transaction queue
+func NewQueue(path string) *Queue {
+	return &Queue{
+		path: path,
+	}
+}
+
+// Add a transaction to the queue, returning its ID
+func (q *Queue) Add(tx *QueuedTransaction) (uint64, error) {
+	err := q.update(func(transactions []*QueuedTransaction) ([]*QueuedTransaction, error) {
+		tx.ID = 1
+		for _, other := range transactions {
+			if other.ID >= tx.ID {
+				tx.ID = other.ID + 1
+			}
+		}
+		tx.Status = QueuedTransactionStatus_Waiting
+		tx.QueuedTime = time.Now()
+		return append(transactions, tx), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return tx.ID, nil
+}
+
+// Get the transactions in the queue
+func (q *Queue) GetTransactions() ([]*QueuedTransaction, error) {
+	var result []*QueuedTransaction
+	err := q.update(func(transactions []*QueuedTransaction) ([]*QueuedTransaction, error) {
+		result = transactions
+		return transactions, nil
+	})
+	return result, err
+}
+
+// Cancel a transaction that's still waiting to be sent
+func (q *Queue) Cancel(id uint64) error {
+	return q.update(func(transactions []*QueuedTransaction) ([]*QueuedTransaction, error) {
+		for _, tx := range transactions {
+			if tx.ID != id {
+				continue
+			}
+			if tx.Status != QueuedTransactionStatus_Waiting {
+				return nil, fmt.Errorf("Queued transaction %d can't be cancelled because it is %s.", id, tx.Status)
+			}
+			tx.Status = QueuedTransactionStatus_Cancelled
+			return transactions, nil
+		}
+		return nil, fmt.Errorf("There is no queued transaction with ID %d.", id)
+	})
+}
+
+// Get the waiting transactions that are due to be sent at the provided base fee (in gwei), marking them as being sent
+func (q *Queue) TakeDueTransactions(baseFeeGwei float64) ([]*QueuedTransaction, error) {
+	due := []*QueuedTransaction{}
+	err := q.update(func(transactions []*QueuedTransaction) ([]*QueuedTransaction, error) {
+		for _, tx := range transactions {
+			if tx.Status == QueuedTransactionStatus_Waiting && tx.IsDue(baseFeeGwei) {
+				tx.Status = QueuedTransactionStatus_Sending
+				due = append(due, tx)
+			}
+		}
+		return transactions, nil
+	})
+	return due, err
+}
+
+// Record the result of sending a queued transaction
+func (q *Queue) SetResult(id uint64, txHash *common.Hash, sendErr error) error {
+	return q.update(func(transactions []*QueuedTransaction) ([]*QueuedTransaction, error) {
+		for _, tx := range transactions {
+			if tx.ID != id {
+				continue
+			}
+			tx.SentTime = time.Now()
+			if sendErr != nil {
+				tx.Status = QueuedTransactionStatus_Failed
+				tx.Error = sendErr.Error()
+			} else {
+				tx.Status = QueuedTransactionStatus_Sent
+				tx.TxHash = txHash
+				tx.Error = ""
+			}
+		}
+		return transactions, nil
+	})
+}
+
+// Put a transaction that couldn't be sent because of a temporary problem back in the queue, recording the problem
+func (q *Queue) Requeue(id uint64, sendErr error) error {
+	return q.update(func(transactions []*QueuedTransaction) ([]*QueuedTransaction, error) {
+		for _, tx := range transactions {
+			if tx.ID == id && tx.Status == QueuedTransactionStatus_Sending {
+				tx.Status = QueuedTransactionStatus_Waiting
+				tx.Error = sendErr.Error()
+			}
+		}
+		return transactions, nil
+	})
+}
+
+// Mark transactions that were being sent when the node daemon stopped as failed, since it's unknown if they were sent
+func (q *Queue) FailInterruptedTransactions() error {
+	return q.update(func(transactions []*QueuedTransaction) ([]*QueuedTransaction, error) {
+		for _, tx := range transactions {
+			if tx.Status == QueuedTransactionStatus_Sending {
+				tx.Status = QueuedTransactionStatus_Failed
+				tx.Error = "The node daemon stopped while sending this transaction; check `rocketpool node tx list` to see if it was sent."
+			}
+		}
+		return transactions, nil
+	})
+}
+
+// Lock the queue, expire old transactions, and save the transactions returned by the provided function
+func (q *Queue) update(apply func([]*QueuedTransaction) ([]*QueuedTransaction, error)) error {
+	unlock, err := lockFile(q.path, "transaction queue")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	transactions, err := q.load()
+	if err != nil {
+		return err
+	}
+	for _, tx := range transactions {
+		if tx.Status == QueuedTransactionStatus_Waiting && time.Now().After(tx.Expiry) {
+			tx.Status = QueuedTransactionStatus_Expired
+		}
+	}
+	transactions, err = apply(transactions)
+	if err != nil {
+		return err
+	}
+	return q.save(transactions)
+}
+
+// Load the transactions in the queue; the queue must be locked
+func (q *Queue) load() ([]*QueuedTransaction, error) {
+	bytes, err := ioutil.ReadFile(q.path)
+	if os.IsNotExist(err) {
+		return []*QueuedTransaction{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading transaction queue: %w", err)
+	}
+	transactions := []*QueuedTransaction{}
+	if err := json.Unmarshal(bytes, &transactions); err != nil {
+		return nil, fmt.Errorf("error deserializing transaction queue: %w", err)
+	}
+	return transactions, nil
+}
+
+// Save the transactions to the queue, dropping old ones that have finished; the queue must be locked
+func (q *Queue) save(transactions []*QueuedTransaction) error {
+	kept := []*QueuedTransaction{}
+	for _, tx := range transactions {
+		finished := tx.Status != QueuedTransactionStatus_Waiting && tx.Status != QueuedTransactionStatus_Sending
+		if finished && time.Since(tx.QueuedTime) > JournalRetention {
+			continue
+		}
+		kept = append(kept, tx)
+	}
+	bytes, err := json.MarshalIndent(kept, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing transaction queue: %w", err)
+	}
+	return writeFile(q.path, bytes, "transaction queue")
+}
@@ -0,0 +1,85 @@
+package txmanager
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// The state of a transaction in the journal
+type TransactionStatus string
+
+const (
+	TransactionStatus_Pending  TransactionStatus = "pending"
+	TransactionStatus_Mined    TransactionStatus = "mined"
+	TransactionStatus_Reverted TransactionStatus = "reverted"
+	TransactionStatus_Replaced TransactionStatus = "replaced"
+	TransactionStatus_Dropped  TransactionStatus = "dropped"
+)
+
+// The processes that send transactions with the node account
+const (
+	Source_Api        string = "api"
+	Source_Node       string = "node"
+	Source_Watchtower string = "watchtower"
+	Source_Offline    string = "offline"
+)
+
+// A transaction sent by the node account
+type Transaction struct {
+	Hash           common.Hash       `json:"hash"`
+	From           common.Address    `json:"from"`
+	To             *common.Address   `json:"to"`
+	Nonce          uint64            `json:"nonce"`
+	Value          *big.Int          `json:"value"`
+	GasLimit       uint64            `json:"gasLimit"`
+	MaxFee         *big.Int          `json:"maxFee"`
+	MaxPriorityFee *big.Int          `json:"maxPriorityFee"`
+	Source         string            `json:"source"`
+	Status         TransactionStatus `json:"status"`
+	Broadcast      bool              `json:"broadcast"`
+	Replaces       *common.Hash      `json:"replaces,omitempty"`
+	ReplacedBy     *common.Hash      `json:"replacedBy,omitempty"`
+	SentTime       time.Time         `json:"sentTime"`
+	BlockNumber    uint64            `json:"blockNumber,omitempty"`
+	Raw            hexutil.Bytes     `json:"raw,omitempty"`
+}
+
+// Create a journal entry for a signed transaction
+func newTransaction(tx *types.Transaction, from common.Address, source string) (*Transaction, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{
+		Hash:           tx.Hash(),
+		From:           from,
+		To:             tx.To(),
+		Nonce:          tx.Nonce(),
+		Value:          tx.Value(),
+		GasLimit:       tx.Gas(),
+		MaxFee:         tx.GasFeeCap(),
+		MaxPriorityFee: tx.GasTipCap(),
+		Source:         source,
+		Status:         TransactionStatus_Pending,
+		SentTime:       time.Now(),
+		Raw:            raw,
+	}, nil
+}
+
+// Decode the signed transaction
+func (t *Transaction) getSignedTransaction() (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(t.Raw); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Check if the transaction is finished, one way or another
+func (t *Transaction) IsFinal() bool {
+	return t.Status == TransactionStatus_Mined || t.Status == TransactionStatus_Reverted || t.Status == TransactionStatus_Dropped
+}
@@ -0,0 +1,244 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	ImportedKeyFolder  string = "imported-keys"
+	ImportedKeyDirMode        = 0700
+)
+
+// An externally created validator key that was imported into the wallet
+type ImportedValidatorKey struct {
+	Pubkey     rptypes.ValidatorPubkey `json:"pubkey"`
+	Path       string                  `json:"path"`
+	ImportedAt time.Time               `json:"importedAt"`
+}
+
+// An imported validator key, encrypted with the node password
+type importedKeystore struct {
+	Crypto  map[string]interface{}  `json:"crypto"`
+	Version uint                    `json:"version"`
+	UUID    uuid.UUID               `json:"uuid"`
+	Path    string                  `json:"path"`
+	Pubkey  rptypes.ValidatorPubkey `json:"pubkey"`
+}
+
+// Get the folder that imported validator keys are stored in, next to the wallet file
+func (w *Wallet) GetImportedKeyFolder() string {
+	return filepath.Join(filepath.Dir(w.walletPath), ImportedKeyFolder)
+}
+
+// Get the validator keys that were imported into the wallet
+func (w *Wallet) GetImportedValidatorKeys() ([]ImportedValidatorKey, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, errors.New("Wallet is not initialized")
+	}
+
+	// Return a copy of the imported keys
+	return append([]ImportedValidatorKey{}, w.ws.ImportedKeys...), nil
+
+}
+
+// Check if a validator key was imported into the wallet
+func (w *Wallet) IsImportedValidatorKey(pubkey rptypes.ValidatorPubkey) bool {
+	if !w.IsInitialized() {
+		return false
+	}
+	for _, importedKey := range w.ws.ImportedKeys {
+		if importedKey.Pubkey == pubkey {
+			return true
+		}
+	}
+	return false
+}
+
+// Import an externally created validator key into the wallet and store it in every keystore.
+// The wallet must be saved afterwards to persist the import.
+func (w *Wallet) ImportValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return errors.New("Wallet is not initialized")
+	}
+	pubkey := rptypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	// Get the node password
+	password, err := w.pm.GetPassword()
+	if err != nil {
+		return fmt.Errorf("Could not get wallet password: %w", err)
+	}
+
+	// Encrypt the key with the node password so it can be restored without the original keystore
+	encryptedKey, err := w.encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+	keyStoreBytes, err := json.Marshal(importedKeystore{
+		Crypto:  encryptedKey,
+		Version: w.encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    derivationPath,
+		Pubkey:  pubkey,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	// Write it to disk
+	keyFolder := w.GetImportedKeyFolder()
+	if err := os.MkdirAll(keyFolder, ImportedKeyDirMode); err != nil {
+		return fmt.Errorf("Could not create imported validator key folder: %w", err)
+	}
+	if err := ioutil.WriteFile(w.getImportedKeyFilePath(pubkey), keyStoreBytes, FileMode); err != nil {
+		return fmt.Errorf("Could not write imported validator key to disk: %w", err)
+	}
+
+	// Update keystores
+	if err := w.StoreValidatorKey(key, derivationPath); err != nil {
+		return err
+	}
+
+	// Track the key in the wallet
+	if !w.IsImportedValidatorKey(pubkey) {
+		w.ws.ImportedKeys = append(w.ws.ImportedKeys, ImportedValidatorKey{
+			Pubkey:     pubkey,
+			Path:       derivationPath,
+			ImportedAt: time.Now().UTC(),
+		})
+	}
+
+	// Return
+	return nil
+
+}
+
+// Get the path of an imported validator key's file
+func (w *Wallet) getImportedKeyFilePath(pubkey rptypes.ValidatorPubkey) string {
+	return filepath.Join(w.GetImportedKeyFolder(), hexutil.AddPrefix(pubkey.Hex())+".json")
+}
+
+// Check if an imported validator key's file exists; wallets that aren't stored on disk have no imported keys
+func (w *Wallet) hasImportedKeyFile(pubkey rptypes.ValidatorPubkey) bool {
+	if w.walletPath == "" {
+		return false
+	}
+	_, err := os.Stat(w.getImportedKeyFilePath(pubkey))
+	return (err == nil)
+}
+
+// Load an imported validator key from disk
+func (w *Wallet) GetImportedValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, string, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, "", errors.New("Wallet is not initialized")
+	}
+
+	// Read the keystore
+	keyStoreBytes, err := ioutil.ReadFile(w.getImportedKeyFilePath(pubkey))
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not read imported validator key %s: %w", pubkey.Hex(), err)
+	}
+	keyStore := importedKeystore{}
+	if err := json.Unmarshal(keyStoreBytes, &keyStore); err != nil {
+		return nil, "", fmt.Errorf("Could not decode imported validator key %s: %w", pubkey.Hex(), err)
+	}
+
+	// Decrypt it
+	password, err := w.pm.GetPassword()
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not get wallet password: %w", err)
+	}
+	decryptedKey, err := w.encryptor.Decrypt(keyStore.Crypto, password)
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not decrypt imported validator key %s: %w", pubkey.Hex(), err)
+	}
+	if err := initializeBLS(); err != nil {
+		return nil, "", fmt.Errorf("Could not initialize BLS library: %w", err)
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(decryptedKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not recreate imported validator key %s: %w", pubkey.Hex(), err)
+	}
+
+	// Make sure it's the right key
+	if !bytes.Equal(pubkey.Bytes(), key.PublicKey().Marshal()) {
+		return nil, "", fmt.Errorf("Imported validator key file for %s contains a different key", pubkey.Hex())
+	}
+
+	// Return
+	return key, keyStore.Path, nil
+
+}
+
+// Recover an imported validator key by public key if its file exists, storing it in every keystore and tracking it in the wallet.
+// Returns false if the key was never imported.
+func (w *Wallet) RecoverImportedValidatorKey(pubkey rptypes.ValidatorPubkey) (bool, error) {
+
+	// Check for the key file
+	if !w.hasImportedKeyFile(pubkey) {
+		return false, nil
+	}
+
+	// Load the key
+	key, derivationPath, err := w.GetImportedValidatorKey(pubkey)
+	if err != nil {
+		return false, err
+	}
+
+	// Update keystores
+	if err := w.StoreValidatorKey(key, derivationPath); err != nil {
+		return false, err
+	}
+
+	// Track the key in the wallet, in case it was recovered from a mnemonic
+	if !w.IsImportedValidatorKey(pubkey) {
+		w.ws.ImportedKeys = append(w.ws.ImportedKeys, ImportedValidatorKey{
+			Pubkey:     pubkey,
+			Path:       derivationPath,
+			ImportedAt: time.Now().UTC(),
+		})
+	}
+
+	// Return
+	return true, nil
+
+}
+
+// Test recovery of an imported validator key by public key.
+// Returns false if the key was never imported.
+func (w *Wallet) TestRecoverImportedValidatorKey(pubkey rptypes.ValidatorPubkey) (bool, error) {
+	if !w.hasImportedKeyFile(pubkey) {
+		return false, nil
+	}
+	if _, _, err := w.GetImportedValidatorKey(pubkey); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Delete the imported validator key files from disk
+func (w *Wallet) DeleteImportedValidatorKeys() error {
+	if w.walletPath == "" {
+		return nil
+	}
+	return os.RemoveAll(w.GetImportedKeyFolder())
+}
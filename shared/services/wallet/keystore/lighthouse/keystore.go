@@ -19,12 +19,13 @@ import (
 
 // Config
 const (
-	KeystoreDir   = "lighthouse"
-	SecretsDir    = "secrets"
-	ValidatorsDir = "validators"
-	KeyFileName   = "voting-keystore.json"
-	DirMode       = 0750
-	FileMode      = 0640
+	KeystoreDir                  = "lighthouse"
+	SecretsDir                   = "secrets"
+	ValidatorsDir                = "validators"
+	KeyFileName                  = "voting-keystore.json"
+	ValidatorDefinitionsFileName = "validator_definitions.yml"
+	DirMode                      = 0750
+	FileMode                     = 0640
 )
 
 // Lighthouse keystore
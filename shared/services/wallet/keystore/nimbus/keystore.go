@@ -19,12 +19,13 @@ import (
 
 // Config
 const (
-	KeystoreDir   = "nimbus"
-	SecretsDir    = "secrets"
-	ValidatorsDir = "validators"
-	KeyFileName   = "keystore.json"
-	DirMode       = 0750
-	FileMode      = 0640
+	KeystoreDir          = "nimbus"
+	SecretsDir           = "secrets"
+	ValidatorsDir        = "validators"
+	KeyFileName          = "keystore.json"
+	FeeRecipientFileName = "suggested_fee_recipient.hex"
+	DirMode              = 0750
+	FileMode             = 0640
 )
 
 // Nimbus keystore
@@ -16,6 +16,11 @@ import (
 // Get the node account
 func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 
+	// Use the offline account for watch-only wallets
+	if w.IsOffline() {
+		return accounts.Account{Address: *w.offlineAddress}, nil
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return accounts.Account{}, errors.New("Wallet is not initialized")
@@ -48,6 +53,11 @@ func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 // Get a transactor for the node account
 func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
+	// Watch-only wallets can only build transactions for offline signing
+	if w.IsOffline() {
+		return w.getWatchOnlyTransactor()
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
@@ -61,11 +71,17 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
 	// Create & return transactor
 	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, w.chainID)
+	if err != nil {
+		return nil, err
+	}
 	transactor.GasFeeCap = w.maxFee
 	transactor.GasTipCap = w.maxPriorityFee
 	transactor.GasLimit = w.gasLimit
 	transactor.Context = context.Background()
-	return transactor, err
+	if w.transactorHook != nil {
+		w.transactorHook(transactor)
+	}
+	return transactor, nil
 
 }
 
@@ -0,0 +1,124 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Returned by the signer of a capturing transactor so the transaction isn't sent
+var errTransactionCaptured = errors.New("The transaction was captured for offline signing")
+
+// The transactions built by node account transactors while capturing
+type transactionCapture struct {
+	nonce        *big.Int
+	transactions []*types.Transaction
+}
+
+// Load the address of a node account whose keys are kept on an offline machine, if one has been set
+func (w *Wallet) LoadOfflineAddress(path string) error {
+	addressBytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Could not read offline node address: %w", err)
+	}
+	address := strings.TrimSpace(string(addressBytes))
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("Invalid offline node address '%s'", address)
+	}
+	offlineAddress := common.HexToAddress(address)
+	w.offlineAddress = &offlineAddress
+	return nil
+}
+
+// Save the address of a node account whose keys are kept on an offline machine.
+// This makes the wallet watch-only: transactions for the node account can be built, but they must be signed offline.
+func (w *Wallet) SaveOfflineAddress(path string, address common.Address) error {
+
+	// Check wallet is not initialized
+	if w.IsInitialized() {
+		return errors.New("The node wallet is initialized, so it can't be watch-only")
+	}
+
+	// Write the address to disk
+	if err := ioutil.WriteFile(path, []byte(address.Hex()), FileMode); err != nil {
+		return fmt.Errorf("Could not write offline node address to disk: %w", err)
+	}
+	w.offlineAddress = &address
+	return nil
+
+}
+
+// Check if the wallet is watch-only, with the node account's keys kept on an offline machine
+func (w *Wallet) IsOffline() bool {
+	return w.offlineAddress != nil && !w.IsInitialized()
+}
+
+// Run a function, capturing the node account transactions it builds with the provided nonce instead of sending them.
+// Only a watch-only wallet can capture transactions.
+func (w *Wallet) CaptureTransactions(nonce uint64, fn func() error) ([]*types.Transaction, error) {
+	if !w.IsOffline() {
+		return nil, errors.New("Transactions can only be built for offline signing with a watch-only node wallet")
+	}
+	w.capture = &transactionCapture{nonce: new(big.Int).SetUint64(nonce)}
+	defer func() {
+		w.capture = nil
+	}()
+	err := fn()
+	return w.capture.transactions, err
+}
+
+// Sign a transaction with the node account
+func (w *Wallet) SignTransaction(tx *types.Transaction) (*types.Transaction, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, errors.New("Wallet is not initialized")
+	}
+
+	// Get private key
+	privateKey, _, err := w.getNodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign the transaction
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(w.chainID), privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing transaction: %w", err)
+	}
+	return signedTx, nil
+
+}
+
+// Get a transactor for the watch-only node account.
+// It can estimate gas, but the transactions it builds are only captured for offline signing, and never sent.
+func (w *Wallet) getWatchOnlyTransactor() (*bind.TransactOpts, error) {
+	transactor := &bind.TransactOpts{
+		From: *w.offlineAddress,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return nil, errors.New("The node wallet is watch-only, so its transactions must be built for offline signing")
+		},
+		GasFeeCap: w.maxFee,
+		GasTipCap: w.maxPriorityFee,
+		GasLimit:  w.gasLimit,
+		Context:   context.Background(),
+	}
+	if capture := w.capture; capture != nil {
+		transactor.Nonce = capture.nonce
+		transactor.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			capture.transactions = append(capture.transactions, tx)
+			return nil, errTransactionCaptured
+		}
+	}
+	return transactor, nil
+}
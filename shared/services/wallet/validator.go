@@ -77,8 +77,12 @@ func (w *Wallet) GetValidatorKeyByPubkey(pubkey rptypes.ValidatorPubkey) (*eth2t
 		}
 	}
 
-	// Check validator key
+	// Check validator key, falling back to the imported keys
 	if validatorKey == nil {
+		if w.IsImportedValidatorKey(pubkey) {
+			key, _, err := w.GetImportedValidatorKey(pubkey)
+			return key, err
+		}
 		return nil, fmt.Errorf("Validator %s key not found", pubkeyHex)
 	}
 
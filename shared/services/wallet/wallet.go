@@ -12,6 +12,8 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
@@ -32,6 +34,13 @@ const (
 	MyEtherWalletNodeKeyPath = "m/44'/60'/0'/%d"
 )
 
+// All of the well-known node key derivation paths, in the order they should be searched
+var KnownNodeKeyPaths = []string{
+	DefaultNodeKeyPath,
+	LedgerLiveNodeKeyPath,
+	MyEtherWalletNodeKeyPath,
+}
+
 // Wallet
 type Wallet struct {
 
@@ -63,6 +72,13 @@ type Wallet struct {
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+
+	// Called with each new node account transactor
+	transactorHook func(*bind.TransactOpts)
+
+	// Watch-only node account whose keys are kept on an offline machine
+	offlineAddress *common.Address
+	capture        *transactionCapture
 }
 
 // Encrypted wallet store
@@ -74,6 +90,7 @@ type walletStore struct {
 	DerivationPath string                 `json:"derivationPath,omitempty"`
 	WalletIndex    uint                   `json:"walletIndex,omitempty"`
 	NextAccount    uint                   `json:"next_account"`
+	ImportedKeys   []ImportedValidatorKey `json:"importedKeys,omitempty"`
 }
 
 // Create new wallet
@@ -103,6 +120,18 @@ func NewWallet(walletPath string, chainId uint, maxFee *big.Int, maxPriorityFee
 
 }
 
+// Set the desired gas settings for transactions made with the node account
+func (w *Wallet) SetGasSettings(maxFee *big.Int, maxPriorityFee *big.Int, gasLimit uint64) {
+	w.maxFee = maxFee
+	w.maxPriorityFee = maxPriorityFee
+	w.gasLimit = gasLimit
+}
+
+// Set a function to call with each new node account transactor, e.g. to track the transactions it signs
+func (w *Wallet) SetTransactorHook(hook func(*bind.TransactOpts)) {
+	w.transactorHook = hook
+}
+
 // Gets the wallet's chain ID
 func (w *Wallet) GetChainID() *big.Int {
 	copy := big.NewInt(0).Set(w.chainID)
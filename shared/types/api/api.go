@@ -4,3 +4,25 @@ type APIResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+// The path the API server accepts calls on
+const ServerCallPath = "/call"
+
+// A request to run an API command on the API server
+type ServerCallRequest struct {
+	Args            []string `json:"args"`
+	IgnoreSyncCheck bool     `json:"ignoreSyncCheck"`
+	ForceFallbacks  bool     `json:"forceFallbacks"`
+	MaxFee          float64  `json:"maxFee"`
+	MaxPrioFee      float64  `json:"maxPrioFee"`
+	GasLimit        uint64   `json:"gasLimit"`
+	Nonce           string   `json:"nonce"`
+}
+
+// The reason a transaction would revert, found by simulating it at the pending block before it's submitted
+type TransactionRevert struct {
+	Reason    string `json:"reason"`
+	ErrorName string `json:"errorName,omitempty"`
+	Contract  string `json:"contract,omitempty"`
+	Data      string `json:"data,omitempty"`
+}
@@ -42,6 +42,7 @@ type CanCreateLotResponse struct {
 	InsufficientBalance bool               `json:"insufficientBalance"`
 	CreateLotDisabled   bool               `json:"createLotDisabled"`
 	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Revert              *TransactionRevert `json:"revert,omitempty"`
 }
 type CreateLotResponse struct {
 	Status string      `json:"status"`
@@ -59,6 +60,7 @@ type CanBidOnLotResponse struct {
 	RPLExhausted     bool               `json:"rplExhausted"`
 	BidOnLotDisabled bool               `json:"bidOnLotDisabled"`
 	GasInfo          rocketpool.GasInfo `json:"gasInfo"`
+	Revert           *TransactionRevert `json:"revert,omitempty"`
 }
 type BidOnLotResponse struct {
 	Status string      `json:"status"`
@@ -74,6 +76,7 @@ type CanClaimFromLotResponse struct {
 	NoBidFromAddress bool               `json:"noBidFromAddress"`
 	NotCleared       bool               `json:"notCleared"`
 	GasInfo          rocketpool.GasInfo `json:"gasInfo"`
+	Revert           *TransactionRevert `json:"revert,omitempty"`
 }
 type ClaimFromLotResponse struct {
 	Status string      `json:"status"`
@@ -90,6 +93,7 @@ type CanRecoverRPLFromLotResponse struct {
 	NoUnclaimedRPL      bool               `json:"noUnclaimedRpl"`
 	RPLAlreadyRecovered bool               `json:"rplAlreadyRecovered"`
 	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Revert              *TransactionRevert `json:"revert,omitempty"`
 }
 type RecoverRPLFromLotResponse struct {
 	Status string      `json:"status"`
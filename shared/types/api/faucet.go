@@ -25,6 +25,7 @@ type CanFaucetWithdrawRplResponse struct {
 	InsufficientAllowance     bool               `json:"insufficientAllowance"`
 	InsufficientNodeBalance   bool               `json:"insufficientNodeBalance"`
 	GasInfo                   rocketpool.GasInfo `json:"gasInfo"`
+	Revert                    *TransactionRevert `json:"revert,omitempty"`
 }
 type FaucetWithdrawRplResponse struct {
 	Status string      `json:"status"`
@@ -10,6 +10,10 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/tokens"
 	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/offline"
+	"github.com/rocket-pool/smartnode/shared/utils/rp"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 type MinipoolStatusResponse struct {
@@ -54,6 +58,7 @@ type CanRefundMinipoolResponse struct {
 	CanRefund                 bool               `json:"canRefund"`
 	InsufficientRefundBalance bool               `json:"insufficientRefundBalance"`
 	GasInfo                   rocketpool.GasInfo `json:"gasInfo"`
+	Revert                    *TransactionRevert `json:"revert,omitempty"`
 }
 type RefundMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -67,6 +72,7 @@ type CanDissolveMinipoolResponse struct {
 	CanDissolve   bool               `json:"canDissolve"`
 	InvalidStatus bool               `json:"invalidStatus"`
 	GasInfo       rocketpool.GasInfo `json:"gasInfo"`
+	Revert        *TransactionRevert `json:"revert,omitempty"`
 }
 type DissolveMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -84,6 +90,14 @@ type ExitMinipoolResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+type SignMinipoolExitResponse struct {
+	Status                string                    `json:"status"`
+	Error                 string                    `json:"error"`
+	Exit                  validator.ExitBundleEntry `json:"exit"`
+	GenesisValidatorsRoot string                    `json:"genesisValidatorsRoot"`
+	ForkVersion           string                    `json:"forkVersion"`
+	Eip7044               bool                      `json:"eip7044"`
+}
 
 type CanProcessWithdrawalResponse struct {
 	Status        string             `json:"status"`
@@ -91,6 +105,7 @@ type CanProcessWithdrawalResponse struct {
 	CanWithdraw   bool               `json:"canWithdraw"`
 	InvalidStatus bool               `json:"invalidStatus"`
 	GasInfo       rocketpool.GasInfo `json:"gasInfo"`
+	Revert        *TransactionRevert `json:"revert,omitempty"`
 }
 type ProcessWithdrawalResponse struct {
 	Status string      `json:"status"`
@@ -104,6 +119,7 @@ type CanProcessWithdrawalAndFinaliseResponse struct {
 	CanWithdraw   bool               `json:"canWithdraw"`
 	InvalidStatus bool               `json:"invalidStatus"`
 	GasInfo       rocketpool.GasInfo `json:"gasInfo"`
+	Revert        *TransactionRevert `json:"revert,omitempty"`
 }
 type ProcessWithdrawalAndFinaliseResponse struct {
 	Status string      `json:"status"`
@@ -118,6 +134,7 @@ type CanCloseMinipoolResponse struct {
 	InvalidStatus bool               `json:"invalidStatus"`
 	InConsensus   bool               `json:"inConsensus"`
 	GasInfo       rocketpool.GasInfo `json:"gasInfo"`
+	Revert        *TransactionRevert `json:"revert,omitempty"`
 }
 type CloseMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -129,6 +146,7 @@ type CanFinaliseMinipoolResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
 	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Revert  *TransactionRevert `json:"revert,omitempty"`
 }
 type FinaliseMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -141,6 +159,7 @@ type CanDelegateUpgradeResponse struct {
 	Error                 string             `json:"error"`
 	LatestDelegateAddress common.Address     `json:"latestDelegateAddress"`
 	GasInfo               rocketpool.GasInfo `json:"gasInfo"`
+	Revert                *TransactionRevert `json:"revert,omitempty"`
 }
 type DelegateUpgradeResponse struct {
 	Status string      `json:"status"`
@@ -153,6 +172,7 @@ type CanDelegateRollbackResponse struct {
 	Error           string             `json:"error"`
 	RollbackAddress common.Address     `json:"rollbackAddress"`
 	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Revert          *TransactionRevert `json:"revert,omitempty"`
 }
 type DelegateRollbackResponse struct {
 	Status string      `json:"status"`
@@ -164,6 +184,7 @@ type CanSetUseLatestDelegateResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
 	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Revert  *TransactionRevert `json:"revert,omitempty"`
 }
 type SetUseLatestDelegateResponse struct {
 	Status string      `json:"status"`
@@ -176,6 +197,7 @@ type CanStakeMinipoolResponse struct {
 	Error    string             `json:"error"`
 	CanStake bool               `json:"canStake"`
 	GasInfo  rocketpool.GasInfo `json:"gasInfo"`
+	Revert   *TransactionRevert `json:"revert,omitempty"`
 }
 type StakeMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -214,3 +236,24 @@ type GetVanityArtifactsResponse struct {
 	MinipoolFactoryAddress common.Address `json:"minipoolFactoryAddress"`
 	InitHash               common.Hash    `json:"initHash"`
 }
+
+type MinipoolPerformanceResponse struct {
+	Status           string                    `json:"status"`
+	Error            string                    `json:"error"`
+	LastCheckedEpoch uint64                    `json:"lastCheckedEpoch"`
+	WindowEpochs     uint64                    `json:"windowEpochs"`
+	Validators       []rp.ValidatorPerformance `json:"validators"`
+}
+
+type BuildOfflineMinipoolExitResponse struct {
+	Status      string                `json:"status"`
+	Error       string                `json:"error"`
+	ChainID     uint64                `json:"chainId"`
+	NodeAddress common.Address        `json:"nodeAddress"`
+	Exit        *offline.UnsignedExit `json:"exit"`
+}
+
+type BroadcastMinipoolExitResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
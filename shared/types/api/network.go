@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/gas/feehistory"
 )
 
 type NodeFeeResponse struct {
@@ -76,3 +78,9 @@ type NetworkDAOProposalsResponse struct {
 	ActiveSnapshotProposals []SnapshotProposal     `json:"activeSnapshotProposals"`
 	ProposalVotes           []SnapshotProposalVote `json:"proposalVotes"`
 }
+
+type NetworkFeeSuggestionsResponse struct {
+	Status     string                      `json:"status"`
+	Error      string                      `json:"error"`
+	Suggestion feehistory.GasFeeSuggestion `json:"suggestion"`
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/tokens"
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/offline"
 	"github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -61,6 +63,7 @@ type CanRegisterNodeResponse struct {
 	AlreadyRegistered    bool               `json:"alreadyRegistered"`
 	RegistrationDisabled bool               `json:"registrationDisabled"`
 	GasInfo              rocketpool.GasInfo `json:"gasInfo"`
+	Revert               *TransactionRevert `json:"revert,omitempty"`
 }
 type RegisterNodeResponse struct {
 	Status string      `json:"status"`
@@ -73,6 +76,7 @@ type CanSetNodeWithdrawalAddressResponse struct {
 	Error   string             `json:"error"`
 	CanSet  bool               ` json:"canSet"`
 	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Revert  *TransactionRevert `json:"revert,omitempty"`
 }
 type SetNodeWithdrawalAddressResponse struct {
 	Status string      `json:"status"`
@@ -85,6 +89,7 @@ type CanConfirmNodeWithdrawalAddressResponse struct {
 	Error      string             `json:"error"`
 	CanConfirm bool               `json:"canConfirm"`
 	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
+	Revert     *TransactionRevert `json:"revert,omitempty"`
 }
 type ConfirmNodeWithdrawalAddressResponse struct {
 	Status string      `json:"status"`
@@ -109,6 +114,7 @@ type CanSetNodeTimezoneResponse struct {
 	Error   string             `json:"error"`
 	CanSet  bool               `json:"canSet"`
 	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Revert  *TransactionRevert `json:"revert,omitempty"`
 }
 type SetNodeTimezoneResponse struct {
 	Status string      `json:"status"`
@@ -122,6 +128,7 @@ type CanNodeSwapRplResponse struct {
 	CanSwap             bool               `json:"canSwap"`
 	InsufficientBalance bool               `json:"insufficientBalance"`
 	GasInfo             rocketpool.GasInfo `json:"GasInfo"`
+	Revert              *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeSwapRplApproveGasResponse struct {
 	Status  string             `json:"status"`
@@ -151,6 +158,7 @@ type CanNodeStakeRplResponse struct {
 	InsufficientBalance bool               `json:"insufficientBalance"`
 	InConsensus         bool               `json:"inConsensus"`
 	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Revert              *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeStakeRplApproveGasResponse struct {
 	Status  string             `json:"status"`
@@ -182,6 +190,7 @@ type CanNodeWithdrawRplResponse struct {
 	WithdrawalDelayActive        bool               `json:"withdrawalDelayActive"`
 	InConsensus                  bool               `json:"inConsensus"`
 	GasInfo                      rocketpool.GasInfo `json:"gasInfo"`
+	Revert                       *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeWithdrawRplResponse struct {
 	Status string      `json:"status"`
@@ -201,6 +210,7 @@ type CanNodeDepositResponse struct {
 	InConsensus            bool               `json:"inConsensus"`
 	MinipoolAddress        common.Address     `json:"minipoolAddress"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeDepositResponse struct {
 	Status          string                  `json:"status"`
@@ -217,6 +227,7 @@ type CanNodeSendResponse struct {
 	CanSend             bool               `json:"canSend"`
 	InsufficientBalance bool               `json:"insufficientBalance"`
 	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Revert              *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeSendResponse struct {
 	Status string      `json:"status"`
@@ -231,6 +242,7 @@ type CanNodeBurnResponse struct {
 	InsufficientBalance    bool               `json:"insufficientBalance"`
 	InsufficientCollateral bool               `json:"insufficientCollateral"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeBurnResponse struct {
 	Status string      `json:"status"`
@@ -245,11 +257,29 @@ type NodeSyncProgressResponse struct {
 	BcStatus ClientManagerStatus `json:"bcStatus"`
 }
 
+type NodeDutiesResponse struct {
+	Status                 string            `json:"status"`
+	Error                  string            `json:"error"`
+	Epoch                  uint64            `json:"epoch"`
+	SecondsPerEpoch        uint64            `json:"secondsPerEpoch"`
+	NextSyncCommitteeEpoch uint64            `json:"nextSyncCommitteeEpoch"`
+	Validators             []ValidatorDuties `json:"validators"`
+}
+type ValidatorDuties struct {
+	Pubkey              rptypes.ValidatorPubkey `json:"pubkey"`
+	Index               uint64                  `json:"index"`
+	Proposals           uint64                  `json:"proposals"`
+	NextEpochProposals  uint64                  `json:"nextEpochProposals"`
+	InSyncCommittee     bool                    `json:"inSyncCommittee"`
+	InNextSyncCommittee bool                    `json:"inNextSyncCommittee"`
+}
+
 type CanNodeClaimRplResponse struct {
 	Status    string             `json:"status"`
 	Error     string             `json:"error"`
 	RplAmount *big.Int           `json:"rplAmount"`
 	GasInfo   rocketpool.GasInfo `json:"gasInfo"`
+	Revert    *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeClaimRplResponse struct {
 	Status string      `json:"status"`
@@ -342,6 +372,7 @@ type NodeCanDistributeResponse struct {
 	Balance        *big.Int           `json:"balance"`
 	AverageNodeFee float64            `json:"averageNodeFee"`
 	GasInfo        rocketpool.GasInfo `json:"gasInfo"`
+	Revert         *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeDistributeResponse struct {
 	Status string      `json:"status"`
@@ -364,6 +395,7 @@ type CanNodeClaimRewardsResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
 	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Revert  *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeClaimRewardsResponse struct {
 	Status string      `json:"status"`
@@ -375,6 +407,7 @@ type CanNodeClaimAndStakeRewardsResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
 	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Revert  *TransactionRevert `json:"revert,omitempty"`
 }
 type NodeClaimAndStakeRewardsResponse struct {
 	Status string      `json:"status"`
@@ -388,10 +421,35 @@ type GetSmoothingPoolRegistrationStatusResponse struct {
 	NodeRegistered          bool          `json:"nodeRegistered"`
 	TimeLeftUntilChangeable time.Duration `json:"timeLeftUntilChangeable"`
 }
+type NodeFeeRecipientStatusResponse struct {
+	Status                 string                        `json:"status"`
+	Error                  string                        `json:"error"`
+	FeeRecipientInfo       rp.FeeRecipientInfo           `json:"feeRecipientInfo"`
+	DefaultFeeRecipient    common.Address                `json:"defaultFeeRecipient"`
+	DefaultFileUpToDate    bool                          `json:"defaultFileUpToDate"`
+	ProposerConfigUpToDate bool                          `json:"proposerConfigUpToDate"`
+	Validators             []ValidatorFeeRecipientStatus `json:"validators"`
+	KeymanagerError        string                        `json:"keymanagerError"`
+}
+type ValidatorFeeRecipientStatus struct {
+	rp.ValidatorFeeRecipient
+	VcFeeRecipient      common.Address `json:"vcFeeRecipient"`
+	VcFeeRecipientKnown bool           `json:"vcFeeRecipientKnown"`
+}
+type SetCustomFeeRecipientResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+type ClearCustomFeeRecipientResponse struct {
+	Status  string `json:"status"`
+	Error   string `json:"error"`
+	Cleared bool   `json:"cleared"`
+}
 type CanSetSmoothingPoolRegistrationStatusResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
 	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Revert  *TransactionRevert `json:"revert,omitempty"`
 }
 type SetSmoothingPoolRegistrationStatusResponse struct {
 	Status string      `json:"status"`
@@ -434,3 +492,73 @@ type SnapshotVotedProposals struct {
 		Votes []SnapshotProposalVote `json:"votes"`
 	} `json:"data"`
 }
+
+type NodeTransactionsResponse struct {
+	Status       string                   `json:"status"`
+	Error        string                   `json:"error"`
+	Transactions []*txmanager.Transaction `json:"transactions"`
+}
+type CanSpeedUpNodeTransactionResponse struct {
+	Status         string                 `json:"status"`
+	Error          string                 `json:"error"`
+	Transaction    *txmanager.Transaction `json:"transaction"`
+	MaxFee         *big.Int               `json:"maxFee"`
+	MaxPriorityFee *big.Int               `json:"maxPriorityFee"`
+}
+type SpeedUpNodeTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+type CanCancelNodeTransactionResponse struct {
+	Status         string                 `json:"status"`
+	Error          string                 `json:"error"`
+	Transaction    *txmanager.Transaction `json:"transaction"`
+	MaxFee         *big.Int               `json:"maxFee"`
+	MaxPriorityFee *big.Int               `json:"maxPriorityFee"`
+	GasLimit       uint64                 `json:"gasLimit"`
+}
+type CancelNodeTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
+type QueueNodeTransactionResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	ID     uint64 `json:"id"`
+}
+
+type NodeTransactionQueueResponse struct {
+	Status       string                         `json:"status"`
+	Error        string                         `json:"error"`
+	Transactions []*txmanager.QueuedTransaction `json:"transactions"`
+}
+
+type CancelQueuedNodeTransactionResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+type BuildOfflineTransactionResponse struct {
+	Status      string                       `json:"status"`
+	Error       string                       `json:"error"`
+	ChainID     uint64                       `json:"chainId"`
+	NodeAddress common.Address               `json:"nodeAddress"`
+	Transaction *offline.UnsignedTransaction `json:"transaction"`
+}
+
+type BuildOfflineDepositResponse struct {
+	Status      string                   `json:"status"`
+	Error       string                   `json:"error"`
+	ChainID     uint64                   `json:"chainId"`
+	NodeAddress common.Address           `json:"nodeAddress"`
+	Deposit     *offline.UnsignedDeposit `json:"deposit"`
+}
+
+type BroadcastNodeTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
@@ -54,6 +54,7 @@ type CanProposeTNDAOInviteResponse struct {
 	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
 	MemberAlreadyExists    bool               `json:"memberAlreadyExists"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type ProposeTNDAOInviteResponse struct {
 	Status     string      `json:"status"`
@@ -69,6 +70,7 @@ type CanProposeTNDAOLeaveResponse struct {
 	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
 	InsufficientMembers    bool               `json:"insufficientMembers"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type ProposeTNDAOLeaveResponse struct {
 	Status     string      `json:"status"`
@@ -84,6 +86,7 @@ type CanProposeTNDAOReplaceResponse struct {
 	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
 	MemberAlreadyExists    bool               `json:"memberAlreadyExists"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type ProposeTNDAOReplaceResponse struct {
 	Status     string      `json:"status"`
@@ -99,6 +102,7 @@ type CanProposeTNDAOKickResponse struct {
 	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
 	InsufficientRplBond    bool               `json:"insufficientRplBond"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type ProposeTNDAOKickResponse struct {
 	Status     string      `json:"status"`
@@ -115,6 +119,7 @@ type CanCancelTNDAOProposalResponse struct {
 	InvalidState    bool               `json:"invalidState"`
 	InvalidProposer bool               `json:"invalidProposer"`
 	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Revert          *TransactionRevert `json:"revert,omitempty"`
 }
 type CancelTNDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -131,6 +136,7 @@ type CanVoteOnTNDAOProposalResponse struct {
 	JoinedAfterCreated bool               `json:"joinedAfterCreated"`
 	AlreadyVoted       bool               `json:"alreadyVoted"`
 	GasInfo            rocketpool.GasInfo `json:"gasInfo"`
+	Revert             *TransactionRevert `json:"revert,omitempty"`
 }
 type VoteOnTNDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -145,6 +151,7 @@ type CanExecuteTNDAOProposalResponse struct {
 	DoesNotExist bool               `json:"doesNotExist"`
 	InvalidState bool               `json:"invalidState"`
 	GasInfo      rocketpool.GasInfo `json:"gasInfo"`
+	Revert       *TransactionRevert `json:"revert,omitempty"`
 }
 type ExecuteTNDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -160,6 +167,7 @@ type CanJoinTNDAOResponse struct {
 	AlreadyMember          bool               `json:"alreadyMember"`
 	InsufficientRplBalance bool               `json:"insufficientRplBalance"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type JoinTNDAOApproveResponse struct {
 	Status        string      `json:"status"`
@@ -179,6 +187,7 @@ type CanLeaveTNDAOResponse struct {
 	ProposalExpired     bool               `json:"proposalExpired"`
 	InsufficientMembers bool               `json:"insufficientMembers"`
 	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Revert              *TransactionRevert `json:"revert,omitempty"`
 }
 type LeaveTNDAOResponse struct {
 	Status string      `json:"status"`
@@ -193,6 +202,7 @@ type CanReplaceTNDAOPositionResponse struct {
 	ProposalExpired     bool               `json:"proposalExpired"`
 	MemberAlreadyExists bool               `json:"memberAlreadyExists"`
 	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Revert              *TransactionRevert `json:"revert,omitempty"`
 }
 type ReplaceTNDAOPositionResponse struct {
 	Status string      `json:"status"`
@@ -206,6 +216,7 @@ type CanProposeTNDAOSettingResponse struct {
 	CanPropose             bool               `json:"canPropose"`
 	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
 	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Revert                 *TransactionRevert `json:"revert,omitempty"`
 }
 type ProposeTNDAOSettingMembersQuorumResponse struct {
 	Status     string      `json:"status"`
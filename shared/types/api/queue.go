@@ -23,6 +23,7 @@ type CanProcessQueueResponse struct {
 	NoMinipoolsAvailable       bool               `json:"noMinipoolsAvailable"`
 	InsufficientDepositBalance bool               `json:"insufficientDepositBalance"`
 	GasInfo                    rocketpool.GasInfo `json:"gasInfo"`
+	Revert                     *TransactionRevert `json:"revert,omitempty"`
 }
 type ProcessQueueResponse struct {
 	Status string      `json:"status"`
@@ -35,3 +35,10 @@ type ClientStatusResponse struct {
 	EcManagerStatus ClientManagerStatus `json:"ecManagerStatus"`
 	BcManagerStatus ClientManagerStatus `json:"bcManagerStatus"`
 }
+
+type CheckBackupNodeResponse struct {
+	Status        string `json:"status"`
+	Error         string `json:"error"`
+	Registered    bool   `json:"registered"`
+	MinipoolCount uint64 `json:"minipoolCount"`
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/offline"
 )
 
 // Encrypted validator keystore following the EIP-2335 standard
@@ -17,11 +19,15 @@ type ValidatorKeystore struct {
 }
 
 type WalletStatusResponse struct {
-	Status            string         `json:"status"`
-	Error             string         `json:"error"`
-	PasswordSet       bool           `json:"passwordSet"`
-	WalletInitialized bool           `json:"walletInitialized"`
-	AccountAddress    common.Address `json:"accountAddress"`
+	Status            string                  `json:"status"`
+	Error             string                  `json:"error"`
+	PasswordSet       bool                    `json:"passwordSet"`
+	PasswordLocked    bool                    `json:"passwordLocked"`
+	PasswordBackend   string                  `json:"passwordBackend"`
+	WalletInitialized bool                    `json:"walletInitialized"`
+	WatchOnly         bool                    `json:"watchOnly"`
+	AccountAddress    common.Address          `json:"accountAddress"`
+	ImportedKeys      []types.ValidatorPubkey `json:"importedKeys"`
 }
 
 type SetPasswordResponse struct {
@@ -29,6 +35,20 @@ type SetPasswordResponse struct {
 	Error  string `json:"error"`
 }
 
+type ImportValidatorKeyResponse struct {
+	Status             string                `json:"status"`
+	Error              string                `json:"error"`
+	Pubkey             types.ValidatorPubkey `json:"pubkey"`
+	MinipoolAddress    common.Address        `json:"minipoolAddress"`
+	RestartedValidator bool                  `json:"restartedValidator"`
+}
+
+type UnlockWalletResponse struct {
+	Status         string         `json:"status"`
+	Error          string         `json:"error"`
+	AccountAddress common.Address `json:"accountAddress"`
+}
+
 type InitWalletResponse struct {
 	Status         string         `json:"status"`
 	Error          string         `json:"error"`
@@ -78,3 +98,14 @@ type PurgeResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type SetOfflineNodeAddressResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+type SignOfflineBundleResponse struct {
+	Status string                `json:"status"`
+	Error  string                `json:"error"`
+	Bundle *offline.SignedBundle `json:"bundle"`
+}
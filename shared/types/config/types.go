@@ -8,6 +8,10 @@ type ExecutionClient string
 type ConsensusClient string
 type RewardsMode string
 type MevRelay string
+type PasswordBackend string
+type RestakeMode string
+type FeeOracle string
+type NotificationSeverity string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -81,6 +85,41 @@ const (
 	RewardsMode_Generate RewardsMode = "generate"
 )
 
+// Enum to describe where the node password is stored
+const (
+	PasswordBackend_Unknown      PasswordBackend = ""
+	PasswordBackend_File         PasswordBackend = "file"
+	PasswordBackend_SystemdCreds PasswordBackend = "systemdCreds"
+	PasswordBackend_Keyring      PasswordBackend = "keyring"
+	PasswordBackend_Env          PasswordBackend = "env"
+	PasswordBackend_Fd           PasswordBackend = "fd"
+	PasswordBackend_Interactive  PasswordBackend = "interactive"
+)
+
+// Enum to describe how much claimed RPL the node should automatically restake
+const (
+	RestakeMode_Unknown          RestakeMode = ""
+	RestakeMode_None             RestakeMode = "none"
+	RestakeMode_CollateralTarget RestakeMode = "collateralTarget"
+	RestakeMode_Fraction         RestakeMode = "fraction"
+)
+
+// Enum to describe where the Smartnode gets its gas fee suggestions from
+const (
+	FeeOracle_Unknown                FeeOracle = ""
+	FeeOracle_ExecutionClient        FeeOracle = "executionClient"
+	FeeOracle_ExecutionClientWithWeb FeeOracle = "executionClientWithWeb"
+	FeeOracle_Web                    FeeOracle = "web"
+)
+
+// Enum to describe how important an operator notification is
+const (
+	NotificationSeverity_Unknown  NotificationSeverity = ""
+	NotificationSeverity_Info     NotificationSeverity = "info"
+	NotificationSeverity_Warning  NotificationSeverity = "warning"
+	NotificationSeverity_Critical NotificationSeverity = "critical"
+)
+
 // Enum to describe MEV-boost relays
 const (
 	MevRelay_Unknown            MevRelay = ""
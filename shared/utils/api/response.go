@@ -1,22 +1,42 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
 	"reflect"
+	"strings"
+
+	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
+// The writer that API responses are printed to
+var output io.Writer = os.Stdout
+
+// Set the writer that API responses are printed to
+func SetOutput(w io.Writer) {
+	output = w
+}
+
 // Print an API response
 // response must be a pointer to a struct type with Error and Status string fields
 func PrintResponse(response interface{}, responseError error) {
+	WriteResponse(output, response, responseError)
+}
+
+// Write an API response to a writer instead of the API output
+// response must be a pointer to a struct type with Error and Status string fields
+func WriteResponse(w io.Writer, response interface{}, responseError error) {
 
 	// Check response type
 	r := reflect.ValueOf(response)
 	if !(r.Kind() == reflect.Ptr && r.Type().Elem().Kind() == reflect.Struct) {
-		PrintErrorResponse(errors.New("Invalid API response"))
+		WriteResponse(w, &api.APIResponse{}, errors.New("Invalid API response"))
 		return
 	}
 
@@ -30,7 +50,7 @@ func PrintResponse(response interface{}, responseError error) {
 	sf := r.Elem().FieldByName("Status")
 	ef := r.Elem().FieldByName("Error")
 	if !(sf.IsValid() && sf.CanSet() && sf.Kind() == reflect.String && ef.IsValid() && ef.CanSet() && ef.Kind() == reflect.String) {
-		PrintErrorResponse(errors.New("Invalid API response"))
+		WriteResponse(w, &api.APIResponse{}, errors.New("Invalid API response"))
 		return
 	}
 
@@ -49,12 +69,12 @@ func PrintResponse(response interface{}, responseError error) {
 	// Encode
 	responseBytes, err := json.Marshal(response)
 	if err != nil {
-		PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+		WriteResponse(w, &api.APIResponse{}, fmt.Errorf("Could not encode API response: %w", err))
 		return
 	}
 
 	// Print
-	fmt.Println(string(responseBytes))
+	fmt.Fprintln(w, string(responseBytes))
 
 }
 
@@ -62,3 +82,21 @@ func PrintResponse(response interface{}, responseError error) {
 func PrintErrorResponse(err error) {
 	PrintResponse(&api.APIResponse{}, err)
 }
+
+// Run an API command in this process as if it was called from the command line, returning its response.
+// The CLI library exits the process on some errors, which is disabled so the caller keeps running.
+func RunCommand(app *cli.App, args []string) []byte {
+	var response bytes.Buffer
+	SetOutput(&response)
+	osExiter := cli.OsExiter
+	cli.OsExiter = func(code int) {}
+	err := app.Run(args)
+	cli.OsExiter = osExiter
+	if err != nil {
+		PrintErrorResponse(err)
+	} else if response.Len() == 0 {
+		PrintErrorResponse(fmt.Errorf("Unknown API command: %s", strings.Join(args, " ")))
+	}
+	SetOutput(os.Stdout)
+	return response.Bytes()
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -8,9 +9,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/settings/protocol"
-	"github.com/rocket-pool/rocketpool-go/utils"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
@@ -55,7 +56,13 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 }
 
 // Print a TX's details to the logger and waits for it to be mined.
-func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, ec rocketpool.ExecutionClient, logger log.ColorLogger) error {
+// The transaction manager follows its replacements, and replaces it if it gets stuck.
+func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, tm *txmanager.Manager, logger log.ColorLogger) error {
+	return PrintAndWaitForTransactionContext(context.Background(), cfg, hash, tm, logger)
+}
+
+// Print a TX's details to the logger and wait for it to be mined, giving up when the context is cancelled
+func PrintAndWaitForTransactionContext(ctx context.Context, cfg *config.RocketPoolConfig, hash common.Hash, tm *txmanager.Manager, logger log.ColorLogger) error {
 
 	txWatchUrl := cfg.Smartnode.GetTxWatchUrl()
 	hashString := hash.String()
@@ -68,7 +75,7 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 	logger.Println("Waiting for the transaction to be mined...")
 
 	// Wait for the TX to be mined
-	if _, err := utils.WaitForTransaction(ec, hash); err != nil {
+	if _, err := tm.WaitForTransactionContext(ctx, hash, &logger); err != nil {
 		return fmt.Errorf("Error mining transaction: %w", err)
 	}
 
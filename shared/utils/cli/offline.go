@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/offline"
+)
+
+// Flags for commands whose transactions can be built for signing on an offline machine
+var OfflineFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "offline",
+		Usage: "Add the unsigned transaction to this bundle `file` for signing on an offline machine with 'rocketpool wallet sign-offline', instead of sending it",
+	},
+	cli.BoolFlag{
+		Name:  "offline-payload",
+		Usage: "When building for offline signing, also print the bundle as a single line of text (e.g. for a QR code)",
+	},
+}
+
+// Check if a command should build its transaction for offline signing instead of sending it
+func IsOffline(c *cli.Context) bool {
+	return c.String("offline") != ""
+}
+
+// Load the offline bundle a command should add its unsigned transaction to, or create an empty one if it doesn't exist yet
+func LoadOfflineBundle(c *cli.Context) (*offline.UnsignedBundle, error) {
+	path := c.String("offline")
+	if !offline.Exists(path) {
+		return offline.NewUnsignedBundle(0, common.Address{}), nil
+	}
+	return offline.LoadUnsignedBundle(path)
+}
+
+// Save the offline bundle after adding to it, checking that everything in it is for the same network and node
+func SaveOfflineBundle(c *cli.Context, bundle *offline.UnsignedBundle, chainID uint64, nodeAddress common.Address) error {
+
+	// Check the bundle
+	if bundle.ChainID == 0 {
+		bundle.ChainID = chainID
+		bundle.NodeAddress = nodeAddress
+	} else if err := bundle.Check(chainID, nodeAddress); err != nil {
+		return err
+	}
+
+	// Save it
+	path := c.String("offline")
+	if err := bundle.Save(path); err != nil {
+		return err
+	}
+	fmt.Printf("Added to the offline bundle %s, which now has %d transaction(s), %d deposit(s) and %d exit(s).\n", path, len(bundle.Transactions), len(bundle.Deposits), len(bundle.Exits))
+
+	// Print the payload if requested
+	if c.Bool("offline-payload") {
+		payload, err := offline.EncodePayload(bundle)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\nBundle payload:\n%s\n\n", payload)
+	}
+
+	fmt.Println("Sign it on your offline machine with `rocketpool wallet sign-offline`, then broadcast the result from this machine with `rocketpool node broadcast`.")
+	return nil
+
+}
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// The version of the structured output envelope; this is increased whenever a change would break existing scripts
+const OutputSchemaVersion = 1
+
+// The format that commands print their results in
+type OutputFormat string
+
+const (
+	OutputFormat_Table OutputFormat = "table"
+	OutputFormat_Json  OutputFormat = "json"
+	OutputFormat_Yaml  OutputFormat = "yaml"
+)
+
+// The envelope that structured command results are printed in
+type StructuredOutput struct {
+	SchemaVersion int         `json:"schemaVersion"`
+	Command       string      `json:"command,omitempty"`
+	Status        string      `json:"status"`
+	Error         string      `json:"error,omitempty"`
+	Data          interface{} `json:"data,omitempty"`
+}
+
+// The selected output format, and where structured output is written to
+var (
+	outputFormat           = OutputFormat_Table
+	structuredOutputWriter io.Writer
+)
+
+// Set the output format for the rest of the process.
+// In the structured formats, everything other than the result is written to stderr without colors so stdout can be parsed.
+func SetOutputFormat(format string) error {
+	switch OutputFormat(strings.ToLower(format)) {
+	case OutputFormat_Table, "":
+		outputFormat = OutputFormat_Table
+		return nil
+	case OutputFormat_Json:
+		outputFormat = OutputFormat_Json
+	case OutputFormat_Yaml:
+		outputFormat = OutputFormat_Yaml
+	default:
+		return fmt.Errorf("Invalid output format '%s'; it must be 'table', 'json' or 'yaml'", format)
+	}
+
+	structuredOutputWriter = os.Stdout
+	os.Stdout = os.Stderr
+	color.Output = os.Stderr
+	color.NoColor = true
+	return nil
+}
+
+// Check if command results should be printed in a structured format instead of as text
+func IsStructuredOutput() bool {
+	return outputFormat != OutputFormat_Table
+}
+
+// Make the commands that can't print their results in a structured format fail with a structured error when one is selected,
+// instead of running and printing text. Supported commands are named without the application name, e.g. "node status".
+func RequireStructuredOutputSupport(commands []cli.Command, supported map[string]bool) {
+	requireStructuredOutputSupport(commands, "", supported)
+}
+func requireStructuredOutputSupport(commands []cli.Command, prefix string, supported map[string]bool) {
+	for i := range commands {
+		command := &commands[i]
+		name := prefix + command.Name
+		requireStructuredOutputSupport(command.Subcommands, name+" ", supported)
+		if command.Action == nil || supported[name] {
+			continue
+		}
+		action := command.Action
+		command.Action = func(c *cli.Context) error {
+			if IsStructuredOutput() {
+				return fmt.Errorf("Structured output is not supported for '%s'", name)
+			}
+			return cli.HandleAction(action, c)
+		}
+	}
+}
+
+// Print the result of a command in the selected structured format
+func PrintStructuredOutput(c *cli.Context, response interface{}) error {
+	return printStructuredOutput(StructuredOutput{
+		SchemaVersion: OutputSchemaVersion,
+		Command:       getCommandName(c),
+		Status:        "success",
+		Data:          response,
+	})
+}
+
+// Print an error in the selected structured format
+func PrintStructuredError(err error) {
+	if printErr := printStructuredOutput(StructuredOutput{
+		SchemaVersion: OutputSchemaVersion,
+		Status:        "error",
+		Error:         err.Error(),
+	}); printErr != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+}
+
+// Serialize and print a structured output envelope
+func printStructuredOutput(output StructuredOutput) error {
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Could not serialize command output: %w", err)
+	}
+	if outputFormat == OutputFormat_Json {
+		_, err = fmt.Fprintln(structuredOutputWriter, string(jsonBytes))
+		return err
+	}
+
+	// Convert the JSON representation to YAML so both formats use the same field names
+	var generic interface{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&generic); err != nil {
+		return fmt.Errorf("Could not convert command output: %w", err)
+	}
+	yamlBytes, err := yaml.Marshal(convertJsonNumbers(generic))
+	if err != nil {
+		return fmt.Errorf("Could not serialize command output: %w", err)
+	}
+	_, err = structuredOutputWriter.Write(yamlBytes)
+	return err
+}
+
+// Convert JSON numbers to native types so they aren't quoted in YAML; integers too large for an int64 are kept as strings
+func convertJsonNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, element := range v {
+			v[key] = convertJsonNumbers(element)
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = convertJsonNumbers(element)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if strings.ContainsAny(v.String(), ".eE") {
+			if f, err := v.Float64(); err == nil {
+				return f
+			}
+		}
+		return v.String()
+	}
+	return value
+}
+
+// Get the name of a command without the application name, e.g. "node status"
+func getCommandName(c *cli.Context) string {
+	name := strings.SplitN(c.Command.HelpName, " ", 2)
+	return name[len(name)-1]
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+)
+
+// Flags for commands whose transactions can be queued for the node daemon to send once gas is low
+var TransactionQueueFlags = []cli.Flag{
+	cli.Float64Flag{
+		Name:  "when-gas-below",
+		Usage: "Queue the transaction for the node daemon to send once the base fee is below this value (in gwei), instead of sending it now",
+	},
+	cli.StringFlag{
+		Name:  "deadline",
+		Usage: "When queueing, send the transaction after this long even if the base fee is still too high (e.g. '48h')",
+	},
+	cli.StringFlag{
+		Name:  "expires",
+		Usage: "When queueing, give up on the transaction if it hasn't been sent after this long",
+		Value: txmanager.DefaultQueueExpiry.String(),
+	},
+}
+
+// Get the conditions for queueing a transaction from the command's flags, or nil if it should be sent now
+func GetTransactionDeferral(c *cli.Context) (*txmanager.Deferral, error) {
+	if !c.IsSet("when-gas-below") {
+		return nil, nil
+	}
+	if IsOffline(c) {
+		return nil, fmt.Errorf("A transaction can't be both queued and built for offline signing.")
+	}
+	gasThreshold := c.Float64("when-gas-below")
+	if gasThreshold <= 0 {
+		return nil, fmt.Errorf("The gas threshold must be greater than 0.")
+	}
+
+	now := time.Now()
+	deferral := &txmanager.Deferral{
+		GasThreshold: gasThreshold,
+	}
+	expiry, err := time.ParseDuration(c.String("expires"))
+	if err != nil || expiry <= 0 {
+		return nil, fmt.Errorf("Invalid expiry '%s' - must be a duration such as '72h'", c.String("expires"))
+	}
+	deferral.Expiry = now.Add(expiry)
+	if c.String("deadline") != "" {
+		deadline, err := time.ParseDuration(c.String("deadline"))
+		if err != nil || deadline <= 0 {
+			return nil, fmt.Errorf("Invalid deadline '%s' - must be a duration such as '48h'", c.String("deadline"))
+		}
+		if deadline >= expiry {
+			return nil, fmt.Errorf("The deadline must be sooner than the expiry.")
+		}
+		deferral.Deadline = now.Add(deadline)
+	}
+	return deferral, nil
+}
+
+// Print the details of a queued transaction
+func PrintQueuedTransaction(id uint64, deferral txmanager.Deferral) {
+	fmt.Printf("The transaction was added to the queue with ID %d.\n", id)
+	fmt.Printf("The node daemon will send it once the base fee is below %.2f gwei", deferral.GasThreshold)
+	if !deferral.Deadline.IsZero() {
+		fmt.Printf(", or at %s if it isn't by then", deferral.Deadline.Format(time.RFC1123))
+	}
+	fmt.Printf(".\nIf it hasn't been sent by %s, it will expire.\n", deferral.Expiry.Format(time.RFC1123))
+	fmt.Printf("You can check on it with `rocketpool node tx queue`, or cancel it with `rocketpool node tx cancel-queued %d`.\n", id)
+}
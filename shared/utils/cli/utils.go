@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -38,7 +39,8 @@ func PrintMultiTransactionNonceWarning() {
 	fmt.Printf("%sNOTE: You have specified the `nonce` flag to indicate a custom nonce for this transaction.\n"+
 		"However, this operation requires multiple transactions.\n"+
 		"Rocket Pool will use your custom value as a basis, and increment it for each additional transaction.\n"+
-		"If you have multiple pending transactions, this MAY OVERRIDE more than the one that you specified.%s\n\n", colorYellow, colorReset)
+		"If you have multiple pending transactions, this MAY OVERRIDE more than the one that you specified.\n"+
+		"You can see the node's pending transactions with `rocketpool node tx list --pending`, and replace one of them with `rocketpool node tx speed-up` or `rocketpool node tx cancel` instead.%s\n\n", colorYellow, colorReset)
 
 }
 
@@ -107,10 +109,20 @@ func PrettyPrintError(err error) {
 		if exists {
 			prettyErr = replacementMessage
 		}
+	} else if index := strings.Index(errorMessage, "would revert:"); index >= 0 {
+		firstMessage := strings.TrimSpace(strings.Split(errorMessage, ":")[0])
+		prettyErr = fmt.Sprintf("%s: %s", firstMessage, errorMessage[index:])
 	}
 	fmt.Println(prettyErr)
 }
 
+// Prints the reason a transaction would revert, if simulating it found one
+func PrintTransactionRevert(revert *api.TransactionRevert) {
+	if revert != nil {
+		fmt.Printf("The transaction would revert: %s\n", revert.Reason)
+	}
+}
+
 // Prints an error message when the Beacon client is not using the deposit contract address that Rocket Pool expects
 func PrintDepositMismatchError(rpNetwork, beaconNetwork uint64, rpDepositAddress, beaconDepositAddress common.Address) {
 	fmt.Printf("%s***ALERT***\n", colorRed)
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/tyler-smith/go-bip39"
 	"github.com/urfave/cli"
 
@@ -265,3 +266,12 @@ func ValidateTxHash(name, value string) (common.Hash, error) {
 	return hash, nil
 
 }
+
+// Validate a validator pubkey
+func ValidatePubkey(name, value string) (types.ValidatorPubkey, error) {
+	pubkey, err := types.HexToValidatorPubkey(strings.ToLower(strings.TrimPrefix(value, "0x")))
+	if err != nil {
+		return types.ValidatorPubkey{}, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+	}
+	return pubkey, nil
+}
@@ -12,7 +12,7 @@ import (
 )
 
 const (
-	upgradeFlagFile string = ".firstrun"
+	UpgradeFlagFile string = ".firstrun"
 )
 
 // Loads a config without updating it if it exists
@@ -49,7 +49,7 @@ func SaveConfig(cfg *config.RocketPoolConfig, path string) error {
 
 // Checks if this is the first run of the configurator after an install
 func IsFirstRun(configDir string) bool {
-	upgradeFilePath := filepath.Join(configDir, upgradeFlagFile)
+	upgradeFilePath := filepath.Join(configDir, UpgradeFlagFile)
 
 	// Load the config normally if the upgrade flag file isn't there
 	_, err := os.Stat(upgradeFilePath)
@@ -64,7 +64,7 @@ func IsFirstRun(configDir string) bool {
 func RemoveUpgradeFlagFile(configDir string) error {
 
 	// Check for the upgrade flag file
-	upgradeFilePath := filepath.Join(configDir, upgradeFlagFile)
+	upgradeFilePath := filepath.Join(configDir, UpgradeFlagFile)
 	_, err := os.Stat(upgradeFilePath)
 	if os.IsNotExist(err) {
 		return nil
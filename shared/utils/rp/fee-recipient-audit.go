@@ -0,0 +1,89 @@
+package rp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"gopkg.in/yaml.v2"
+)
+
+// The progress and totals of the proposed block fee recipient audit
+type FeeRecipientAuditState struct {
+	LastCheckedEpoch uint64 `yaml:"lastCheckedEpoch"`
+	CheckedBlocks    uint64 `yaml:"checkedBlocks"`
+	MismatchedBlocks uint64 `yaml:"mismatchedBlocks"`
+	LastMismatchSlot uint64 `yaml:"lastMismatchSlot"`
+
+	// Epochs that couldn't be audited and were skipped so the audit keeps moving
+	UnverifiableEpochs    uint64 `yaml:"unverifiableEpochs"`
+	LastUnverifiableEpoch uint64 `yaml:"lastUnverifiableEpoch"`
+}
+
+// The audit result for a single block proposed by one of the node's validators
+type FeeRecipientAuditEntry struct {
+	Slot                 uint64                `json:"slot"`
+	ExecutionBlockNumber uint64                `json:"executionBlockNumber"`
+	ValidatorIndex       uint64                `json:"validatorIndex"`
+	Pubkey               types.ValidatorPubkey `json:"pubkey"`
+	MinipoolAddress      common.Address        `json:"minipoolAddress"`
+	FeeRecipient         common.Address        `json:"feeRecipient"`
+	ExpectedFeeRecipient common.Address        `json:"expectedFeeRecipient"`
+	Correct              bool                  `json:"correct"`
+	ExpectedFromHead     bool                  `json:"expectedFromHead,omitempty"`
+	CheckedAt            time.Time             `json:"checkedAt"`
+}
+
+// Load the audit state, returning nil if the audit hasn't run yet
+func LoadFeeRecipientAuditState(path string) (*FeeRecipientAuditState, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading fee recipient audit state: %w", err)
+	}
+	state := new(FeeRecipientAuditState)
+	if err := yaml.Unmarshal(bytes, state); err != nil {
+		return nil, fmt.Errorf("error deserializing fee recipient audit state: %w", err)
+	}
+	return state, nil
+}
+
+// Save the audit state
+func (s *FeeRecipientAuditState) Save(path string) error {
+	bytes, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("error serializing fee recipient audit state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating fee recipient audit directory: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("error writing fee recipient audit state: %w", err)
+	}
+	return nil
+}
+
+// Append entries to the audit log, one JSON object per line
+func AppendFeeRecipientAuditLog(path string, entries []FeeRecipientAuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening fee recipient audit log: %w", err)
+	}
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return fmt.Errorf("error writing fee recipient audit log: %w", err)
+		}
+	}
+	return nil
+}
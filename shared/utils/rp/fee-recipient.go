@@ -101,3 +101,11 @@ func GetFeeRecipientInfo(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddres
 	return info, nil
 
 }
+
+// Get the fee recipient the node's validators should be using
+func (info *FeeRecipientInfo) GetCorrectFeeRecipient() common.Address {
+	if info.IsInSmoothingPool || info.IsInOptOutCooldown {
+		return info.SmoothingPoolAddress
+	}
+	return info.FeeDistributorAddress
+}
@@ -0,0 +1,204 @@
+package rp
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const customFeeRecipientsFileMode = 0644
+
+// Why a validator uses its fee recipient
+type FeeRecipientSource string
+
+const (
+	FeeRecipientSource_SmoothingPool  FeeRecipientSource = "smoothingPool"
+	FeeRecipientSource_OptOutCooldown FeeRecipientSource = "optOutCooldown"
+	FeeRecipientSource_Distributor    FeeRecipientSource = "distributor"
+	FeeRecipientSource_Custom         FeeRecipientSource = "custom"
+)
+
+// The fee recipient a single validator should use
+type ValidatorFeeRecipient struct {
+	Pubkey          types.ValidatorPubkey `json:"pubkey"`
+	MinipoolAddress common.Address        `json:"minipoolAddress"`
+	MinipoolStatus  types.MinipoolStatus  `json:"minipoolStatus"`
+	IsMinipool      bool                  `json:"isMinipool"`
+	FeeRecipient    common.Address        `json:"feeRecipient"`
+	Source          FeeRecipientSource    `json:"source"`
+}
+
+// Get the fee recipient of every minipool validator belonging to the node, and of every solo validator with a custom fee recipient
+func GetValidatorFeeRecipients(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, nodeAddress common.Address, info *FeeRecipientInfo) ([]ValidatorFeeRecipient, error) {
+
+	// Get the node's minipools
+	minipools, err := minipool.GetNodeMinipools(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting node minipools: %w", err)
+	}
+	statuses := make([]types.MinipoolStatus, len(minipools))
+	finalised := make([]bool, len(minipools))
+	var wg errgroup.Group
+	for i, details := range minipools {
+		i, details := i, details
+		wg.Go(func() error {
+			mp, err := minipool.NewMinipool(rp, details.Address)
+			if err != nil {
+				return err
+			}
+			statuses[i], err = mp.GetStatus(nil)
+			if err != nil {
+				return fmt.Errorf("Error getting status of minipool %s: %w", details.Address.Hex(), err)
+			}
+			finalised[i], err = mp.GetFinalised(nil)
+			if err != nil {
+				return fmt.Errorf("Error getting finalised status of minipool %s: %w", details.Address.Hex(), err)
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the fee recipient for each minipool validator
+	recipients := make([]ValidatorFeeRecipient, 0, len(minipools))
+	minipoolPubkeys := map[types.ValidatorPubkey]bool{}
+	for i, details := range minipools {
+		recipient, isActive := getMinipoolFeeRecipient(details, statuses[i], finalised[i], info)
+		if !isActive {
+			continue
+		}
+		recipients = append(recipients, recipient)
+		minipoolPubkeys[details.Pubkey] = true
+	}
+
+	// Add the custom fee recipients; active minipool validators can't be overridden, since that would be penalized
+	customRecipients, err := LoadCustomFeeRecipients(cfg)
+	if err != nil {
+		return nil, err
+	}
+	for pubkey, feeRecipient := range customRecipients {
+		if minipoolPubkeys[pubkey] {
+			continue
+		}
+		recipients = append(recipients, ValidatorFeeRecipient{
+			Pubkey:       pubkey,
+			FeeRecipient: feeRecipient,
+			Source:       FeeRecipientSource_Custom,
+		})
+	}
+
+	// Sort by pubkey so the order is stable
+	sort.Slice(recipients, func(i, j int) bool {
+		return bytes.Compare(recipients[i].Pubkey[:], recipients[j].Pubkey[:]) < 0
+	})
+
+	// Return
+	return recipients, nil
+
+}
+
+// Get the fee recipient a minipool's validator should use from the minipool's own state.
+// Dissolved and finalised minipools no longer earn rewards for the protocol, so they aren't active and their validators are treated like solo ones.
+func getMinipoolFeeRecipient(details minipool.MinipoolDetails, status types.MinipoolStatus, finalised bool, info *FeeRecipientInfo) (ValidatorFeeRecipient, bool) {
+	recipient := ValidatorFeeRecipient{
+		Pubkey:          details.Pubkey,
+		MinipoolAddress: details.Address,
+		MinipoolStatus:  status,
+		IsMinipool:      true,
+	}
+	if status == types.Dissolved || finalised {
+		return recipient, false
+	}
+	switch {
+	case info.IsInSmoothingPool:
+		recipient.FeeRecipient = info.SmoothingPoolAddress
+		recipient.Source = FeeRecipientSource_SmoothingPool
+	case info.IsInOptOutCooldown:
+		recipient.FeeRecipient = info.SmoothingPoolAddress
+		recipient.Source = FeeRecipientSource_OptOutCooldown
+	default:
+		recipient.FeeRecipient = info.FeeDistributorAddress
+		recipient.Source = FeeRecipientSource_Distributor
+	}
+	return recipient, true
+}
+
+// Get the validators whose fee recipient differs from the default one
+func GetFeeRecipientOverrides(recipients []ValidatorFeeRecipient, defaultFeeRecipient common.Address) map[types.ValidatorPubkey]common.Address {
+	overrides := map[types.ValidatorPubkey]common.Address{}
+	for _, recipient := range recipients {
+		if recipient.FeeRecipient != defaultFeeRecipient {
+			overrides[recipient.Pubkey] = recipient.FeeRecipient
+		}
+	}
+	return overrides
+}
+
+// Load the fee recipients the user assigned to solo validators, keyed by pubkey
+func LoadCustomFeeRecipients(cfg *config.RocketPoolConfig) (map[types.ValidatorPubkey]common.Address, error) {
+
+	// Read the file if it exists
+	path := cfg.Smartnode.GetCustomFeeRecipientsFilePath()
+	fileBytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return map[types.ValidatorPubkey]common.Address{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("Error reading custom fee recipient file: %w", err)
+	}
+
+	// Deserialize it
+	entries := map[string]string{}
+	if err := yaml.Unmarshal(fileBytes, &entries); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling custom fee recipient file: %w", err)
+	}
+	recipients := make(map[types.ValidatorPubkey]common.Address, len(entries))
+	for pubkeyString, addressString := range entries {
+		pubkey, err := types.HexToValidatorPubkey(strings.ToLower(hexutil.RemovePrefix(pubkeyString)))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid pubkey '%s' in custom fee recipient file: %w", pubkeyString, err)
+		}
+		if !common.IsHexAddress(addressString) {
+			return nil, fmt.Errorf("Invalid fee recipient '%s' for validator %s in custom fee recipient file", addressString, pubkey.Hex())
+		}
+		recipients[pubkey] = common.HexToAddress(addressString)
+	}
+	return recipients, nil
+
+}
+
+// Save the fee recipients the user assigned to solo validators
+func SaveCustomFeeRecipients(cfg *config.RocketPoolConfig, recipients map[types.ValidatorPubkey]common.Address) error {
+
+	// Serialize them
+	entries := make(map[string]string, len(recipients))
+	for pubkey, feeRecipient := range recipients {
+		entries[hexutil.AddPrefix(pubkey.Hex())] = feeRecipient.Hex()
+	}
+	fileBytes, err := yaml.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("Error marshalling custom fee recipient file: %w", err)
+	}
+
+	// Write the file
+	if err := ioutil.WriteFile(cfg.Smartnode.GetCustomFeeRecipientsFilePath(), fileBytes, customFeeRecipientsFileMode); err != nil {
+		return fmt.Errorf("Error writing custom fee recipient file: %w", err)
+	}
+	return nil
+
+}
@@ -0,0 +1,152 @@
+package rp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// The duties and results of a single validator in a single epoch
+type ValidatorEpochPerformance struct {
+	Epoch                uint64 `json:"epoch"`
+	AttestationDuties    uint64 `json:"attestationDuties"`
+	AttestationsIncluded uint64 `json:"attestationsIncluded"`
+	InclusionDistance    uint64 `json:"inclusionDistance"`
+	ProposalDuties       uint64 `json:"proposalDuties"`
+	ProposalsMissed      uint64 `json:"proposalsMissed"`
+	ProposalsUnknown     bool   `json:"proposalsUnknown,omitempty"`
+	SyncDuties           uint64 `json:"syncDuties"`
+	SyncParticipations   uint64 `json:"syncParticipations"`
+}
+
+// The tracked performance of one of the node's validators
+type ValidatorPerformanceRecord struct {
+	MinipoolAddress common.Address              `json:"minipoolAddress"`
+	Pubkey          types.ValidatorPubkey       `json:"pubkey"`
+	Index           uint64                      `json:"index"`
+	Epochs          []ValidatorEpochPerformance `json:"epochs"`
+}
+
+// The tracked performance of all of the node's validators
+type ValidatorPerformanceState struct {
+	LastCheckedEpoch uint64                                 `json:"lastCheckedEpoch"`
+	Validators       map[uint64]*ValidatorPerformanceRecord `json:"validators"`
+}
+
+// A validator's performance summarized over a window of epochs
+type ValidatorPerformance struct {
+	MinipoolAddress          common.Address        `json:"minipoolAddress"`
+	ValidatorPubkey          types.ValidatorPubkey `json:"validatorPubkey"`
+	ValidatorIndex           uint64                `json:"validatorIndex"`
+	EpochsTracked            uint64                `json:"epochsTracked"`
+	AttestationDuties        uint64                `json:"attestationDuties"`
+	AttestationsIncluded     uint64                `json:"attestationsIncluded"`
+	AverageInclusionDistance float64               `json:"averageInclusionDistance"`
+	ProposalDuties           uint64                `json:"proposalDuties"`
+	ProposalsMissed          uint64                `json:"proposalsMissed"`
+	ProposalsUnknownEpochs   uint64                `json:"proposalsUnknownEpochs"`
+	SyncDuties               uint64                `json:"syncDuties"`
+	SyncParticipations       uint64                `json:"syncParticipations"`
+}
+
+// Load the performance state, returning nil if the monitor hasn't run yet
+func LoadValidatorPerformanceState(path string) (*ValidatorPerformanceState, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading validator performance state: %w", err)
+	}
+	state := new(ValidatorPerformanceState)
+	if err := json.Unmarshal(bytes, state); err != nil {
+		return nil, fmt.Errorf("error deserializing validator performance state: %w", err)
+	}
+	if state.Validators == nil {
+		state.Validators = map[uint64]*ValidatorPerformanceRecord{}
+	}
+	return state, nil
+}
+
+// Save the performance state
+func (s *ValidatorPerformanceState) Save(path string) error {
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("error serializing validator performance state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating validator performance directory: %w", err)
+	}
+
+	// Write to a temporary file first so the state isn't lost if the daemon stops partway through
+	tempPath := path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, bytes, 0644); err != nil {
+		return fmt.Errorf("error writing validator performance state: %w", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("error writing validator performance state: %w", err)
+	}
+	return nil
+}
+
+// Drop the epochs that are older than the retention window
+func (s *ValidatorPerformanceState) Prune(retentionEpochs uint64) {
+	if s.LastCheckedEpoch < retentionEpochs {
+		return
+	}
+	oldestEpoch := s.LastCheckedEpoch - retentionEpochs + 1
+	for _, record := range s.Validators {
+		start := sort.Search(len(record.Epochs), func(i int) bool {
+			return record.Epochs[i].Epoch >= oldestEpoch
+		})
+		record.Epochs = record.Epochs[start:]
+	}
+}
+
+// Summarize each validator's performance over the latest window of epochs, sorted by validator index
+func (s *ValidatorPerformanceState) Summarize(windowEpochs uint64) []ValidatorPerformance {
+	oldestEpoch := uint64(0)
+	if s.LastCheckedEpoch >= windowEpochs {
+		oldestEpoch = s.LastCheckedEpoch - windowEpochs + 1
+	}
+
+	summaries := make([]ValidatorPerformance, 0, len(s.Validators))
+	for _, record := range s.Validators {
+		summary := ValidatorPerformance{
+			MinipoolAddress: record.MinipoolAddress,
+			ValidatorPubkey: record.Pubkey,
+			ValidatorIndex:  record.Index,
+		}
+		totalDistance := uint64(0)
+		for _, epoch := range record.Epochs {
+			if epoch.Epoch < oldestEpoch {
+				continue
+			}
+			summary.EpochsTracked++
+			summary.AttestationDuties += epoch.AttestationDuties
+			summary.AttestationsIncluded += epoch.AttestationsIncluded
+			summary.ProposalDuties += epoch.ProposalDuties
+			summary.ProposalsMissed += epoch.ProposalsMissed
+			if epoch.ProposalsUnknown {
+				summary.ProposalsUnknownEpochs++
+			}
+			summary.SyncDuties += epoch.SyncDuties
+			summary.SyncParticipations += epoch.SyncParticipations
+			totalDistance += epoch.InclusionDistance
+		}
+		if summary.AttestationsIncluded > 0 {
+			summary.AverageInclusionDistance = float64(totalDistance) / float64(summary.AttestationsIncluded)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ValidatorIndex < summaries[j].ValidatorIndex
+	})
+	return summaries
+}
@@ -0,0 +1,140 @@
+package validator
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// Settings
+const (
+	ExitBundleVersion          uint64 = 1
+	EncryptedExitBundleVersion uint64 = 1
+	ExitBundleCipher           string = "ecies-secp256k1-aes128-ctr-hmac-sha256"
+)
+
+// A voluntary exit message, as accepted by the Beacon API
+type VoluntaryExitMessage struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// A signed voluntary exit, as accepted by the Beacon API
+type SignedVoluntaryExit struct {
+	Message   VoluntaryExitMessage `json:"message"`
+	Signature string               `json:"signature"`
+}
+
+// A pre-signed voluntary exit for a minipool's validator
+type ExitBundleEntry struct {
+	MinipoolAddress     common.Address      `json:"minipool_address"`
+	Pubkey              string              `json:"pubkey"`
+	SignedVoluntaryExit SignedVoluntaryExit `json:"signed_voluntary_exit"`
+}
+
+// A set of pre-signed voluntary exits that can be broadcast later
+type ExitBundle struct {
+	Version               uint64            `json:"version"`
+	GenesisValidatorsRoot string            `json:"genesis_validators_root"`
+	ForkVersion           string            `json:"fork_version,omitempty"`
+	Eip7044               bool              `json:"eip7044"`
+	Exits                 []ExitBundleEntry `json:"exits"`
+}
+
+// An exit bundle encrypted to a recipient's secp256k1 public key
+type EncryptedExitBundle struct {
+	Version    uint64 `json:"version"`
+	Cipher     string `json:"cipher"`
+	Recipient  string `json:"recipient"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// Create a bundle entry from a signed voluntary exit
+func NewExitBundleEntry(minipoolAddress common.Address, pubkey types.ValidatorPubkey, validatorIndex uint64, epoch uint64, signature types.ValidatorSignature) ExitBundleEntry {
+	return ExitBundleEntry{
+		MinipoolAddress: minipoolAddress,
+		Pubkey:          hexutil.Encode(pubkey.Bytes()),
+		SignedVoluntaryExit: SignedVoluntaryExit{
+			Message: VoluntaryExitMessage{
+				Epoch:          strconv.FormatUint(epoch, 10),
+				ValidatorIndex: strconv.FormatUint(validatorIndex, 10),
+			},
+			Signature: hexutil.Encode(signature.Bytes()),
+		},
+	}
+}
+
+// Get the validator index, epoch and signature of a bundle entry
+func (e ExitBundleEntry) Decode() (uint64, uint64, types.ValidatorSignature, error) {
+	validatorIndex, err := strconv.ParseUint(e.SignedVoluntaryExit.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return 0, 0, types.ValidatorSignature{}, fmt.Errorf("invalid validator index '%s': %w", e.SignedVoluntaryExit.Message.ValidatorIndex, err)
+	}
+	epoch, err := strconv.ParseUint(e.SignedVoluntaryExit.Message.Epoch, 10, 64)
+	if err != nil {
+		return 0, 0, types.ValidatorSignature{}, fmt.Errorf("invalid epoch '%s': %w", e.SignedVoluntaryExit.Message.Epoch, err)
+	}
+	signatureBytes, err := hexutil.Decode(e.SignedVoluntaryExit.Signature)
+	if err != nil {
+		return 0, 0, types.ValidatorSignature{}, fmt.Errorf("invalid signature '%s': %w", e.SignedVoluntaryExit.Signature, err)
+	}
+	return validatorIndex, epoch, types.BytesToValidatorSignature(signatureBytes), nil
+}
+
+// Parse a recipient's secp256k1 public key, in compressed or uncompressed hex form
+func ParseExitBundleRecipient(recipient string) (*ecdsa.PublicKey, error) {
+	keyBytes, err := hexutil.Decode(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient public key '%s': %w", recipient, err)
+	}
+	if len(keyBytes) == 33 {
+		return crypto.DecompressPubkey(keyBytes)
+	}
+	return crypto.UnmarshalPubkey(keyBytes)
+}
+
+// Encrypt an exit bundle so that only the holder of the recipient's private key can read it
+func EncryptExitBundle(bundle ExitBundle, recipient *ecdsa.PublicKey) (EncryptedExitBundle, error) {
+	plaintext, err := json.Marshal(bundle)
+	if err != nil {
+		return EncryptedExitBundle{}, fmt.Errorf("error serializing exit bundle: %w", err)
+	}
+	ciphertext, err := ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(recipient), plaintext, nil, nil)
+	if err != nil {
+		return EncryptedExitBundle{}, fmt.Errorf("error encrypting exit bundle: %w", err)
+	}
+	return EncryptedExitBundle{
+		Version:    EncryptedExitBundleVersion,
+		Cipher:     ExitBundleCipher,
+		Recipient:  hexutil.Encode(crypto.CompressPubkey(recipient)),
+		Ciphertext: hexutil.Encode(ciphertext),
+	}, nil
+}
+
+// Decrypt an exit bundle with the recipient's private key
+func DecryptExitBundle(encrypted EncryptedExitBundle, key *ecdsa.PrivateKey) (ExitBundle, error) {
+	if encrypted.Cipher != ExitBundleCipher {
+		return ExitBundle{}, fmt.Errorf("unsupported exit bundle cipher '%s'", encrypted.Cipher)
+	}
+	ciphertext, err := hexutil.Decode(encrypted.Ciphertext)
+	if err != nil {
+		return ExitBundle{}, fmt.Errorf("invalid exit bundle ciphertext: %w", err)
+	}
+	plaintext, err := ecies.ImportECDSA(key).Decrypt(ciphertext, nil, nil)
+	if err != nil {
+		return ExitBundle{}, fmt.Errorf("error decrypting exit bundle (is this the right key?): %w", err)
+	}
+	var bundle ExitBundle
+	if err := json.Unmarshal(plaintext, &bundle); err != nil {
+		return ExitBundle{}, fmt.Errorf("error deserializing exit bundle: %w", err)
+	}
+	return bundle, nil
+}
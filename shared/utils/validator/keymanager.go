@@ -0,0 +1,201 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// An EIP-2335 keystore used to hand a validator key to the Keymanager API
+type keymanagerKeystore struct {
+	Crypto  map[string]interface{}  `json:"crypto"`
+	Version uint                    `json:"version"`
+	UUID    uuid.UUID               `json:"uuid"`
+	Path    string                  `json:"path"`
+	Pubkey  rptypes.ValidatorPubkey `json:"pubkey"`
+}
+
+// Get a client for the validator client's Keymanager API, or nil if it isn't configured
+func GetKeymanagerClient(cfg *config.RocketPoolConfig) (*keymanager.Client, error) {
+	url := cfg.Smartnode.KeymanagerApiUrl.Value.(string)
+	if url == "" {
+		return nil, nil
+	}
+	tokenPath := os.ExpandEnv(cfg.Smartnode.KeymanagerApiTokenPath.Value.(string))
+	return keymanager.NewClientWithTokenFile(url, tokenPath)
+}
+
+// Load new validator keys into the validator client with the Keymanager API and set their fee recipient if one is provided.
+// Falls back to restarting the validator client if the API isn't configured or fails.
+func LoadValidatorKeys(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.ColorLogger, d *client.Client, keys []*eth2types.BLSPrivateKey, feeRecipient common.Address) error {
+
+	// Get the Keymanager API client
+	km, err := GetKeymanagerClient(cfg)
+	if err != nil {
+		logKeymanagerFallback(log, err)
+		return RestartValidator(cfg, bc, log, d)
+	}
+	if km == nil {
+		return RestartValidator(cfg, bc, log, d)
+	}
+
+	// Import the keys
+	if log != nil {
+		log.Printlnf("Loading %d validator key(s) with the Keymanager API...", len(keys))
+	}
+	if err := importKeys(km, keys, feeRecipient); err != nil {
+		logKeymanagerFallback(log, err)
+		return RestartValidator(cfg, bc, log, d)
+	}
+
+	// Log & return
+	if log != nil {
+		log.Println("Successfully loaded the validator keys")
+	}
+	return nil
+
+}
+
+// Set the fee recipient of every validator in the validator client with the Keymanager API, using the overrides for validators that have them.
+// Falls back to restarting the validator client if the API isn't configured or fails.
+func UpdateFeeRecipient(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.ColorLogger, d *client.Client, feeRecipient common.Address, overrides map[rptypes.ValidatorPubkey]common.Address) error {
+
+	// Get the Keymanager API client
+	km, err := GetKeymanagerClient(cfg)
+	if err != nil {
+		logKeymanagerFallback(log, err)
+		return RestartValidator(cfg, bc, log, d)
+	}
+	if km == nil {
+		return RestartValidator(cfg, bc, log, d)
+	}
+
+	// Set the fee recipient
+	if log != nil {
+		log.Printlnf("Setting the fee recipient to %s with the Keymanager API...", feeRecipient.Hex())
+	}
+	count, err := setFeeRecipients(km, feeRecipient, overrides)
+	if err != nil {
+		logKeymanagerFallback(log, err)
+		return RestartValidator(cfg, bc, log, d)
+	}
+
+	// Log & return
+	if log != nil {
+		log.Printlnf("Successfully updated the fee recipient for %d validator(s)", count)
+	}
+	return nil
+
+}
+
+// Import keys into the validator client
+func importKeys(km *keymanager.Client, keys []*eth2types.BLSPrivateKey, feeRecipient common.Address) error {
+
+	// Create a keystore for each key with a one-time password
+	encryptor := eth2ks.New()
+	keystores := make([]string, len(keys))
+	passwords := make([]string, len(keys))
+	pubkeys := make([]rptypes.ValidatorPubkey, len(keys))
+	for i, key := range keys {
+		password, err := keystore.GenerateRandomPassword()
+		if err != nil {
+			return fmt.Errorf("Could not generate random password: %w", err)
+		}
+		encryptedKey, err := encryptor.Encrypt(key.Marshal(), password)
+		if err != nil {
+			return fmt.Errorf("Could not encrypt validator key: %w", err)
+		}
+		pubkeys[i] = rptypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+		keystoreBytes, err := json.Marshal(keymanagerKeystore{
+			Crypto:  encryptedKey,
+			Version: encryptor.Version(),
+			UUID:    uuid.New(),
+			Pubkey:  pubkeys[i],
+		})
+		if err != nil {
+			return fmt.Errorf("Could not encode validator key: %w", err)
+		}
+		keystores[i] = string(keystoreBytes)
+		passwords[i] = password
+	}
+
+	// Import them
+	statuses, err := km.ImportKeystores(keystores, passwords, "")
+	if err != nil {
+		return err
+	}
+	if len(statuses) != len(keys) {
+		return fmt.Errorf("Keymanager API returned %d statuses for %d keys", len(statuses), len(keys))
+	}
+	for i, status := range statuses {
+		if status.Status != keymanager.StatusImported && status.Status != keymanager.StatusDuplicate {
+			return fmt.Errorf("Could not import validator %s: %s (%s)", pubkeys[i].Hex(), status.Status, status.Message)
+		}
+	}
+
+	// Set their fee recipient, since the validator client's default may be out of date
+	if feeRecipient != (common.Address{}) {
+		for _, pubkey := range pubkeys {
+			if err := km.SetFeeRecipient(pubkey, feeRecipient); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+
+}
+
+// Set the fee recipient of every local and remote key in the validator client, returning the number of keys updated
+func setFeeRecipients(km *keymanager.Client, feeRecipient common.Address, overrides map[rptypes.ValidatorPubkey]common.Address) (int, error) {
+
+	// Get the loaded keys
+	keystores, err := km.ListKeystores()
+	if err != nil {
+		return 0, err
+	}
+	pubkeys := make([]rptypes.ValidatorPubkey, 0, len(keystores))
+	for _, keystore := range keystores {
+		pubkeys = append(pubkeys, keystore.Pubkey)
+	}
+	remoteKeys, err := km.ListRemoteKeys()
+	if err != nil && !errors.Is(err, keymanager.ErrNotSupported) {
+		return 0, err
+	}
+	for _, remoteKey := range remoteKeys {
+		pubkeys = append(pubkeys, remoteKey.Pubkey)
+	}
+
+	// Update them
+	for _, pubkey := range pubkeys {
+		pubkeyFeeRecipient, exists := overrides[pubkey]
+		if !exists {
+			pubkeyFeeRecipient = feeRecipient
+		}
+		if err := km.SetFeeRecipient(pubkey, pubkeyFeeRecipient); err != nil {
+			return 0, err
+		}
+	}
+	return len(pubkeys), nil
+
+}
+
+// Log the reason for falling back to a validator client restart
+func logKeymanagerFallback(log *log.ColorLogger, err error) {
+	if log != nil {
+		log.Printlnf("WARNING: Could not use the Keymanager API (%s), falling back to restarting the validator client.", err.Error())
+	}
+}
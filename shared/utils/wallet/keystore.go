@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Decrypt an EIP-2335 validator keystore and verify that it holds the key for the pubkey it claims
+func DecryptValidatorKeystore(keystore api.ValidatorKeystore, password string) (*eth2types.BLSPrivateKey, error) {
+
+	// Get the encryption function it uses
+	kdf, exists := keystore.Crypto["kdf"]
+	if !exists {
+		return nil, errors.New("\"crypto\" didn't contain a subkey named \"kdf\"")
+	}
+	kdfMap, ok := kdf.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("\"crypto.kdf\" is not an object")
+	}
+	function, exists := kdfMap["function"]
+	if !exists {
+		return nil, errors.New("\"crypto.kdf\" didn't contain a subkey named \"function\"")
+	}
+	functionString, ok := function.(string)
+	if !ok {
+		return nil, errors.New("\"crypto.kdf.function\" is not a string")
+	}
+
+	// Initialize the BLS library
+	if err := eth2types.InitBLS(); err != nil {
+		return nil, fmt.Errorf("error initializing BLS: %w", err)
+	}
+
+	// Decrypt the private key
+	encryptor := eth2ks.New(eth2ks.WithCipher(functionString))
+	decryptedKey, err := encryptor.Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting keystore for validator %s: %w", keystore.Pubkey.Hex(), err)
+	}
+	privateKey, err := eth2types.BLSPrivateKeyFromBytes(decryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error recreating private key for validator %s: %w", keystore.Pubkey.Hex(), err)
+	}
+
+	// Verify the private key matches the public key
+	reconstructedPubkey := types.BytesToValidatorPubkey(privateKey.PublicKey().Marshal())
+	if reconstructedPubkey != keystore.Pubkey {
+		return nil, fmt.Errorf("keystore claims to be for validator %s but it's for validator %s", keystore.Pubkey.Hex(), reconstructedPubkey.Hex())
+	}
+
+	return privateKey, nil
+
+}
+
+// Encrypt a validator key into an EIP-2335 keystore, e.g. to move it to another machine
+func EncryptValidatorKeystore(privateKey *eth2types.BLSPrivateKey, path string, password string) (api.ValidatorKeystore, error) {
+	encryptor := eth2ks.New()
+	encryptedKey, err := encryptor.Encrypt(privateKey.Marshal(), password)
+	if err != nil {
+		return api.ValidatorKeystore{}, fmt.Errorf("error encrypting validator key: %w", err)
+	}
+	return api.ValidatorKeystore{
+		Crypto:  encryptedKey,
+		Version: encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    path,
+		Pubkey:  types.BytesToValidatorPubkey(privateKey.PublicKey().Marshal()),
+	}, nil
+}
@@ -18,7 +18,6 @@ import (
 	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
 	"github.com/urfave/cli"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
-	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 	"gopkg.in/yaml.v2"
 )
 
@@ -39,6 +38,22 @@ func RecoverMinipoolKeys(c *cli.Context, rp *rocketpool.RocketPool, address comm
 		pubkeyMap[pubkey] = true
 	}
 
+	// Recover imported validator keys
+	for _, pubkey := range pubkeys {
+		var recovered bool
+		if testOnly {
+			recovered, err = w.TestRecoverImportedValidatorKey(pubkey)
+		} else {
+			recovered, err = w.RecoverImportedValidatorKey(pubkey)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if recovered {
+			delete(pubkeyMap, pubkey)
+		}
+	}
+
 	// Load custom validator keys
 	customKeyDir := cfg.Smartnode.GetCustomKeyPath()
 	info, err := os.Stat(customKeyDir)
@@ -99,34 +114,12 @@ func RecoverMinipoolKeys(c *cli.Context, rp *rocketpool.RocketPool, address comm
 					return nil, fmt.Errorf("custom keystore for pubkey %s needs a password, but none was provided", keystore.Pubkey.Hex())
 				}
 
-				// Get the encryption function it uses
-				kdf, exists := keystore.Crypto["kdf"]
-				if !exists {
-					return nil, fmt.Errorf("error processing custom keystore %s: \"crypto\" didn't contain a subkey named \"kdf\"", file.Name())
-				}
-				kdfMap := kdf.(map[string]interface{})
-				function, exists := kdfMap["function"]
-				if !exists {
-					return nil, fmt.Errorf("error processing custom keystore %s: \"crypto.kdf\" didn't contain a subkey named \"function\"", file.Name())
-				}
-				functionString := function.(string)
-
 				// Decrypt the private key
-				encryptor := eth2ks.New(eth2ks.WithCipher(functionString))
-				decryptedKey, err := encryptor.Decrypt(keystore.Crypto, password)
+				privateKey, err := DecryptValidatorKeystore(keystore, password)
 				if err != nil {
-					return nil, fmt.Errorf("error decrypting keystore for validator %s: %w", keystore.Pubkey.Hex(), err)
-				}
-				privateKey, err := eth2types.BLSPrivateKeyFromBytes(decryptedKey)
-				if err != nil {
-					return nil, fmt.Errorf("error recreating private key for validator %s: %w", keystore.Pubkey.Hex(), err)
-				}
-
-				// Verify the private key matches the public key
-				reconstructedPubkey := types.BytesToValidatorPubkey(privateKey.PublicKey().Marshal())
-				if reconstructedPubkey != keystore.Pubkey {
-					return nil, fmt.Errorf("private keystore file %s claims to be for validator %s but it's for validator %s", file.Name(), keystore.Pubkey.Hex(), reconstructedPubkey.Hex())
+					return nil, fmt.Errorf("error processing custom keystore %s: %w", file.Name(), err)
 				}
+				reconstructedPubkey := keystore.Pubkey
 
 				// Store the key
 				if !testOnly {